@@ -0,0 +1,172 @@
+package kratix
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/syntasso/kratix-go/internal/objutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// ReadyConditionType is the type of the aggregated condition returned by
+	// ChildrenStatus.ReadyCondition.
+	ReadyConditionType = "Ready"
+
+	// ChildrenStatusKey is the status key the summary of the children is
+	// recorded under by ChildrenStatus.ApplyTo.
+	ChildrenStatusKey = "children"
+)
+
+// ChildReadyConditionTypes are the condition types that mark a child resource
+// as ready, in order of preference: a Ready condition set by its workflows, or
+// the Reconciled condition set by Kratix.
+var ChildReadyConditionTypes = []string{"Ready", "Reconciled"}
+
+// ChildSummary is the readiness of a child resource.
+type ChildSummary struct {
+	Promise   string `json:"promise"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Ready     bool   `json:"ready"`
+	Reason    string `json:"reason,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+// ChildrenStatus is the readiness of the child resources of a Resource.
+type ChildrenStatus struct {
+	Children []ChildSummary `json:"children"`
+}
+
+// Ready returns true if there are children and all of them are ready.
+func (c ChildrenStatus) Ready() bool {
+	if len(c.Children) == 0 {
+		return false
+	}
+	for _, child := range c.Children {
+		if !child.Ready {
+			return false
+		}
+	}
+	return true
+}
+
+// ReadyCondition returns the Ready condition aggregated from the children. Its
+// LastTransitionTime is left unset, to be set only when its status changes by
+// ApplyTo, PublishStatus or meta.SetStatusCondition.
+func (c ChildrenStatus) ReadyCondition() metav1.Condition {
+	condition := metav1.Condition{
+		Type:    ReadyConditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  "ChildrenReady",
+		Message: fmt.Sprintf("%d/%d child resources ready", len(c.Children), len(c.Children)),
+	}
+	if len(c.Children) == 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "ChildrenNotFound"
+		condition.Message = "no child resources found"
+		return condition
+	}
+
+	var notReady []string
+	for _, child := range c.Children {
+		if !child.Ready {
+			notReady = append(notReady, child.Kind+"/"+child.Name)
+		}
+	}
+	if len(notReady) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "ChildrenNotReady"
+		condition.Message = fmt.Sprintf("%d/%d child resources ready, waiting for %s",
+			len(c.Children)-len(notReady), len(c.Children), strings.Join(notReady, ", "))
+	}
+	return condition
+}
+
+// ApplyTo records the summary of the children and the Ready condition in the
+// status, to be written with WriteStatus or published with PublishStatus. The
+// Ready condition already in the status keeps its lastTransitionTime while its
+// status is unchanged.
+func (c ChildrenStatus) ApplyTo(status Status) error {
+	children, err := objutil.ToJSON(c.Children)
+	if err != nil {
+		return fmt.Errorf("children: %w", err)
+	}
+	if children == nil {
+		children = []any{}
+	}
+	if err := status.Set(ChildrenStatusKey, children); err != nil {
+		return err
+	}
+
+	condition, err := objutil.ToJSON(c.ReadyCondition())
+	if err != nil {
+		return fmt.Errorf("ready condition: %w", err)
+	}
+	existing, _ := status.Get("conditions").([]any)
+	return status.Set("conditions", mergeConditions(existing, []any{condition}))
+}
+
+// AggregateChildStatus lists the resources of the named Promises requested by
+// the parent Resource with BuildSubResourceRequest, and summarises their
+// readiness from their status conditions.
+func (k *KratixSDK) AggregateChildStatus(parent Resource, promiseNames ...string) (ChildrenStatus, error) {
+	selector := k.ChildSelector(parent).String()
+	result := ChildrenStatus{Children: []ChildSummary{}}
+	for _, promiseName := range promiseNames {
+		promise, err := k.fetchPromiseNamed(promiseName)
+		if err != nil {
+			return ChildrenStatus{}, err
+		}
+		objectClient, err := k.clientForPromiseAPI(promise, parent.GetNamespace())
+		if err != nil {
+			return ChildrenStatus{}, err
+		}
+		children, err := objectClient.List(context.Background(), metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return ChildrenStatus{}, fmt.Errorf("list %s resources: %w", promiseName, err)
+		}
+		for _, child := range children.Items {
+			result.Children = append(result.Children, summariseChild(promiseName, child))
+		}
+	}
+	return result, nil
+}
+
+// clientForPromiseAPI returns a client for the resources of the storage
+// version of the Promise API, resolved with the CRD of the Promise.
+func (k *KratixSDK) clientForPromiseAPI(promise Promise, namespace string) (ResourceInterface, error) {
+	gvk, crd, err := promise.GetPromise().GetAPI()
+	if err != nil {
+		return nil, fmt.Errorf("read promise api: %w", err)
+	}
+	return k.clientFor(*gvk, namespace, crdRESTMapper(crd))
+}
+
+func summariseChild(promiseName string, child unstructured.Unstructured) ChildSummary {
+	summary := ChildSummary{
+		Promise:   promiseName,
+		Kind:      child.GetKind(),
+		Name:      child.GetName(),
+		Namespace: child.GetNamespace(),
+		Reason:    "NoReadyCondition",
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(child.Object, "status", "conditions")
+	for _, conditionType := range ChildReadyConditionTypes {
+		for _, c := range conditions {
+			condition, ok := c.(map[string]any)
+			if !ok || condition["type"] != conditionType {
+				continue
+			}
+			summary.Ready = condition["status"] == string(metav1.ConditionTrue)
+			summary.Reason, _ = condition["reason"].(string)
+			summary.Message, _ = condition["message"].(string)
+			return summary
+		}
+	}
+	return summary
+}
@@ -0,0 +1,150 @@
+package kratix
+
+import (
+	"context"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/syntasso/kratix/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+var _ = Describe("Aggregating child status", func() {
+	var (
+		sdk           *KratixSDK
+		dynamicClient *fake.FakeDynamicClient
+		parent        *ResourceImpl
+		postgresGVR   = schema.GroupVersionResource{Group: "marketplace.kratix.io", Version: "v1alpha1", Resource: "postgresqls"}
+	)
+
+	createChild := func(name string, childLabels map[string]string, conditions ...any) {
+		child := &unstructured.Unstructured{}
+		child.SetAPIVersion("marketplace.kratix.io/v1alpha1")
+		child.SetKind("postgresql")
+		child.SetName(name)
+		child.SetNamespace("team-a")
+		child.SetLabels(childLabels)
+		if len(conditions) > 0 {
+			Expect(unstructured.SetNestedSlice(child.Object, conditions, "status", "conditions")).To(Succeed())
+		}
+		_, err := dynamicClient.Resource(postgresGVR).Namespace("team-a").Create(context.Background(), child, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		dynamicClient = fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+			postgresGVR: "postgresqlList",
+		})
+		promise := postgresPromise().ToUnstructured()
+		_, err := dynamicClient.Resource(v1alpha1.GroupVersion.WithResource("promises")).Create(context.Background(), &promise, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		sdk = New(WithDynamicClient(dynamicClient))
+		os.Setenv("KRATIX_PROMISE_NAME", "platform-app")
+		DeferCleanup(os.Unsetenv, "KRATIX_PROMISE_NAME")
+
+		parent = &ResourceImpl{obj: unstructured.Unstructured{}}
+		parent.obj.SetName("my-app")
+		parent.obj.SetNamespace("team-a")
+	})
+
+	It("is not ready when there are no children", func() {
+		children, err := sdk.AggregateChildStatus(parent, "postgres")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(children.Children).To(BeEmpty())
+		Expect(children.Ready()).To(BeFalse())
+		Expect(children.ReadyCondition().Reason).To(Equal("ChildrenNotFound"))
+	})
+
+	It("summarises the children of the parent from their conditions", func() {
+		childLabels := sdk.parentLabels(parent)
+		createChild("db", childLabels, map[string]any{
+			"type": "Reconciled", "status": "True", "reason": "Reconciled", "message": "Reconciled",
+		})
+		createChild("replica", childLabels,
+			map[string]any{"type": "Reconciled", "status": "True", "reason": "Reconciled"},
+			map[string]any{"type": "Ready", "status": "False", "reason": "Provisioning", "message": "creating the database"},
+		)
+		createChild("pending", childLabels)
+		createChild("other-app-db", map[string]string{ParentNameLabel: "other-app"})
+
+		children, err := sdk.AggregateChildStatus(parent, "postgres")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(children.Children).To(ConsistOf(
+			ChildSummary{Promise: "postgres", Kind: "postgresql", Name: "db", Namespace: "team-a", Ready: true, Reason: "Reconciled", Message: "Reconciled"},
+			ChildSummary{Promise: "postgres", Kind: "postgresql", Name: "replica", Namespace: "team-a", Ready: false, Reason: "Provisioning", Message: "creating the database"},
+			ChildSummary{Promise: "postgres", Kind: "postgresql", Name: "pending", Namespace: "team-a", Ready: false, Reason: "NoReadyCondition"},
+		))
+		Expect(children.Ready()).To(BeFalse())
+
+		condition := children.ReadyCondition()
+		Expect(condition.Type).To(Equal(ReadyConditionType))
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("ChildrenNotReady"))
+		Expect(condition.Message).To(SatisfyAll(
+			HavePrefix("1/3 child resources ready, waiting for "),
+			ContainSubstring("postgresql/replica"),
+			ContainSubstring("postgresql/pending"),
+		))
+	})
+
+	It("is ready when all the children are ready", func() {
+		createChild("db", sdk.parentLabels(parent), map[string]any{"type": "Reconciled", "status": "True", "reason": "Reconciled"})
+
+		children, err := sdk.AggregateChildStatus(parent, "postgres")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(children.Ready()).To(BeTrue())
+
+		condition := children.ReadyCondition()
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Message).To(Equal("1/1 child resources ready"))
+	})
+
+	It("records the summary and the Ready condition in the status", func() {
+		children := ChildrenStatus{Children: []ChildSummary{{Promise: "postgres", Kind: "postgresql", Name: "db", Ready: true}}}
+		status := NewStatusFromMap(map[string]any{
+			"conditions": []any{
+				map[string]any{"type": "Ready", "status": "False"},
+				map[string]any{"type": "ConfigureWorkflowCompleted", "status": "True"},
+			},
+		})
+
+		Expect(children.ApplyTo(status)).To(Succeed())
+		Expect(status.Get("children")).To(Equal([]any{
+			map[string]any{"promise": "postgres", "kind": "postgresql", "name": "db", "ready": true},
+		}))
+		Expect(status.Get("conditions")).To(ConsistOf(
+			SatisfyAll(HaveKeyWithValue("type", "Ready"), HaveKeyWithValue("status", "True"), HaveKeyWithValue("reason", "ChildrenReady")),
+			HaveKeyWithValue("type", "ConfigureWorkflowCompleted"),
+		))
+	})
+
+	It("keeps the lastTransitionTime of the Ready condition while its status is unchanged", func() {
+		children := ChildrenStatus{Children: []ChildSummary{{Promise: "postgres", Kind: "postgresql", Name: "db", Ready: true}}}
+		Expect(children.ReadyCondition().LastTransitionTime).To(BeZero())
+
+		status := NewStatusFromMap(map[string]any{
+			"conditions": []any{
+				map[string]any{"type": "Ready", "status": "True", "reason": "ChildrenReady", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+			},
+		})
+		Expect(children.ApplyTo(status)).To(Succeed())
+		Expect(children.ApplyTo(status)).To(Succeed())
+		Expect(status.Get("conditions")).To(ConsistOf(
+			HaveKeyWithValue("lastTransitionTime", "2024-01-01T00:00:00Z"),
+		))
+
+		children.Children[0].Ready = false
+		Expect(children.ApplyTo(status)).To(Succeed())
+		Expect(status.Get("conditions")).To(ConsistOf(SatisfyAll(
+			HaveKeyWithValue("status", "False"),
+			HaveKeyWithValue("lastTransitionTime", Not(Equal("2024-01-01T00:00:00Z"))),
+		)))
+	})
+})
@@ -0,0 +1,144 @@
+package kratix
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// clientConfig holds the settings used to build the Kubernetes client when one
+// has not been injected with WithDynamicClient or WithObjectClient.
+type clientConfig struct {
+	restConfig     *rest.Config
+	kubeconfigPath string
+	kubeContext    string
+	inCluster      bool
+	qps            float32
+	burst          int
+	userAgent      string
+	impersonate    *rest.ImpersonationConfig
+}
+
+// WithRESTConfig builds the Kubernetes client from the provided rest.Config.
+func WithRESTConfig(config *rest.Config) Option {
+	return func(k *KratixSDK) { k.clientConfig.restConfig = config }
+}
+
+// WithKubeconfig builds the Kubernetes client from the kubeconfig at path,
+// using the named context. An empty path uses the default kubeconfig loading
+// rules, and an empty context uses the kubeconfig's current context.
+func WithKubeconfig(path, context string) Option {
+	return func(k *KratixSDK) {
+		k.clientConfig.kubeconfigPath = path
+		k.clientConfig.kubeContext = context
+	}
+}
+
+// WithInClusterConfig builds the Kubernetes client from the service account
+// mounted in the pod, without falling back to a kubeconfig.
+func WithInClusterConfig() Option {
+	return func(k *KratixSDK) { k.clientConfig.inCluster = true }
+}
+
+// WithQPS sets the client-side rate limits of the Kubernetes client.
+func WithQPS(qps float32, burst int) Option {
+	return func(k *KratixSDK) {
+		k.clientConfig.qps = qps
+		k.clientConfig.burst = burst
+	}
+}
+
+// WithUserAgent sets the user agent sent by the Kubernetes client.
+func WithUserAgent(userAgent string) Option {
+	return func(k *KratixSDK) { k.clientConfig.userAgent = userAgent }
+}
+
+// WithImpersonation makes the Kubernetes client act as the provided user.
+func WithImpersonation(impersonate rest.ImpersonationConfig) Option {
+	return func(k *KratixSDK) { k.clientConfig.impersonate = &impersonate }
+}
+
+// WithDynamicClient overrides the dynamic client used for all Kubernetes
+// operations, bypassing the client configuration options.
+func WithDynamicClient(client dynamic.Interface) Option {
+	return func(k *KratixSDK) { k.dynamicClient = client }
+}
+
+// getDynamicClient returns the injected dynamic client, or builds and caches
+// one from the client configuration.
+func (k *KratixSDK) getDynamicClient() (dynamic.Interface, error) {
+	if k.dynamicClient != nil {
+		return k.dynamicClient, nil
+	}
+
+	config, err := k.getRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("create kubernetes client: %w", err)
+	}
+	k.dynamicClient = client
+	return client, nil
+}
+
+// getRESTConfig builds and caches the rest.Config from the client configuration.
+func (k *KratixSDK) getRESTConfig() (*rest.Config, error) {
+	if k.restConfig != nil {
+		return k.restConfig, nil
+	}
+	config, err := k.clientConfig.build()
+	if err != nil {
+		return nil, fmt.Errorf("build kubernetes client config: %w", err)
+	}
+	k.restConfig = config
+	return config, nil
+}
+
+// build resolves the rest.Config. Without explicit configuration it mirrors
+// Kratix, trying the in-cluster config first and falling back to the
+// default kubeconfig.
+func (c clientConfig) build() (*rest.Config, error) {
+	var config *rest.Config
+	var err error
+	switch {
+	case c.restConfig != nil:
+		config = rest.CopyConfig(c.restConfig)
+	case c.inCluster:
+		config, err = rest.InClusterConfig()
+	case c.kubeconfigPath != "" || c.kubeContext != "":
+		config, err = kubeconfig(c.kubeconfigPath, c.kubeContext)
+	default:
+		config, err = rest.InClusterConfig()
+		if err != nil {
+			config, err = kubeconfig("", "")
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if c.qps != 0 {
+		config.QPS = c.qps
+	}
+	if c.burst != 0 {
+		config.Burst = c.burst
+	}
+	if c.userAgent != "" {
+		config.UserAgent = c.userAgent
+	}
+	if c.impersonate != nil {
+		config.Impersonate = *c.impersonate
+	}
+	return config, nil
+}
+
+func kubeconfig(path, context string) (*rest.Config, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = path
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: context}
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides).ClientConfig()
+}
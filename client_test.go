@@ -0,0 +1,88 @@
+package kratix
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/client-go/rest"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+current-context: platform
+clusters:
+- name: platform
+  cluster:
+    server: https://platform.example:6443
+- name: worker
+  cluster:
+    server: https://worker.example:6443
+contexts:
+- name: platform
+  context:
+    cluster: platform
+    user: admin
+- name: worker
+  context:
+    cluster: worker
+    user: admin
+users:
+- name: admin
+  user:
+    token: secret
+`
+
+var _ = Describe("Client configuration", func() {
+	It("copies the provided rest config and applies the overrides", func() {
+		base := &rest.Config{Host: "https://example:6443", QPS: 5, Burst: 10}
+		sdk := New(
+			WithRESTConfig(base),
+			WithQPS(50, 100),
+			WithUserAgent("my-pipeline"),
+			WithImpersonation(rest.ImpersonationConfig{UserName: "system:serviceaccount:default:pipeline"}),
+		)
+
+		config, err := sdk.clientConfig.build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.Host).To(Equal("https://example:6443"))
+		Expect(config.QPS).To(BeNumerically("==", 50))
+		Expect(config.Burst).To(Equal(100))
+		Expect(config.UserAgent).To(Equal("my-pipeline"))
+		Expect(config.Impersonate.UserName).To(Equal("system:serviceaccount:default:pipeline"))
+		Expect(base.QPS).To(BeNumerically("==", 5))
+	})
+
+	Describe("WithKubeconfig", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(GinkgoT().TempDir(), "kubeconfig")
+			Expect(os.WriteFile(path, []byte(testKubeconfig), 0o600)).To(Succeed())
+		})
+
+		It("uses the current context by default", func() {
+			config, err := New(WithKubeconfig(path, "")).clientConfig.build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Host).To(Equal("https://platform.example:6443"))
+		})
+
+		It("uses the provided context", func() {
+			config, err := New(WithKubeconfig(path, "worker")).clientConfig.build()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Host).To(Equal("https://worker.example:6443"))
+		})
+
+		It("errors when the context does not exist", func() {
+			_, err := New(WithKubeconfig(path, "missing")).clientConfig.build()
+			Expect(err).To(MatchError(ContainSubstring("missing")))
+		})
+	})
+
+	It("errors when in-cluster config is requested outside a cluster", func() {
+		_, err := New(WithInClusterConfig()).getDynamicClient()
+		Expect(err).To(MatchError(ContainSubstring("build kubernetes client config")))
+	})
+})
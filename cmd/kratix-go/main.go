@@ -0,0 +1,129 @@
+// Command kratix-go generates code from Promises for workflows written with
+// the kratix-go SDK.
+//
+// Usage:
+//
+//	kratix-go types -promise promise.yaml -package config [-version v1] [-o types.go]
+//	kratix-go examples -promise promise.yaml [-variant minimal] [-version v1] [-o examples.yaml]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix-go/codegen"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const usage = `Usage: kratix-go <command> [flags]
+
+Commands:
+  types     generate Go types from the API of a Promise
+  examples  generate example resource requests from the API of a Promise
+`
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "kratix-go:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return fmt.Errorf("no command given")
+	}
+	switch args[0] {
+	case "types":
+		return runTypes(args[1:], stdout)
+	case "examples":
+		return runExamples(args[1:], stdout)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stdout, usage)
+		return nil
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+func runTypes(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("types", flag.ContinueOnError)
+	promiseFile := flags.String("promise", "", "path to the Promise YAML")
+	pkg := flags.String("package", "", "name of the generated Go package")
+	versions := flags.String("version", "", "comma-separated versions of the API to generate (default all served versions)")
+	out := flags.String("o", "", "file to write the generated code to (default stdout)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *promiseFile == "" || *pkg == "" {
+		flags.Usage()
+		return fmt.Errorf("-promise and -package are required")
+	}
+
+	promise, err := kratix.ReadPromiseFile(*promiseFile)
+	if err != nil {
+		return err
+	}
+	opts := codegen.Options{Package: *pkg}
+	if *versions != "" {
+		opts.Versions = strings.Split(*versions, ",")
+	}
+	src, err := codegen.GenerateTypes(promise, opts)
+	if err != nil {
+		return err
+	}
+	return writeOutput(*out, src, stdout)
+}
+
+func runExamples(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("examples", flag.ContinueOnError)
+	promiseFile := flags.String("promise", "", "path to the Promise YAML")
+	variant := flags.String("variant", "", "variant to generate, minimal or maximal (default both)")
+	version := flags.String("version", "", "version of the API (default the storage version)")
+	name := flags.String("name", "", "name of the examples (default example-<variant>)")
+	namespace := flags.String("namespace", "", "namespace of the examples (default default)")
+	out := flags.String("o", "", "file to write the examples to (default stdout)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *promiseFile == "" {
+		flags.Usage()
+		return fmt.Errorf("-promise is required")
+	}
+
+	promise, err := kratix.ReadPromiseFile(*promiseFile)
+	if err != nil {
+		return err
+	}
+	opts := codegen.ExampleOptions{Version: *version, Name: *name, Namespace: *namespace}
+	var examples []*unstructured.Unstructured
+	if *variant == "" {
+		examples, err = codegen.GenerateExamples(promise, opts)
+	} else {
+		var example *unstructured.Unstructured
+		example, err = codegen.GenerateExample(promise, codegen.ExampleVariant(*variant), opts)
+		examples = append(examples, example)
+	}
+	if err != nil {
+		return err
+	}
+	data, err := codegen.MarshalExamples(examples)
+	if err != nil {
+		return err
+	}
+	return writeOutput(*out, data, stdout)
+}
+
+func writeOutput(path string, data []byte, stdout io.Writer) error {
+	if path == "" {
+		_, err := stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
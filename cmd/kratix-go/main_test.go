@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const testPromise = "../../codegen/testdata/promise.yaml"
+
+var _ = Describe("kratix-go", func() {
+	var stdout *bytes.Buffer
+
+	BeforeEach(func() {
+		stdout = &bytes.Buffer{}
+	})
+
+	Describe("types", func() {
+		It("writes the generated types to stdout", func() {
+			Expect(run([]string{"types", "-promise", testPromise, "-package", "database", "-version", "v1"}, stdout)).To(Succeed())
+			Expect(stdout.String()).To(ContainSubstring("package database"))
+			Expect(stdout.String()).To(ContainSubstring("type Database struct {"))
+		})
+
+		It("writes the generated types to a file", func() {
+			out := filepath.Join(GinkgoT().TempDir(), "types.go")
+			Expect(run([]string{"types", "-promise", testPromise, "-package", "database", "-o", out}, stdout)).To(Succeed())
+			Expect(stdout.String()).To(BeEmpty())
+
+			data, err := os.ReadFile(out)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(ContainSubstring("type DatabaseV1alpha1 struct {"))
+		})
+
+		It("requires a promise and a package", func() {
+			Expect(run([]string{"types", "-package", "database"}, stdout)).To(MatchError("-promise and -package are required"))
+		})
+	})
+
+	Describe("examples", func() {
+		It("writes every variant to stdout", func() {
+			Expect(run([]string{"examples", "-promise", testPromise}, stdout)).To(Succeed())
+			Expect(stdout.String()).To(ContainSubstring("name: example-minimal"))
+			Expect(stdout.String()).To(ContainSubstring("---\n"))
+			Expect(stdout.String()).To(ContainSubstring("name: example-maximal"))
+		})
+
+		It("writes a single variant", func() {
+			args := []string{"examples", "-promise", testPromise, "-variant", "minimal", "-name", "db", "-namespace", "team-a"}
+			Expect(run(args, stdout)).To(Succeed())
+			Expect(stdout.String()).To(ContainSubstring("name: db\n  namespace: team-a\n"))
+			Expect(stdout.String()).ToNot(ContainSubstring("---"))
+		})
+
+		It("requires a promise", func() {
+			Expect(run([]string{"examples"}, stdout)).To(MatchError("-promise is required"))
+		})
+	})
+
+	It("errors on unknown commands", func() {
+		Expect(run([]string{"nope"}, stdout)).To(MatchError(`unknown command "nope"`))
+	})
+})
@@ -0,0 +1,174 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix-go/internal/schemagen"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// ExampleVariant selects which fields an example resource request sets.
+type ExampleVariant string
+
+const (
+	// ExampleMinimal sets only the required fields.
+	ExampleMinimal ExampleVariant = "minimal"
+	// ExampleMaximal sets every field of the schema.
+	ExampleMaximal ExampleVariant = "maximal"
+)
+
+// ExampleVariants are the variants generated by GenerateExamples.
+var ExampleVariants = []ExampleVariant{ExampleMinimal, ExampleMaximal}
+
+// ExampleOptions configures the generated example resource requests.
+type ExampleOptions struct {
+	// Version of the API the examples are generated for. The storage version
+	// is used by default.
+	Version string
+	// Name of the examples. Defaults to example-<variant>.
+	Name string
+	// Namespace of the examples of namespaced APIs. Defaults to default.
+	Namespace string
+}
+
+// GenerateExamples generates an example resource request of the Promise API
+// for each of the ExampleVariants.
+func GenerateExamples(promise kratix.Promise, opts ExampleOptions) ([]*unstructured.Unstructured, error) {
+	var examples []*unstructured.Unstructured
+	for _, variant := range ExampleVariants {
+		example, err := GenerateExample(promise, variant, opts)
+		if err != nil {
+			return nil, err
+		}
+		examples = append(examples, example)
+	}
+	return examples, nil
+}
+
+// GenerateExample generates an example resource request of the Promise API
+// from the schema of its CRD. Each field is set to its default, else its
+// example, else the first of its enum values, else a value of its type within
+// the bounds of the schema. The example is validated against the schema, so
+// fields with patterns or formats the generator cannot satisfy must have a
+// default or example in the Promise.
+func GenerateExample(promise kratix.Promise, variant ExampleVariant, opts ExampleOptions) (*unstructured.Unstructured, error) {
+	if !slices.Contains(ExampleVariants, variant) {
+		return nil, fmt.Errorf("unknown example variant %q", variant)
+	}
+	name := opts.Name
+	if name == "" {
+		name = "example-" + string(variant)
+	}
+	namespace := opts.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	example, err := schemagen.Resource(promise, opts.Version, name, namespace, &exampler{maximal: variant == ExampleMaximal})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := kratix.ValidateAgainstPromise(promise, example); err != nil {
+		return nil, fmt.Errorf("generate %s example: %w", variant, err)
+	}
+	return example, nil
+}
+
+// MarshalExamples encodes the examples as a multi-document YAML.
+func MarshalExamples(examples []*unstructured.Unstructured) ([]byte, error) {
+	var buf bytes.Buffer
+	for i, example := range examples {
+		data, err := yaml.Marshal(example.Object)
+		if err != nil {
+			return nil, fmt.Errorf("marshal example %s: %w", example.GetName(), err)
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
+
+// exampler generates the same example every time, setting every field or
+// only the required ones.
+type exampler struct {
+	maximal bool
+}
+
+var _ schemagen.Source = (*exampler)(nil)
+
+// Preset returns the default or example of the field. Objects preserving
+// unknown fields are left empty, as any field made up would be misleading.
+func (e *exampler) Preset(schema *apiextensionsv1.JSONSchemaProps) (any, bool) {
+	if v, ok := schemagen.RawValue(schema.Default); ok {
+		return v, true
+	}
+	if v, ok := schemagen.RawValue(schema.Example); ok {
+		return v, true
+	}
+	if schema.Type == "object" && len(schema.Properties) == 0 && schema.AdditionalProperties == nil &&
+		schema.XPreserveUnknownFields != nil && *schema.XPreserveUnknownFields {
+		return map[string]any{}, true
+	}
+	return nil, false
+}
+
+// Choose picks the first choice, e.g. the first enum value.
+func (e *exampler) Choose(int) int {
+	return 0
+}
+
+func (e *exampler) Bool() bool {
+	return e.maximal
+}
+
+// Count returns the minimum, and at least one in maximal examples.
+func (e *exampler) Count(lo, hi int64) int64 {
+	count := lo
+	if e.maximal {
+		count = max(count, 1)
+	}
+	return min(count, hi)
+}
+
+func (e *exampler) Key(i int64) string {
+	if i == 0 {
+		return "example"
+	}
+	return fmt.Sprintf("example-%d", i)
+}
+
+func (e *exampler) String(schema *apiextensionsv1.JSONSchemaProps) string {
+	v := "example"
+	if schema.MinLength != nil && int64(len(v)) < *schema.MinLength {
+		v += strings.Repeat("x", int(*schema.MinLength)-len(v))
+	}
+	if schema.MaxLength != nil && int64(len(v)) > *schema.MaxLength {
+		v = v[:*schema.MaxLength]
+	}
+	return v
+}
+
+// Integer returns 1, or the closest bound.
+func (e *exampler) Integer(_ *apiextensionsv1.JSONSchemaProps, lo, hi int64) int64 {
+	return min(max(1, lo), hi)
+}
+
+func (e *exampler) Number(schema *apiextensionsv1.JSONSchemaProps) float64 {
+	switch {
+	case schema.Minimum != nil && schema.Maximum != nil:
+		return (*schema.Minimum + *schema.Maximum) / 2
+	case schema.Minimum != nil:
+		return *schema.Minimum + 1
+	case schema.Maximum != nil:
+		return *schema.Maximum - 1
+	}
+	return 1.5
+}
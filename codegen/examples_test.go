@@ -0,0 +1,146 @@
+package codegen_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix-go/codegen"
+	"github.com/syntasso/kratix/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// promiseWithSchema returns a Promise whose v1 API has the given spec schema.
+func promiseWithSchema(spec string) kratix.Promise {
+	promise := &v1alpha1.Promise{}
+	promise.SetName("widget")
+	promise.Spec.API = &runtime.RawExtension{Raw: []byte(`{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind": "CustomResourceDefinition",
+		"metadata": {"name": "widgets.example.com"},
+		"spec": {
+			"group": "example.com",
+			"names": {"kind": "Widget", "plural": "widgets", "singular": "widget"},
+			"scope": "Cluster",
+			"versions": [{
+				"name": "v1", "served": true, "storage": true,
+				"schema": {"openAPIV3Schema": {"type": "object", "properties": {"spec": ` + spec + `}}}
+			}]
+		}
+	}`)}
+	data, err := yaml.Marshal(promise)
+	Expect(err).ToNot(HaveOccurred())
+	p, err := kratix.UnmarshalPromise(data)
+	Expect(err).ToNot(HaveOccurred())
+	return p
+}
+
+var _ = Describe("GenerateExample", func() {
+	var promise kratix.Promise
+
+	BeforeEach(func() {
+		promise = loadPromise("testdata/promise.yaml")
+	})
+
+	It("generates a minimal example with only the required fields", func() {
+		example, err := codegen.GenerateExample(promise, codegen.ExampleMinimal, codegen.ExampleOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(example.GetAPIVersion()).To(Equal("marketplace.kratix.io/v1"))
+		Expect(example.GetKind()).To(Equal("database"))
+		Expect(example.GetName()).To(Equal("example-minimal"))
+		Expect(example.GetNamespace()).To(Equal("default"))
+		Expect(example.Object["spec"]).To(Equal(map[string]any{"size": "small"}))
+	})
+
+	It("generates a maximal example from defaults, examples and enums", func() {
+		example, err := codegen.GenerateExample(promise, codegen.ExampleMaximal, codegen.ExampleOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(example.Object["spec"]).To(Equal(map[string]any{
+			"size":           "small",
+			"replicas":       int64(1),
+			"storageGB":      int64(10),
+			"backup-enabled": true,
+			"port":           int64(1),
+			"labels":         map[string]any{"example": "example"},
+			"extra":          map[string]any{},
+			"users": []any{
+				map[string]any{"name": "alice", "roles": []any{"read"}},
+			},
+		}))
+	})
+
+	It("uses the version, name and namespace from the options", func() {
+		example, err := codegen.GenerateExample(promise, codegen.ExampleMinimal, codegen.ExampleOptions{
+			Version: "v1alpha1", Name: "my-db", Namespace: "team-a",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(example.GetAPIVersion()).To(Equal("marketplace.kratix.io/v1alpha1"))
+		Expect(example.GetName()).To(Equal("my-db"))
+		Expect(example.GetNamespace()).To(Equal("team-a"))
+		Expect(example.Object["spec"]).To(Equal(map[string]any{}))
+	})
+
+	It("keeps values within the bounds of the schema", func() {
+		promise = promiseWithSchema(`{
+			"type": "object",
+			"required": ["count", "ratio", "code", "tags", "createdAt"],
+			"properties": {
+				"count": {"type": "integer", "minimum": 3, "exclusiveMinimum": true, "multipleOf": 2},
+				"ratio": {"type": "number", "minimum": 0, "maximum": 1},
+				"code": {"type": "string", "minLength": 10, "maxLength": 12},
+				"tags": {"type": "array", "minItems": 2, "x-kubernetes-list-type": "set", "items": {"type": "string"}},
+				"createdAt": {"type": "string", "format": "date-time"}
+			}
+		}`)
+		example, err := codegen.GenerateExample(promise, codegen.ExampleMinimal, codegen.ExampleOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(example.GetNamespace()).To(BeEmpty())
+		Expect(example.Object["spec"]).To(Equal(map[string]any{
+			"count":     int64(4),
+			"ratio":     0.5,
+			"code":      "examplexxx",
+			"tags":      []any{"example", "example-1"},
+			"createdAt": "2025-01-01T00:00:00Z",
+		}))
+	})
+
+	It("errors when the example does not match the schema", func() {
+		promise = promiseWithSchema(`{
+			"type": "object",
+			"required": ["name"],
+			"properties": {"name": {"type": "string", "pattern": "^[0-9]+$"}}
+		}`)
+		_, err := codegen.GenerateExample(promise, codegen.ExampleMinimal, codegen.ExampleOptions{})
+
+		var validationErr *kratix.SchemaValidationError
+		Expect(errors.As(err, &validationErr)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("generate minimal example")))
+	})
+
+	It("errors on unknown variants", func() {
+		_, err := codegen.GenerateExample(promise, "medium", codegen.ExampleOptions{})
+		Expect(err).To(MatchError(`unknown example variant "medium"`))
+	})
+})
+
+var _ = Describe("GenerateExamples", func() {
+	It("generates every variant, which can be marshalled as YAML", func() {
+		examples, err := codegen.GenerateExamples(loadPromise("../system/assets/promise.yaml"), codegen.ExampleOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(examples).To(HaveLen(2))
+		Expect(examples[0].GetName()).To(Equal("example-minimal"))
+		Expect(examples[1].GetName()).To(Equal("example-maximal"))
+
+		data, err := codegen.MarshalExamples(examples)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring("name: example-minimal\n  namespace: default\nspec: {}\n---\n"))
+		Expect(string(data)).To(ContainSubstring("fields:\n  - name: example\n    value: example\n"))
+	})
+})
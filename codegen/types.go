@@ -0,0 +1,299 @@
+// Package codegen generates Go types from the API of a Promise, so workflows
+// can read their input with typed structs that follow the Promise API.
+package codegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+
+	kratix "github.com/syntasso/kratix-go"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// Options configures the generated code.
+type Options struct {
+	// Package is the name of the generated Go package.
+	Package string
+	// Versions limits the generated types to these versions of the API. All
+	// the served versions are generated by default.
+	Versions []string
+}
+
+// GenerateTypes generates the Go source of structs for the Promise API, one
+// root struct per version with nested structs for its objects. Optional fields
+// are pointers, enums are typed constants, and every root struct has a Decode
+// function converting the Resource read with ReadResourceInput. When more than
+// one version is generated, the type names are suffixed with the version.
+func GenerateTypes(promise kratix.Promise, opts Options) ([]byte, error) {
+	if opts.Package == "" {
+		return nil, fmt.Errorf("package name is required")
+	}
+	crd, err := promise.GetCRD()
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []apiextensionsv1.CustomResourceDefinitionVersion
+	for _, v := range crd.Spec.Versions {
+		if len(opts.Versions) == 0 && v.Served || slices.Contains(opts.Versions, v.Name) {
+			versions = append(versions, v)
+		}
+	}
+	for _, name := range opts.Versions {
+		if !slices.ContainsFunc(versions, func(v apiextensionsv1.CustomResourceDefinitionVersion) bool { return v.Name == name }) {
+			return nil, fmt.Errorf("promise api has no version %s", name)
+		}
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("promise api has no versions to generate")
+	}
+
+	g := &generator{names: map[string]bool{}}
+	for _, v := range versions {
+		root := exportedName(crd.Spec.Names.Kind)
+		if len(versions) > 1 {
+			root += exportedName(v.Name)
+		}
+		schema := &apiextensionsv1.JSONSchemaProps{Type: "object"}
+		if v.Schema != nil && v.Schema.OpenAPIV3Schema != nil {
+			schema = v.Schema.OpenAPIV3Schema
+		}
+		g.rootType(root, crd.Spec.Group+"/"+v.Name, crd.Spec.Names.Kind, schema)
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by kratix-go codegen from the %s Promise. DO NOT EDIT.\n\n", promise.GetName())
+	fmt.Fprintf(&src, "package %s\n\n", opts.Package)
+	src.WriteString("import (\n")
+	src.WriteString("\tkratix \"github.com/syntasso/kratix-go\"\n")
+	src.WriteString("\tmetav1 \"k8s.io/apimachinery/pkg/apis/meta/v1\"\n")
+	if g.usesIntOrString {
+		src.WriteString("\t\"k8s.io/apimachinery/pkg/util/intstr\"\n")
+	}
+	src.WriteString(")\n")
+	for _, decl := range g.decls {
+		src.WriteString("\n")
+		src.WriteString(decl)
+	}
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w", err)
+	}
+	return formatted, nil
+}
+
+type generator struct {
+	decls           []string
+	names           map[string]bool
+	usesIntOrString bool
+}
+
+// rootType declares the struct of a version of the API, with its constants
+// and Decode function.
+func (g *generator) rootType(name, apiVersion, kind string, schema *apiextensionsv1.JSONSchemaProps) {
+	name = g.reserve(name)
+	for _, declared := range []string{name + "APIVersion", name + "Kind", "Decode" + name} {
+		g.names[declared] = true
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %s is a %s resource of the %s API.\n", name, kind, apiVersion)
+	fmt.Fprintf(&b, "type %s struct {\n", name)
+	b.WriteString("\tmetav1.TypeMeta `json:\",inline\"`\n")
+	b.WriteString("\tmetav1.ObjectMeta `json:\"metadata,omitempty\"`\n")
+	idx := len(g.decls)
+	g.decls = append(g.decls, "")
+	g.fields(&b, name, name, "", schema, "apiVersion", "kind", "metadata")
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(&b, "const (\n")
+	fmt.Fprintf(&b, "\t// %sAPIVersion is the apiVersion of %s resources.\n", name, name)
+	fmt.Fprintf(&b, "\t%sAPIVersion = %q\n", name, apiVersion)
+	fmt.Fprintf(&b, "\t// %sKind is the kind of %s resources.\n", name, name)
+	fmt.Fprintf(&b, "\t%sKind = %q\n", name, kind)
+	fmt.Fprintf(&b, ")\n\n")
+
+	fmt.Fprintf(&b, "// Decode%s decodes the Resource, e.g. read with ReadResourceInput, into a %s.\n", name, name)
+	fmt.Fprintf(&b, "func Decode%s(resource kratix.Resource) (*%s, error) {\n", name, name)
+	fmt.Fprintf(&b, "\tout := &%s{}\n", name)
+	b.WriteString("\tif err := kratix.Decode(resource, out); err != nil {\n\t\treturn nil, err\n\t}\n")
+	b.WriteString("\treturn out, nil\n}\n")
+	g.decls[idx] = b.String()
+}
+
+// structType declares the struct for an object schema and returns its name.
+func (g *generator) structType(name, root, path string, schema *apiextensionsv1.JSONSchemaProps) string {
+	name = g.reserve(name)
+	idx := len(g.decls)
+	g.decls = append(g.decls, "")
+
+	var b strings.Builder
+	writeDoc(&b, "", fmt.Sprintf("%s is %s of %s.", name, path, root), schema.Description)
+	fmt.Fprintf(&b, "type %s struct {\n", name)
+	g.fields(&b, name, root, path, schema)
+	b.WriteString("}\n")
+	g.decls[idx] = b.String()
+	return name
+}
+
+// fields writes the fields of the object schema, skipping the excluded properties.
+func (g *generator) fields(b *strings.Builder, parent, root, path string, schema *apiextensionsv1.JSONSchemaProps, exclude ...string) {
+	fieldNames := map[string]bool{}
+	for _, prop := range slices.Sorted(maps.Keys(schema.Properties)) {
+		if slices.Contains(exclude, prop) {
+			continue
+		}
+		propSchema := schema.Properties[prop]
+		fieldName := exportedName(prop)
+		for i := 2; fieldNames[fieldName]; i++ {
+			fieldName = fmt.Sprintf("%s%d", exportedName(prop), i)
+		}
+		fieldNames[fieldName] = true
+
+		propPath := prop
+		if path != "" {
+			propPath = path + "." + prop
+		}
+		goType, nillable := g.goType(parent+fieldName, root, propPath, &propSchema)
+		required := slices.Contains(schema.Required, prop)
+		tag := prop
+		if !required {
+			tag += ",omitempty"
+			if !nillable {
+				goType = "*" + goType
+			}
+		}
+
+		writeDoc(b, "\t", "", propSchema.Description)
+		fmt.Fprintf(b, "\t%s %s `json:%q`\n", fieldName, goType, tag)
+	}
+}
+
+// goType returns the Go type for the schema, declaring the types it needs,
+// and whether the type can already be nil.
+func (g *generator) goType(name, root, path string, schema *apiextensionsv1.JSONSchemaProps) (string, bool) {
+	if schema.XIntOrString {
+		g.usesIntOrString = true
+		return "intstr.IntOrString", false
+	}
+
+	switch schema.Type {
+	case "string":
+		if len(schema.Enum) > 0 {
+			return g.enumType(name, root, path, schema), false
+		}
+		return "string", false
+	case "integer":
+		return "int64", false
+	case "number":
+		return "float64", false
+	case "boolean":
+		return "bool", false
+	case "array":
+		if schema.Items == nil || schema.Items.Schema == nil {
+			return "[]any", true
+		}
+		item, _ := g.goType(name+"Item", root, path+"[]", schema.Items.Schema)
+		return "[]" + item, true
+	}
+
+	if len(schema.Properties) > 0 {
+		return g.structType(name, root, path, schema), false
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		value, _ := g.goType(name+"Value", root, path+".*", schema.AdditionalProperties.Schema)
+		return "map[string]" + value, true
+	}
+	if schema.Type == "object" {
+		return "map[string]any", true
+	}
+	return "any", true
+}
+
+// enumType declares a string type with a constant for each enum value.
+func (g *generator) enumType(name, root, path string, schema *apiextensionsv1.JSONSchemaProps) string {
+	name = g.reserve(name)
+
+	var b strings.Builder
+	writeDoc(&b, "", fmt.Sprintf("%s is %s of %s.", name, path, root), schema.Description)
+	fmt.Fprintf(&b, "type %s string\n\n", name)
+	b.WriteString("const (\n")
+	for _, value := range schema.Enum {
+		var s string
+		if err := json.Unmarshal(value.Raw, &s); err != nil {
+			continue
+		}
+		constName := g.reserve(name + exportedName(s))
+		fmt.Fprintf(&b, "\t%s %s = %q\n", constName, name, s)
+	}
+	b.WriteString(")\n")
+	g.decls = append(g.decls, b.String())
+	return name
+}
+
+// reserve returns a name not yet declared, based on name, for a type or a
+// constant.
+func (g *generator) reserve(name string) string {
+	unique := name
+	for i := 2; g.names[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	g.names[unique] = true
+	return unique
+}
+
+func writeDoc(b *strings.Builder, indent, summary, description string) {
+	if summary != "" {
+		fmt.Fprintf(b, "%s// %s\n", indent, summary)
+	}
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return
+	}
+	if summary != "" {
+		fmt.Fprintf(b, "%s//\n", indent)
+	}
+	for _, line := range strings.Split(description, "\n") {
+		fmt.Fprintf(b, "%s// %s\n", indent, strings.TrimRightFunc(line, unicode.IsSpace))
+	}
+}
+
+var initialisms = map[string]bool{
+	"api": true, "cpu": true, "dns": true, "http": true, "https": true, "id": true, "ip": true,
+	"json": true, "tls": true, "ttl": true, "uid": true, "uri": true, "url": true, "uuid": true,
+}
+
+// exportedName converts a JSON property or value into an exported Go
+// identifier, e.g. dbConfig to DbConfig and max-size to MaxSize.
+func exportedName(s string) string {
+	parts := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var b strings.Builder
+	for _, part := range parts {
+		if initialisms[strings.ToLower(part)] {
+			b.WriteString(strings.ToUpper(part))
+			continue
+		}
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+
+	name := b.String()
+	if name == "" {
+		return "Value"
+	}
+	if unicode.IsDigit([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
@@ -0,0 +1,155 @@
+package codegen_test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix-go/codegen"
+)
+
+func loadPromise(path string) kratix.Promise {
+	promise, err := kratix.ReadPromiseFile(path)
+	Expect(err).ToNot(HaveOccurred())
+	return promise
+}
+
+// exportData maps the packages the generated code can import, and their
+// dependencies, to their compiled export data.
+var exportData map[string]string
+
+// typeCheck fails unless the generated source compiles.
+func typeCheck(src []byte) {
+	if exportData == nil {
+		out, err := exec.Command("go", "list", "-export", "-deps", "-f", "{{.ImportPath}}={{.Export}}",
+			"github.com/syntasso/kratix-go",
+			"k8s.io/apimachinery/pkg/apis/meta/v1",
+			"k8s.io/apimachinery/pkg/util/intstr",
+		).Output()
+		Expect(err).ToNot(HaveOccurred())
+		exportData = map[string]string{}
+		for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+			path, file, _ := strings.Cut(line, "=")
+			exportData[path] = file
+		}
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "types.go", src, parser.ParseComments)
+	Expect(err).ToNot(HaveOccurred())
+	imp := importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+		if exportData[path] == "" {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(exportData[path])
+	})
+	_, err = (&types.Config{Importer: imp}).Check(file.Name.Name, fset, []*ast.File{file}, nil)
+	Expect(err).ToNot(HaveOccurred())
+}
+
+var _ = Describe("GenerateTypes", func() {
+	var promise kratix.Promise
+
+	BeforeEach(func() {
+		promise = loadPromise("testdata/promise.yaml")
+	})
+
+	generate := func(opts codegen.Options) string {
+		src, err := codegen.GenerateTypes(promise, opts)
+		Expect(err).ToNot(HaveOccurred())
+		typeCheck(src)
+		return string(src)
+	}
+
+	It("generates a root struct with a Decode function", func() {
+		src := generate(codegen.Options{Package: "database", Versions: []string{"v1"}})
+
+		Expect(src).To(HavePrefix("// Code generated by kratix-go codegen from the database Promise. DO NOT EDIT.\n\npackage database\n"))
+		Expect(src).To(ContainSubstring("type Database struct {\n\tmetav1.TypeMeta   `json:\",inline\"`\n\tmetav1.ObjectMeta `json:\"metadata,omitempty\"`\n\tSpec              *DatabaseSpec `json:\"spec,omitempty\"`\n}"))
+		Expect(src).To(ContainSubstring(`DatabaseAPIVersion = "marketplace.kratix.io/v1"`))
+		Expect(src).To(MatchRegexp(`DatabaseKind += "database"`))
+		Expect(src).To(ContainSubstring("func DecodeDatabase(resource kratix.Resource) (*Database, error) {"))
+	})
+
+	It("uses values for required fields and pointers for optional ones", func() {
+		src := generate(codegen.Options{Package: "database", Versions: []string{"v1"}})
+
+		Expect(src).To(MatchRegexp("// The size of the database.\n\tSize +DatabaseSpecSize +`json:\"size\"`"))
+		Expect(src).To(MatchRegexp("Replicas +\\*int64 +`json:\"replicas,omitempty\"`"))
+		Expect(src).To(MatchRegexp("StorageGB +\\*float64 +`json:\"storageGB,omitempty\"`"))
+		Expect(src).To(MatchRegexp("BackupEnabled +\\*bool +`json:\"backup-enabled,omitempty\"`"))
+		Expect(src).To(MatchRegexp("Port +\\*intstr.IntOrString +`json:\"port,omitempty\"`"))
+		Expect(src).To(ContainSubstring(`"k8s.io/apimachinery/pkg/util/intstr"`))
+	})
+
+	It("does not use pointers for slices and maps", func() {
+		src := generate(codegen.Options{Package: "database", Versions: []string{"v1"}})
+
+		Expect(src).To(MatchRegexp("Labels +map\\[string\\]string +`json:\"labels,omitempty\"`"))
+		Expect(src).To(MatchRegexp("Extra +map\\[string\\]any +`json:\"extra,omitempty\"`"))
+		Expect(src).To(MatchRegexp("Users +\\[\\]DatabaseSpecUsersItem +`json:\"users,omitempty\"`"))
+		Expect(src).To(MatchRegexp("Name +string +`json:\"name\"`"))
+		Expect(src).To(MatchRegexp("Roles +\\[\\]DatabaseSpecUsersItemRolesItem +`json:\"roles,omitempty\"`"))
+	})
+
+	It("generates typed constants for enums", func() {
+		src := generate(codegen.Options{Package: "database", Versions: []string{"v1"}})
+
+		Expect(src).To(ContainSubstring("type DatabaseSpecSize string"))
+		Expect(src).To(MatchRegexp(`DatabaseSpecSizeSmall +DatabaseSpecSize = "small"`))
+		Expect(src).To(MatchRegexp(`DatabaseSpecSizeLarge +DatabaseSpecSize = "large"`))
+		Expect(src).To(MatchRegexp(`DatabaseSpecUsersItemRolesItemWrite +DatabaseSpecUsersItemRolesItem = "write"`))
+	})
+
+	It("does not declare types with the names of enum constants", func() {
+		promise = promiseWithSchema(`{
+			"type": "object",
+			"properties": {
+				"size": {"type": "string", "enum": ["small"]},
+				"sizeSmall": {"type": "object", "properties": {"disk": {"type": "string"}}}
+			}
+		}`)
+		src := generate(codegen.Options{Package: "widget"})
+
+		Expect(src).To(MatchRegexp(`WidgetSpecSizeSmall +WidgetSpecSize = "small"`))
+		Expect(src).To(ContainSubstring("type WidgetSpecSizeSmall2 struct {"))
+	})
+
+	It("suffixes the type names with the version when generating several versions", func() {
+		src := generate(codegen.Options{Package: "database"})
+
+		Expect(src).To(ContainSubstring("type DatabaseV1alpha1 struct {"))
+		Expect(src).To(ContainSubstring("type DatabaseV1 struct {"))
+		Expect(src).To(ContainSubstring("func DecodeDatabaseV1alpha1("))
+		Expect(src).To(ContainSubstring("type DatabaseV1Spec struct {"))
+		Expect(src).ToNot(ContainSubstring("DatabaseV0"))
+	})
+
+	It("generates the types of the system test Promise", func() {
+		promise = loadPromise("../system/assets/promise.yaml")
+		src := generate(codegen.Options{Package: "config"})
+
+		Expect(src).To(ContainSubstring("type ConfigSpec struct {"))
+		Expect(src).To(ContainSubstring("type ConfigSpecFieldsItem struct {"))
+		Expect(src).ToNot(ContainSubstring("intstr"))
+	})
+
+	It("errors on unknown versions or a missing package name", func() {
+		_, err := codegen.GenerateTypes(promise, codegen.Options{Package: "database", Versions: []string{"v2"}})
+		Expect(err).To(MatchError("promise api has no version v2"))
+
+		_, err = codegen.GenerateTypes(promise, codegen.Options{})
+		Expect(err).To(MatchError("package name is required"))
+	})
+})
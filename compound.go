@@ -0,0 +1,123 @@
+package kratix
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/syntasso/kratix-go/internal/objutil"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Labels recording which Resource requested a sub-resource.
+const (
+	ParentPromiseLabel   = "kratix.io/parent-promise-name"
+	ParentNameLabel      = "kratix.io/parent-resource-name"
+	ParentNamespaceLabel = "kratix.io/parent-resource-namespace"
+)
+
+// PlatformDirectory is the output directory sub-resource requests are written
+// to, scheduled to the platform cluster with PlatformDestinationLabels.
+const PlatformDirectory = "platform"
+
+// PlatformDestinationLabels select the Destination for the platform cluster,
+// following the Kratix convention for compound Promises.
+var PlatformDestinationLabels = map[string]string{"environment": "platform"}
+
+// BuildSubResourceRequest builds a request for a resource of the named Promise,
+// for compound Promises whose workflows request resources from other Promises.
+// The kind and version come from the CRD of the Promise, which is fetched from
+// the platform cluster. The request is created in the namespace of the parent,
+// labelled with the parent it was requested by, and validated against the
+// schema of the Promise API.
+func (k *KratixSDK) BuildSubResourceRequest(parent Resource, promiseName, name string, spec map[string]any) (*unstructured.Unstructured, error) {
+	promise, err := k.fetchPromiseNamed(promiseName)
+	if err != nil {
+		return nil, err
+	}
+	crd, err := promise.GetCRD()
+	if err != nil {
+		return nil, err
+	}
+	version, err := promise.GetStorageVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	request := &unstructured.Unstructured{Object: map[string]any{}}
+	request.SetAPIVersion(crd.Spec.Group + "/" + version)
+	request.SetKind(crd.Spec.Names.Kind)
+	request.SetName(name)
+	if crd.Spec.Scope != apiextensionsv1.ClusterScoped {
+		request.SetNamespace(parent.GetNamespace())
+	}
+	request.SetLabels(k.parentLabels(parent))
+	if spec != nil {
+		specValue, err := objutil.ToJSON(spec)
+		if err != nil {
+			return nil, fmt.Errorf("spec: %w", err)
+		}
+		request.Object["spec"] = specValue
+	}
+
+	if err := ValidateAgainstPromise(promise, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// parentLabels returns the labels identifying the parent Resource.
+func (k *KratixSDK) parentLabels(parent Resource) map[string]string {
+	parentLabels := map[string]string{
+		ParentPromiseLabel: k.PromiseName(),
+		ParentNameLabel:    parent.GetName(),
+	}
+	if parent.GetNamespace() != "" {
+		parentLabels[ParentNamespaceLabel] = parent.GetNamespace()
+	}
+	return parentLabels
+}
+
+// ChildSelector returns the label selector matching the sub-resources
+// requested by the parent Resource with BuildSubResourceRequest.
+func (k *KratixSDK) ChildSelector(parent Resource) labels.Selector {
+	return labels.SelectorFromSet(k.parentLabels(parent))
+}
+
+// WriteSubResourceRequests writes the requests to the platform directory of the
+// output and schedules that directory to the platform cluster, keeping any
+// other destination selectors the workflow wrote. It returns an error, without
+// writing the requests, if the workflow already scheduled the platform
+// directory elsewhere.
+func (k *KratixSDK) WriteSubResourceRequests(requests ...*unstructured.Unstructured) error {
+	selectors, err := k.ReadDestinationSelectors()
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	idx := slices.IndexFunc(selectors, func(s DestinationSelector) bool { return filepath.Clean(s.Directory) == PlatformDirectory })
+	if idx != -1 && !maps.Equal(selectors[idx].MatchLabels, PlatformDestinationLabels) {
+		return fmt.Errorf("the %s directory is already scheduled to destinations matching %v", PlatformDirectory, selectors[idx].MatchLabels)
+	}
+
+	for _, request := range requests {
+		filename := strings.ToLower(fmt.Sprintf("%s-%s.yaml", request.GetKind(), request.GetName()))
+		if err := k.WriteObjects(path.Join(PlatformDirectory, filename), []*unstructured.Unstructured{request}); err != nil {
+			return err
+		}
+	}
+
+	if idx != -1 {
+		return nil
+	}
+	return k.WriteDestinationSelectors(append(selectors, DestinationSelector{
+		Directory:   PlatformDirectory,
+		MatchLabels: PlatformDestinationLabels,
+	}))
+}
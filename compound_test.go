@@ -0,0 +1,150 @@
+package kratix
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/syntasso/kratix/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Compound promises", func() {
+	var (
+		sdk                    *KratixSDK
+		dynamicClient          *fake.FakeDynamicClient
+		outputDir, metadataDir string
+		parent                 *ResourceImpl
+	)
+
+	BeforeEach(func() {
+		dynamicClient = fake.NewSimpleDynamicClient(runtime.NewScheme())
+		promise := postgresPromise().ToUnstructured()
+		_, err := dynamicClient.Resource(v1alpha1.GroupVersion.WithResource("promises")).Create(context.Background(), &promise, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		outputDir = GinkgoT().TempDir()
+		metadataDir = GinkgoT().TempDir()
+		sdk = New(WithDynamicClient(dynamicClient), WithOutputDir(outputDir), WithMetadataDir(metadataDir))
+
+		os.Setenv("KRATIX_PROMISE_NAME", "platform-app")
+		DeferCleanup(os.Unsetenv, "KRATIX_PROMISE_NAME")
+
+		parent = &ResourceImpl{obj: unstructured.Unstructured{}}
+		parent.obj.SetAPIVersion("marketplace.kratix.io/v1")
+		parent.obj.SetKind("app")
+		parent.obj.SetName("my-app")
+		parent.obj.SetNamespace("team-a")
+	})
+
+	It("builds a typed, labelled request for a resource of another promise", func() {
+		request, err := sdk.BuildSubResourceRequest(parent, "postgres", "my-app-db", map[string]any{"size": "small", "replicas": 2})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(request.GetAPIVersion()).To(Equal("marketplace.kratix.io/v1alpha1"))
+		Expect(request.GetKind()).To(Equal("postgresql"))
+		Expect(request.GetName()).To(Equal("my-app-db"))
+		Expect(request.GetNamespace()).To(Equal("team-a"))
+		Expect(request.GetLabels()).To(Equal(map[string]string{
+			ParentPromiseLabel:   "platform-app",
+			ParentNameLabel:      "my-app",
+			ParentNamespaceLabel: "team-a",
+		}))
+		Expect(request.Object["spec"]).To(Equal(map[string]any{"size": "small", "replicas": int64(2)}))
+
+		Expect(sdk.ChildSelector(parent).Matches(labels.Set(request.GetLabels()))).To(BeTrue())
+	})
+
+	It("rejects requests that do not match the promise schema", func() {
+		_, err := sdk.BuildSubResourceRequest(parent, "postgres", "my-app-db", map[string]any{"size": "huge"})
+		Expect(err).To(MatchError(ContainSubstring(`spec.size: Unsupported value: "huge"`)))
+	})
+
+	It("returns an error when the promise does not exist", func() {
+		_, err := sdk.BuildSubResourceRequest(parent, "redis", "my-app-cache", nil)
+		Expect(err).To(MatchError(ContainSubstring("fetch promise redis")))
+	})
+
+	It("writes the requests scheduled to the platform cluster, keeping the other selectors", func() {
+		Expect(sdk.WriteDestinationSelectors([]DestinationSelector{
+			{Directory: "app", MatchLabels: map[string]string{"environment": "dev"}},
+		})).To(Succeed())
+
+		request, err := sdk.BuildSubResourceRequest(parent, "postgres", "my-app-db", map[string]any{"size": "large"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sdk.WriteSubResourceRequests(request)).To(Succeed())
+
+		data, err := os.ReadFile(filepath.Join(outputDir, "platform", "postgresql-my-app-db.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		written := map[string]any{}
+		Expect(yaml.Unmarshal(data, &written)).To(Succeed())
+		Expect(written).To(HaveKeyWithValue("kind", "postgresql"))
+
+		selectors, err := sdk.ReadDestinationSelectors()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(selectors).To(Equal([]DestinationSelector{
+			{Directory: "app", MatchLabels: map[string]string{"environment": "dev"}},
+			{Directory: PlatformDirectory, MatchLabels: PlatformDestinationLabels},
+		}))
+	})
+
+	It("writes the platform destination selector when the workflow wrote none", func() {
+		request, err := sdk.BuildSubResourceRequest(parent, "postgres", "my-app-db", map[string]any{"size": "large"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sdk.WriteSubResourceRequests(request)).To(Succeed())
+
+		selectors, err := sdk.ReadDestinationSelectors()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(selectors).To(Equal([]DestinationSelector{{Directory: PlatformDirectory, MatchLabels: PlatformDestinationLabels}}))
+	})
+
+	It("keeps the platform destination selector the workflow already wrote", func() {
+		Expect(sdk.WriteDestinationSelectors([]DestinationSelector{
+			{Directory: PlatformDirectory, MatchLabels: map[string]string{"environment": "platform"}},
+		})).To(Succeed())
+
+		request, err := sdk.BuildSubResourceRequest(parent, "postgres", "my-app-db", map[string]any{"size": "large"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sdk.WriteSubResourceRequests(request)).To(Succeed())
+
+		selectors, err := sdk.ReadDestinationSelectors()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(selectors).To(Equal([]DestinationSelector{{Directory: PlatformDirectory, MatchLabels: PlatformDestinationLabels}}))
+	})
+
+	It("recognises the platform destination selector written with another form of the path", func() {
+		for _, directory := range []string{"platform/", "./platform"} {
+			selectors := []DestinationSelector{{Directory: directory, MatchLabels: PlatformDestinationLabels}}
+			Expect(sdk.WriteDestinationSelectors(selectors)).To(Succeed())
+
+			request, err := sdk.BuildSubResourceRequest(parent, "postgres", "my-app-db", map[string]any{"size": "large"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sdk.WriteSubResourceRequests(request)).To(Succeed())
+			Expect(sdk.ReadDestinationSelectors()).To(Equal(selectors))
+
+			Expect(sdk.WriteDestinationSelectors([]DestinationSelector{
+				{Directory: directory, MatchLabels: map[string]string{"environment": "dev"}},
+			})).To(Succeed())
+			Expect(sdk.WriteSubResourceRequests(request)).To(MatchError(ContainSubstring("already scheduled")))
+		}
+	})
+
+	It("errors when the workflow scheduled the platform directory elsewhere", func() {
+		selectors := []DestinationSelector{{Directory: PlatformDirectory, MatchLabels: map[string]string{"environment": "dev"}}}
+		Expect(sdk.WriteDestinationSelectors(selectors)).To(Succeed())
+
+		request, err := sdk.BuildSubResourceRequest(parent, "postgres", "my-app-db", map[string]any{"size": "large"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sdk.WriteSubResourceRequests(request)).To(MatchError("the platform directory is already scheduled to destinations matching map[environment:dev]"))
+
+		Expect(filepath.Join(outputDir, "platform")).ToNot(BeADirectory())
+		Expect(sdk.ReadDestinationSelectors()).To(Equal(selectors))
+	})
+})
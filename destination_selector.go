@@ -1,6 +1,82 @@
 package kratix
 
+import (
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+
+	"github.com/syntasso/kratix/api/v1alpha1"
+)
+
 type DestinationSelector struct {
 	Directory   string            `json:"directory"`
 	MatchLabels map[string]string `json:"matchLabels"`
 }
+
+// ResolveDestinationSelectors combines the Promise's spec.destinationSelectors with
+// the selectors written by a workflow, returning the selectors Kratix will use
+// for each output directory.
+//
+// It follows the same rules as Kratix when scheduling the workflow output:
+//   - selectors for the root directory are merged with the Promise selectors,
+//     with the Promise selectors taking precedence on conflicting keys
+//   - selectors for a sub-directory override the Promise selectors entirely
+//
+// The root directory is always returned first, as ".", followed by the
+// sub-directories in the order they were declared. The root directory is
+// omitted when neither the Promise nor the workflow declares selectors for it.
+func ResolveDestinationSelectors(promiseSelectors []v1alpha1.PromiseScheduling, workflowSelectors []DestinationSelector) ([]DestinationSelector, error) {
+	if err := validateDestinationSelectors(workflowSelectors); err != nil {
+		return nil, err
+	}
+
+	var root map[string]string
+	var directories []DestinationSelector
+	for _, selector := range workflowSelectors {
+		directory := filepath.Clean(selector.Directory)
+		if directory == v1alpha1.DefaultWorkloadGroupDirectory {
+			root = maps.Clone(selector.MatchLabels)
+			continue
+		}
+		directories = append(directories, DestinationSelector{
+			Directory:   directory,
+			MatchLabels: maps.Clone(selector.MatchLabels),
+		})
+	}
+
+	if promiseLabels := v1alpha1.SquashPromiseScheduling(promiseSelectors); len(promiseLabels) > 0 {
+		if root == nil {
+			root = map[string]string{}
+		}
+		maps.Copy(root, promiseLabels)
+	}
+
+	if root == nil {
+		return directories, nil
+	}
+	return append([]DestinationSelector{{
+		Directory:   v1alpha1.DefaultWorkloadGroupDirectory,
+		MatchLabels: root,
+	}}, directories...), nil
+}
+
+// validateDestinationSelectors applies the same checks Kratix runs against
+// /kratix/metadata/destination-selectors.yaml.
+func validateDestinationSelectors(selectors []DestinationSelector) error {
+	var seen []string
+	for i, selector := range selectors {
+		if len(selector.MatchLabels) == 0 {
+			return fmt.Errorf("destination selector with index %d has no selectors", i)
+		}
+		directory := filepath.Clean(selector.Directory)
+		if filepath.Base(directory) != directory {
+			return fmt.Errorf("invalid directory in destination selectors: %s, sub-directories are not allowed", directory)
+		}
+		if slices.Contains(seen, directory) {
+			return fmt.Errorf("duplicate destination selectors for directory %s", directory)
+		}
+		seen = append(seen, directory)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package kratix
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/syntasso/kratix/api/v1alpha1"
+)
+
+var _ = Describe("ResolveDestinationSelectors", func() {
+	var promiseSelectors []v1alpha1.PromiseScheduling
+
+	BeforeEach(func() {
+		promiseSelectors = []v1alpha1.PromiseScheduling{
+			{MatchLabels: map[string]string{"environment": "dev", "region": "eu"}},
+			{MatchLabels: map[string]string{"environment": "prod"}},
+		}
+	})
+
+	It("merges the promise selectors into the root directory selectors", func() {
+		selectors, err := ResolveDestinationSelectors(promiseSelectors, []DestinationSelector{
+			{MatchLabels: map[string]string{"environment": "staging", "team": "a"}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(selectors).To(Equal([]DestinationSelector{
+			{Directory: ".", MatchLabels: map[string]string{"environment": "dev", "region": "eu", "team": "a"}},
+		}))
+	})
+
+	It("does not apply the promise selectors to sub-directories", func() {
+		selectors, err := ResolveDestinationSelectors(promiseSelectors, []DestinationSelector{
+			{Directory: "db/", MatchLabels: map[string]string{"team": "data"}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(selectors).To(Equal([]DestinationSelector{
+			{Directory: ".", MatchLabels: map[string]string{"environment": "dev", "region": "eu"}},
+			{Directory: "db", MatchLabels: map[string]string{"team": "data"}},
+		}))
+	})
+
+	It("omits the root directory when nothing selects it", func() {
+		selectors, err := ResolveDestinationSelectors(nil, []DestinationSelector{
+			{Directory: "db", MatchLabels: map[string]string{"team": "data"}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(selectors).To(Equal([]DestinationSelector{
+			{Directory: "db", MatchLabels: map[string]string{"team": "data"}},
+		}))
+	})
+
+	It("rejects selectors Kratix would reject", func() {
+		_, err := ResolveDestinationSelectors(nil, []DestinationSelector{{Directory: "db"}})
+		Expect(err).To(MatchError(ContainSubstring("index 0 has no selectors")))
+
+		_, err = ResolveDestinationSelectors(nil, []DestinationSelector{
+			{Directory: "foo/bar", MatchLabels: map[string]string{"a": "b"}},
+		})
+		Expect(err).To(MatchError(ContainSubstring("sub-directories are not allowed")))
+
+		_, err = ResolveDestinationSelectors(nil, []DestinationSelector{
+			{MatchLabels: map[string]string{"a": "b"}},
+			{Directory: ".", MatchLabels: map[string]string{"c": "d"}},
+		})
+		Expect(err).To(MatchError(ContainSubstring("duplicate destination selectors for directory .")))
+	})
+})
@@ -0,0 +1,204 @@
+package kratix
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// FingerprintStatusKey is the status key the input fingerprint is recorded under.
+const FingerprintStatusKey = "inputFingerprint"
+
+// FingerprintOptions selects the inputs, besides the spec, that are part of
+// the fingerprint.
+type FingerprintOptions struct {
+	// Labels are the label keys to include.
+	Labels []string
+	// Annotations are the annotation keys to include.
+	Annotations []string
+	// PromiseVersion is the version of the Promise, e.g. the value of the
+	// kratix.io/promise-version label.
+	PromiseVersion string
+	// PipelineVersion is the version of the pipeline, so that changes to the
+	// pipeline itself trigger a regeneration.
+	PipelineVersion string
+}
+
+// Fingerprint is a hash of the inputs of a workflow, along with the hash of
+// each individual field so that the fields that changed can be reported.
+type Fingerprint struct {
+	Hash   string            `json:"hash"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// InputChanges describes how the inputs of a workflow changed since the
+// fingerprint recorded in the Resource status.
+type InputChanges struct {
+	// Fingerprint is the fingerprint of the current inputs, to be recorded with
+	// RecordFingerprint once the workflow succeeds.
+	Fingerprint Fingerprint
+	// Previous is the recorded fingerprint, or nil if none was recorded.
+	Previous *Fingerprint
+	// Fields are the paths of the fields that were added, removed or changed,
+	// e.g. spec.dbConfig.size or metadata.labels.team.
+	Fields []string
+}
+
+// Changed returns true if there is no recorded fingerprint or the inputs
+// changed since it was recorded.
+func (c InputChanges) Changed() bool {
+	return c.Previous == nil || c.Previous.Hash != c.Fingerprint.Hash
+}
+
+// NewFingerprint computes the fingerprint of the Resource spec and of the
+// inputs selected by the options.
+func NewFingerprint(res Resource, opts FingerprintOptions) (Fingerprint, error) {
+	fields := map[string]string{}
+
+	spec, err := res.GetValue("spec")
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return Fingerprint{}, err
+	}
+	if err := hashFields(fields, "spec", spec); err != nil {
+		return Fingerprint{}, err
+	}
+
+	labels := res.GetLabels()
+	for _, key := range opts.Labels {
+		if value, ok := labels[key]; ok {
+			fields["metadata.labels."+escapePathKey(key)] = hashValue([]byte(value))
+		}
+	}
+	annotations := res.GetAnnotations()
+	for _, key := range opts.Annotations {
+		if value, ok := annotations[key]; ok {
+			fields["metadata.annotations."+escapePathKey(key)] = hashValue([]byte(value))
+		}
+	}
+	if opts.PromiseVersion != "" {
+		fields["promiseVersion"] = hashValue([]byte(opts.PromiseVersion))
+	}
+	if opts.PipelineVersion != "" {
+		fields["pipelineVersion"] = hashValue([]byte(opts.PipelineVersion))
+	}
+
+	h := sha256.New()
+	for _, path := range slices.Sorted(maps.Keys(fields)) {
+		fmt.Fprintf(h, "%s=%s\n", path, fields[path])
+	}
+	return Fingerprint{Hash: "sha256:" + hex.EncodeToString(h.Sum(nil)), Fields: fields}, nil
+}
+
+// Diff returns the sorted paths of the fields that were added, removed or
+// changed since the previous fingerprint.
+func (f Fingerprint) Diff(previous Fingerprint) []string {
+	var changed []string
+	for path, hash := range f.Fields {
+		if previous.Fields[path] != hash {
+			changed = append(changed, path)
+		}
+	}
+	for path := range previous.Fields {
+		if _, found := f.Fields[path]; !found {
+			changed = append(changed, path)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
+
+// DetectInputChanges compares the inputs of the Resource with the fingerprint
+// recorded in its status by a previous successful run. Expensive workflows can
+// skip regeneration when nothing changed:
+//
+//	changes, err := kratix.DetectInputChanges(resource, opts)
+//	if !changes.Changed() {
+//		return nil
+//	}
+//	// ... regenerate, then record the fingerprint
+//	kratix.RecordFingerprint(status, changes.Fingerprint)
+func DetectInputChanges(res Resource, opts FingerprintOptions) (InputChanges, error) {
+	current, err := NewFingerprint(res, opts)
+	if err != nil {
+		return InputChanges{}, err
+	}
+	status, err := res.GetStatus()
+	if err != nil {
+		return InputChanges{}, err
+	}
+	previous, found, err := ReadFingerprint(status)
+	if err != nil {
+		return InputChanges{}, err
+	}
+
+	changes := InputChanges{Fingerprint: current}
+	if !found {
+		changes.Fields = current.Diff(Fingerprint{})
+		return changes, nil
+	}
+	changes.Previous = &previous
+	changes.Fields = current.Diff(previous)
+	return changes, nil
+}
+
+// ReadFingerprint returns the fingerprint recorded in the status, and false if
+// there is none.
+func ReadFingerprint(status Status) (Fingerprint, bool, error) {
+	recorded := status.Get(FingerprintStatusKey)
+	if recorded == nil {
+		return Fingerprint{}, false, nil
+	}
+	data, err := json.Marshal(recorded)
+	if err != nil {
+		return Fingerprint{}, false, fmt.Errorf("marshal input fingerprint: %w", err)
+	}
+	var f Fingerprint
+	if err := json.Unmarshal(data, &f); err != nil {
+		return Fingerprint{}, false, fmt.Errorf("unmarshal input fingerprint: %w", err)
+	}
+	return f, true, nil
+}
+
+// RecordFingerprint records the fingerprint in the status, to be compared
+// against by the next run once the status is written or published.
+func RecordFingerprint(status Status, f Fingerprint) error {
+	return status.Set(FingerprintStatusKey, f)
+}
+
+// hashFields records the hash of every leaf value under path. Lists are hashed
+// as a whole.
+func hashFields(fields map[string]string, path string, val any) error {
+	if m, ok := val.(map[string]any); ok && len(m) > 0 {
+		for key, child := range m {
+			if err := hashFields(fields, path+"."+escapePathKey(key), child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if val == nil {
+		return nil
+	}
+	data, err := json.Marshal(val)
+	if err != nil {
+		return fmt.Errorf("path %s: %w", path, err)
+	}
+	fields[path] = hashValue(data)
+	return nil
+}
+
+// escapePathKey escapes the key so it can be used as an element of the paths
+// accepted by GetValue.
+func escapePathKey(key string) string {
+	return strings.NewReplacer(`\`, `\\`, ".", `\.`, "[", `\[`).Replace(key)
+}
+
+func hashValue(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
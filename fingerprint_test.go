@@ -0,0 +1,129 @@
+package kratix
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("Input fingerprinting", func() {
+	var (
+		resource *ResourceImpl
+		opts     FingerprintOptions
+	)
+
+	BeforeEach(func() {
+		resource = &ResourceImpl{obj: unstructured.Unstructured{Object: map[string]any{
+			"metadata": map[string]any{
+				"name": "my-resource",
+				"labels": map[string]any{
+					"team":   "data",
+					"ignore": "me",
+				},
+				"annotations": map[string]any{
+					"kratix.io/tier": "gold",
+				},
+			},
+			"spec": map[string]any{
+				"replicas": int64(3),
+				"dbConfig": map[string]any{
+					"size": "small",
+					"type": "postgres",
+				},
+				"features": []any{"logging"},
+			},
+		}}}
+		opts = FingerprintOptions{
+			Labels:          []string{"team"},
+			Annotations:     []string{"kratix.io/tier"},
+			PromiseVersion:  "v1.0.0",
+			PipelineVersion: "1",
+		}
+	})
+
+	record := func() {
+		changes, err := DetectInputChanges(resource, opts)
+		Expect(err).ToNot(HaveOccurred())
+		status, err := resource.GetStatus()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(RecordFingerprint(status, changes.Fingerprint)).To(Succeed())
+		resource.obj.Object["status"] = status.ToMap()
+	}
+
+	It("fingerprints the spec and the selected inputs", func() {
+		f, err := NewFingerprint(resource, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Hash).To(HavePrefix("sha256:"))
+		Expect(f.Fields).To(HaveLen(8))
+		Expect(f.Fields).To(SatisfyAll(
+			HaveKey("spec.replicas"),
+			HaveKey("spec.dbConfig.size"),
+			HaveKey("spec.dbConfig.type"),
+			HaveKey("spec.features"),
+			HaveKey("metadata.labels.team"),
+			HaveKey(`metadata.annotations.kratix\.io/tier`),
+			HaveKey("promiseVersion"),
+			HaveKey("pipelineVersion"),
+		))
+
+		again, err := NewFingerprint(resource, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(again).To(Equal(f))
+	})
+
+	It("reports every field as changed when there is no recorded fingerprint", func() {
+		changes, err := DetectInputChanges(resource, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes.Changed()).To(BeTrue())
+		Expect(changes.Previous).To(BeNil())
+		Expect(changes.Fields).To(HaveLen(8))
+	})
+
+	It("reports no changes when the inputs are the same as the recorded ones", func() {
+		record()
+		resource.SetLabel("ignore", "changed")
+
+		changes, err := DetectInputChanges(resource, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes.Changed()).To(BeFalse())
+		Expect(changes.Fields).To(BeEmpty())
+	})
+
+	It("reports the fields that changed since the recorded fingerprint", func() {
+		record()
+		Expect(resource.SetValue("spec.dbConfig.size", "large")).To(Succeed())
+		Expect(resource.SetValue("spec.region", "eu-west-2")).To(Succeed())
+		delete(resource.obj.Object["spec"].(map[string]any), "features")
+		resource.SetAnnotation("kratix.io/tier", "silver")
+		opts.PipelineVersion = "2"
+
+		changes, err := DetectInputChanges(resource, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes.Changed()).To(BeTrue())
+		Expect(changes.Previous).ToNot(BeNil())
+		Expect(changes.Fields).To(Equal([]string{
+			`metadata.annotations.kratix\.io/tier`,
+			"pipelineVersion",
+			"spec.dbConfig.size",
+			"spec.features",
+			"spec.region",
+		}))
+	})
+
+	It("reads back the fingerprint recorded in the status", func() {
+		f, err := NewFingerprint(resource, opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		status := NewStatus()
+		_, found, err := ReadFingerprint(status)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+
+		Expect(RecordFingerprint(status, f)).To(Succeed())
+		recorded, found, err := ReadFingerprint(status)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(recorded).To(Equal(f))
+	})
+})
@@ -0,0 +1,162 @@
+package kratix
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/syntasso/kratix-go/internal/objutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ImmutableFieldsStatusKey is the status key the snapshot of the immutable
+	// fields is recorded under.
+	ImmutableFieldsStatusKey = "immutableFields"
+
+	// ImmutableFieldsConditionType is the type of the condition returned by
+	// ImmutableFieldError.Condition.
+	ImmutableFieldsConditionType = "ImmutableFieldsUnchanged"
+)
+
+// ImmutableFieldViolation describes an immutable field whose value changed
+// since it was snapshotted.
+type ImmutableFieldViolation struct {
+	Path     string
+	Previous any
+	Current  any
+}
+
+// ImmutableFieldError is returned by CheckImmutableFields when immutable
+// fields changed.
+type ImmutableFieldError struct {
+	Violations []ImmutableFieldViolation
+}
+
+func (e *ImmutableFieldError) Error() string {
+	changes := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		changes = append(changes, fmt.Sprintf("%s changed from %v to %v", v.Path, formatFieldValue(v.Previous), formatFieldValue(v.Current)))
+	}
+	return "immutable fields changed: " + strings.Join(changes, ", ")
+}
+
+// Condition returns a failed condition describing the violations, to be
+// published in the Resource status.
+func (e *ImmutableFieldError) Condition() metav1.Condition {
+	return metav1.Condition{
+		Type:               ImmutableFieldsConditionType,
+		Status:             metav1.ConditionFalse,
+		Reason:             "ImmutableFieldChanged",
+		Message:            e.Error(),
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+func formatFieldValue(v any) string {
+	if v == nil {
+		return "<unset>"
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// SnapshotImmutableFields records the current values of the fields at the
+// provided paths in the status, to be checked by CheckImmutableFields on later
+// runs. It should be called once the workflow succeeds. Values already in the
+// snapshot of the Resource status are kept, so fields are snapshotted on the
+// first successful run only. Fields that are not set are not snapshotted.
+func SnapshotImmutableFields(res Resource, status Status, paths ...string) error {
+	snapshot, err := readImmutableFields(res)
+	if err != nil {
+		return err
+	}
+	snapshot = maps.Clone(snapshot)
+	if snapshot == nil {
+		snapshot = map[string]any{}
+	}
+
+	for _, path := range paths {
+		if _, found := snapshot[path]; found {
+			continue
+		}
+		val, err := res.GetValue(path)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		snapshot[path] = val
+	}
+	return status.Set(ImmutableFieldsStatusKey, snapshot)
+}
+
+// CheckImmutableFields compares the fields at the provided paths with the
+// snapshot recorded in the Resource status by SnapshotImmutableFields. It
+// returns an *ImmutableFieldError listing the fields whose value changed or
+// that were removed. Fields without a snapshot, e.g. on the first run, are not
+// checked.
+func CheckImmutableFields(res Resource, paths ...string) error {
+	snapshot, err := readImmutableFields(res)
+	if err != nil {
+		return err
+	}
+
+	var violations []ImmutableFieldViolation
+	for _, path := range paths {
+		previous, found := snapshot[path]
+		if !found {
+			continue
+		}
+		current, err := res.GetValue(path)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+		equal, err := sameFieldValue(previous, current)
+		if err != nil {
+			return fmt.Errorf("path %s: %w", path, err)
+		}
+		if !equal {
+			violations = append(violations, ImmutableFieldViolation{Path: path, Previous: previous, Current: current})
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	slices.SortFunc(violations, func(a, b ImmutableFieldViolation) int { return strings.Compare(a.Path, b.Path) })
+	return &ImmutableFieldError{Violations: violations}
+}
+
+func readImmutableFields(res Resource) (map[string]any, error) {
+	status, err := res.GetStatus()
+	if err != nil {
+		return nil, err
+	}
+	recorded := status.Get(ImmutableFieldsStatusKey)
+	if recorded == nil {
+		return nil, nil
+	}
+	snapshot, ok := recorded.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("status %s: expected a map, found %T", ImmutableFieldsStatusKey, recorded)
+	}
+	return snapshot, nil
+}
+
+// sameFieldValue compares the values as JSON, so that numbers decoded with
+// different types compare equal.
+func sameFieldValue(a, b any) (bool, error) {
+	a, err := objutil.ToJSON(a)
+	if err != nil {
+		return false, err
+	}
+	b, err = objutil.ToJSON(b)
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(a, b), nil
+}
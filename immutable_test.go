@@ -0,0 +1,100 @@
+package kratix
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("Immutable fields", func() {
+	var (
+		resource *ResourceImpl
+		paths    []string
+	)
+
+	BeforeEach(func() {
+		resource = &ResourceImpl{obj: unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{
+				"region":   "eu-west-2",
+				"replicas": int64(3),
+				"dbConfig": map[string]any{
+					"type": "postgres",
+					"size": "small",
+				},
+			},
+		}}}
+		paths = []string{"spec.dbConfig.type", "spec.region", "spec.replicas", "spec.zone"}
+	})
+
+	snapshot := func() {
+		status, err := resource.GetStatus()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(SnapshotImmutableFields(resource, status, paths...)).To(Succeed())
+		resource.obj.Object["status"] = status.ToMap()
+	}
+
+	It("does not check fields before they are snapshotted", func() {
+		Expect(CheckImmutableFields(resource, paths...)).To(Succeed())
+	})
+
+	It("snapshots the fields that are set", func() {
+		snapshot()
+		status, err := resource.GetStatus()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(status.Get(ImmutableFieldsStatusKey)).To(Equal(map[string]any{
+			"spec.dbConfig.type": "postgres",
+			"spec.region":        "eu-west-2",
+			"spec.replicas":      int64(3),
+		}))
+	})
+
+	It("accepts changes to fields that are not immutable", func() {
+		snapshot()
+		Expect(resource.SetValue("spec.dbConfig.size", "large")).To(Succeed())
+		Expect(resource.SetValue("spec.replicas", 3)).To(Succeed())
+
+		Expect(CheckImmutableFields(resource, paths...)).To(Succeed())
+	})
+
+	It("returns the violations when immutable fields change", func() {
+		snapshot()
+		Expect(resource.SetValue("spec.region", "us-east-1")).To(Succeed())
+		Expect(resource.SetValue("spec.dbConfig.type", "mysql")).To(Succeed())
+		delete(resource.obj.Object["spec"].(map[string]any), "replicas")
+
+		err := CheckImmutableFields(resource, paths...)
+		var immutableErr *ImmutableFieldError
+		Expect(errors.As(err, &immutableErr)).To(BeTrue())
+		Expect(immutableErr.Violations).To(Equal([]ImmutableFieldViolation{
+			{Path: "spec.dbConfig.type", Previous: "postgres", Current: "mysql"},
+			{Path: "spec.region", Previous: "eu-west-2", Current: "us-east-1"},
+			{Path: "spec.replicas", Previous: int64(3), Current: nil},
+		}))
+		Expect(err).To(MatchError("immutable fields changed: spec.dbConfig.type changed from postgres to mysql, " +
+			"spec.region changed from eu-west-2 to us-east-1, spec.replicas changed from 3 to <unset>"))
+
+		condition := immutableErr.Condition()
+		Expect(condition.Type).To(Equal(ImmutableFieldsConditionType))
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("ImmutableFieldChanged"))
+		Expect(condition.Message).To(Equal(err.Error()))
+	})
+
+	It("keeps the values snapshotted on the first run", func() {
+		snapshot()
+		Expect(resource.SetValue("spec.region", "us-east-1")).To(Succeed())
+		Expect(resource.SetValue("spec.zone", "a")).To(Succeed())
+		snapshot()
+
+		status, err := resource.GetStatus()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(status.Get(ImmutableFieldsStatusKey)).To(SatisfyAll(
+			HaveKeyWithValue("spec.region", "eu-west-2"),
+			HaveKeyWithValue("spec.zone", "a"),
+		))
+	})
+})
@@ -0,0 +1,76 @@
+// Package objutil encodes and decodes the YAML and JSON of unstructured
+// objects, keeping integers as int64 like unstructured objects do rather than
+// converting them to float64.
+package objutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"sigs.k8s.io/yaml"
+)
+
+// UnmarshalObject decodes the YAML or JSON of an object into a map.
+func UnmarshalObject(data []byte) (map[string]any, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]any
+	if err := utiljson.Unmarshal(jsonData, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// ToJSON converts the value into the types used by unstructured objects, so
+// typed values compare with parsed ones.
+func ToJSON(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal value: %w", err)
+	}
+	var out any
+	if err := utiljson.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("unmarshal value: %w", err)
+	}
+	return out, nil
+}
+
+// ToJSONObject converts the object like ToJSON, returning an empty map for a
+// nil object.
+func ToJSONObject(obj map[string]any) (map[string]any, error) {
+	out, err := ToJSON(obj)
+	if err != nil {
+		return nil, err
+	}
+	converted, _ := out.(map[string]any)
+	if converted == nil {
+		converted = map[string]any{}
+	}
+	return converted, nil
+}
+
+// WriteYAML writes the value to the file as YAML.
+func WriteYAML(path string, v any) error {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", filepath.Base(path), err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
+
+// IsYAMLFile returns true for the files read as YAML, JSON being YAML.
+func IsYAMLFile(path string) bool {
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
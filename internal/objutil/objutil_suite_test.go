@@ -0,0 +1,13 @@
+package objutil_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestObjutil(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Objutil Suite")
+}
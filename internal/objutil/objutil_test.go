@@ -0,0 +1,56 @@
+package objutil_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/syntasso/kratix-go/internal/objutil"
+)
+
+var _ = Describe("objutil", func() {
+	It("decodes YAML and JSON objects keeping integers as int64", func() {
+		Expect(objutil.UnmarshalObject([]byte("spec:\n  replicas: 2\n  ratio: 0.5\n"))).To(Equal(map[string]any{
+			"spec": map[string]any{"replicas": int64(2), "ratio": 0.5},
+		}))
+		Expect(objutil.UnmarshalObject([]byte(`{"replicas": 2}`))).To(Equal(map[string]any{"replicas": int64(2)}))
+
+		_, err := objutil.UnmarshalObject([]byte("- not\n- an object\n"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("converts typed values to the types of unstructured objects", func() {
+		type spec struct {
+			Replicas int      `json:"replicas"`
+			Names    []string `json:"names,omitempty"`
+		}
+		Expect(objutil.ToJSON(spec{Replicas: 2})).To(Equal(map[string]any{"replicas": int64(2)}))
+		Expect(objutil.ToJSON([]int{1})).To(Equal([]any{int64(1)}))
+
+		_, err := objutil.ToJSON(func() {})
+		Expect(err).To(MatchError(ContainSubstring("marshal value")))
+	})
+
+	It("converts nil objects to empty ones", func() {
+		Expect(objutil.ToJSONObject(nil)).To(Equal(map[string]any{}))
+		Expect(objutil.ToJSONObject(map[string]any{"size": 1})).To(Equal(map[string]any{"size": int64(1)}))
+	})
+
+	It("writes YAML files", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "object.yaml")
+		Expect(objutil.WriteYAML(path, map[string]any{"kind": "ConfigMap"})).To(Succeed())
+		Expect(os.ReadFile(path)).To(BeEquivalentTo("kind: ConfigMap\n"))
+
+		err := objutil.WriteYAML(filepath.Join(path, "nested.yaml"), map[string]any{})
+		Expect(err).To(MatchError(ContainSubstring("write nested.yaml")))
+	})
+
+	It("recognises YAML and JSON files", func() {
+		Expect(objutil.IsYAMLFile("object.yaml")).To(BeTrue())
+		Expect(objutil.IsYAMLFile("object.yml")).To(BeTrue())
+		Expect(objutil.IsYAMLFile("object.json")).To(BeTrue())
+		Expect(objutil.IsYAMLFile("object.txt")).To(BeFalse())
+	})
+})
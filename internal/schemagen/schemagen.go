@@ -0,0 +1,260 @@
+// Package schemagen generates resource requests from the schema of a Promise
+// API. It walks the schema within its bounds and draws the choices the schema
+// leaves open, like optional fields, enum values and lengths, from a Source.
+package schemagen
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	kratix "github.com/syntasso/kratix-go"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/json"
+)
+
+// UnboundedRange is the range of numbers generated for fields without bounds.
+const UnboundedRange = 1000
+
+// maxExtraItems is the number of items generated above the minimum of arrays
+// and maps.
+const maxExtraItems = 4
+
+// FormatValues are valid values of the string formats, the first being the
+// most typical.
+var FormatValues = map[string][]string{
+	"date":      {"2025-01-01", "1970-01-01", "2038-01-19"},
+	"date-time": {"2025-01-01T00:00:00Z", "1970-01-01T00:00:00Z", "2038-01-19T03:14:07+01:00"},
+	"email":     {"user@example.com", "a.b+c@example.org"},
+	"hostname":  {"example.com", "a", "my-host.internal"},
+	"ipv4":      {"192.0.2.1", "0.0.0.0", "255.255.255.255"},
+	"ipv6":      {"2001:db8::1", "::1"},
+	"uri":       {"https://example.com", "file:///etc/passwd", "http://[::1]:80/a/../b"},
+	"uuid":      {"00000000-0000-4000-8000-000000000000", "ffffffff-ffff-4fff-bfff-ffffffffffff"},
+	"duration":  {"1h", "0s", "1h30m15s"},
+	"byte":      {"ZXhhbXBsZQ==", ""},
+}
+
+// Source makes the choices the schema leaves open.
+type Source interface {
+	// Preset returns the value of the field when it is not generated, e.g.
+	// its default.
+	Preset(schema *apiextensionsv1.JSONSchemaProps) (any, bool)
+	// Choose returns a number in [0, n), e.g. the index of an enum value.
+	Choose(n int) int
+	// Bool returns the value of booleans, and whether optional properties
+	// are set.
+	Bool() bool
+	// Count returns the number of items of arrays, and of the additional
+	// properties of maps, in [lo, hi].
+	Count(lo, hi int64) int64
+	// Key returns the name of the i-th additional property of a map.
+	Key(i int64) string
+	// String returns a value of a string without a known format.
+	String(schema *apiextensionsv1.JSONSchemaProps) string
+	// Integer returns a value in [lo, hi], the bounds of the schema.
+	Integer(schema *apiextensionsv1.JSONSchemaProps, lo, hi int64) int64
+	// Number returns a value within the bounds of the schema.
+	Number(schema *apiextensionsv1.JSONSchemaProps) float64
+}
+
+// Resource generates a resource request of the Promise API, at the storage
+// version when version is empty. The namespace is only set on namespaced
+// APIs. Metadata and status are not generated, and spec is always set as
+// requests without one are rarely useful.
+func Resource(promise kratix.Promise, version, name, namespace string, src Source) (*unstructured.Unstructured, error) {
+	crd, err := promise.GetCRD()
+	if err != nil {
+		return nil, err
+	}
+	if version == "" {
+		if version, err = promise.GetStorageVersion(); err != nil {
+			return nil, err
+		}
+	}
+	schema, err := promise.GetAPISchema(version)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := map[string]any{}
+	for _, prop := range slices.Sorted(maps.Keys(schema.Properties)) {
+		if slices.Contains([]string{"apiVersion", "kind", "metadata", "status"}, prop) {
+			continue
+		}
+		if prop == "spec" || slices.Contains(schema.Required, prop) || src.Bool() {
+			propSchema := schema.Properties[prop]
+			obj[prop] = Value(&propSchema, src)
+		}
+	}
+
+	resource := &unstructured.Unstructured{Object: obj}
+	resource.SetAPIVersion(crd.Spec.Group + "/" + version)
+	resource.SetKind(crd.Spec.Names.Kind)
+	resource.SetName(name)
+	if crd.Spec.Scope != apiextensionsv1.ClusterScoped {
+		resource.SetNamespace(namespace)
+	}
+	return resource, nil
+}
+
+// Value generates a value of the schema: its preset value, else one of its
+// enum values, else a value of its type.
+func Value(schema *apiextensionsv1.JSONSchemaProps, src Source) any {
+	if v, ok := src.Preset(schema); ok {
+		return v
+	}
+	if len(schema.Enum) > 0 {
+		if v, ok := RawValue(&schema.Enum[src.Choose(len(schema.Enum))]); ok {
+			return v
+		}
+	}
+	if schema.XIntOrString {
+		if src.Choose(2) == 0 {
+			return integer(schema, src)
+		}
+		return str(schema, src)
+	}
+
+	switch schema.Type {
+	case "string":
+		return str(schema, src)
+	case "integer":
+		return integer(schema, src)
+	case "number":
+		// multiples of fractions are not exact in floating point
+		if schema.MultipleOf != nil {
+			return integer(schema, src)
+		}
+		return src.Number(schema)
+	case "boolean":
+		return src.Bool()
+	case "array":
+		return array(schema, src)
+	}
+	return object(schema, src)
+}
+
+func object(schema *apiextensionsv1.JSONSchemaProps, src Source) map[string]any {
+	obj := map[string]any{}
+	for _, prop := range slices.Sorted(maps.Keys(schema.Properties)) {
+		if slices.Contains(schema.Required, prop) || src.Bool() {
+			propSchema := schema.Properties[prop]
+			obj[prop] = Value(&propSchema, src)
+		}
+	}
+	if len(schema.Properties) > 0 {
+		return obj
+	}
+
+	var valueSchema *apiextensionsv1.JSONSchemaProps
+	switch {
+	case schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil:
+		valueSchema = schema.AdditionalProperties.Schema
+	case schema.XPreserveUnknownFields != nil && *schema.XPreserveUnknownFields:
+		valueSchema = &apiextensionsv1.JSONSchemaProps{Type: "string"}
+	default:
+		return obj
+	}
+	for i := range src.Count(0, maxExtraItems) {
+		obj[src.Key(i)] = Value(valueSchema, src)
+	}
+	return obj
+}
+
+func array(schema *apiextensionsv1.JSONSchemaProps, src Source) []any {
+	items := []any{}
+	if schema.Items == nil || schema.Items.Schema == nil {
+		return items
+	}
+	lo := int64(0)
+	if schema.MinItems != nil {
+		lo = *schema.MinItems
+	}
+	hi := lo + maxExtraItems
+	if schema.MaxItems != nil {
+		hi = min(hi, *schema.MaxItems)
+	}
+
+	isSet := schema.XListType != nil && *schema.XListType == "set"
+	for i := range src.Count(lo, hi) {
+		item := Value(schema.Items.Schema, src)
+		if isSet && contains(items, item) {
+			// make repeated strings unique, skip other repeated items
+			s, ok := item.(string)
+			if !ok {
+				continue
+			}
+			if item = fmt.Sprintf("%s-%d", s, i); contains(items, item) {
+				continue
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func contains(items []any, item any) bool {
+	return slices.ContainsFunc(items, func(existing any) bool { return fmt.Sprint(existing) == fmt.Sprint(item) })
+}
+
+func str(schema *apiextensionsv1.JSONSchemaProps, src Source) string {
+	if values, ok := FormatValues[schema.Format]; ok {
+		return values[src.Choose(len(values))]
+	}
+	return src.String(schema)
+}
+
+// integer generates an integer within the bounds of the schema, a multiple of
+// its multipleOf when it can be.
+func integer(schema *apiextensionsv1.JSONSchemaProps, src Source) int64 {
+	lo, hi := int64(-UnboundedRange), int64(UnboundedRange)
+	if schema.Minimum != nil {
+		lo = int64(math.Ceil(*schema.Minimum))
+		if schema.ExclusiveMinimum && float64(lo) == *schema.Minimum {
+			lo++
+		}
+		if schema.Maximum == nil {
+			hi = lo + UnboundedRange
+		}
+	}
+	if schema.Maximum != nil {
+		hi = int64(math.Floor(*schema.Maximum))
+		if schema.ExclusiveMaximum && float64(hi) == *schema.Maximum {
+			hi--
+		}
+		if schema.Minimum == nil {
+			lo = hi - UnboundedRange
+		}
+	}
+	if hi < lo {
+		return lo
+	}
+
+	v := src.Integer(schema, lo, hi)
+	if schema.MultipleOf != nil && *schema.MultipleOf >= 1 {
+		step := int64(*schema.MultipleOf)
+		// round up, or down when that is above the maximum
+		if rem := ((v % step) + step) % step; rem != 0 {
+			v += step - rem
+			if v > hi {
+				v -= step
+			}
+		}
+	}
+	return v
+}
+
+// RawValue decodes a default, example or enum value of the schema.
+func RawValue(raw *apiextensionsv1.JSON) (any, bool) {
+	if raw == nil || len(raw.Raw) == 0 {
+		return nil, false
+	}
+	var v any
+	if err := json.Unmarshal(raw.Raw, &v); err != nil {
+		return nil, false
+	}
+	return v, true
+}
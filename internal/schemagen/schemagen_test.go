@@ -0,0 +1,73 @@
+package schemagen_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/syntasso/kratix-go/internal/schemagen"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/json"
+)
+
+// highest makes the last choice everywhere: every optional field, the most
+// items and the highest numbers.
+type highest struct{}
+
+func (highest) Preset(*apiextensionsv1.JSONSchemaProps) (any, bool) { return nil, false }
+func (highest) Choose(n int) int                                    { return n - 1 }
+func (highest) Bool() bool                                          { return true }
+func (highest) Count(_, hi int64) int64                             { return hi }
+func (highest) Key(i int64) string                                  { return string(rune('a' + i)) }
+func (highest) String(*apiextensionsv1.JSONSchemaProps) string      { return "same" }
+func (highest) Number(s *apiextensionsv1.JSONSchemaProps) float64   { return *s.Maximum }
+func (highest) Integer(_ *apiextensionsv1.JSONSchemaProps, _, hi int64) int64 {
+	return hi
+}
+
+func schemaOf(data string) *apiextensionsv1.JSONSchemaProps {
+	schema := &apiextensionsv1.JSONSchemaProps{}
+	Expect(json.Unmarshal([]byte(data), schema)).To(Succeed())
+	return schema
+}
+
+var _ = Describe("Value", func() {
+	It("draws the choices the schema leaves open from the source", func() {
+		value := schemagen.Value(schemaOf(`{
+			"type": "object",
+			"properties": {
+				"size": {"type": "string", "enum": ["small", "large"]},
+				"enabled": {"type": "boolean"},
+				"created": {"type": "string", "format": "ipv6"},
+				"ratio": {"type": "number", "maximum": 0.5},
+				"port": {"x-kubernetes-int-or-string": true},
+				"labels": {"type": "object", "additionalProperties": {"type": "string"}}
+			}
+		}`), highest{})
+
+		Expect(value).To(Equal(map[string]any{
+			"size":    "large",
+			"enabled": true,
+			"created": "::1",
+			"ratio":   0.5,
+			"port":    "same",
+			"labels":  map[string]any{"a": "same", "b": "same", "c": "same", "d": "same"},
+		}))
+	})
+
+	It("keeps integers within the bounds, rounding multiples down when above the maximum", func() {
+		Expect(schemagen.Value(schemaOf(`{"type": "integer", "minimum": 1, "maximum": 9, "multipleOf": 4}`), highest{})).To(Equal(int64(8)))
+		Expect(schemagen.Value(schemaOf(`{"type": "integer", "maximum": 9, "exclusiveMaximum": true}`), highest{})).To(Equal(int64(8)))
+		Expect(schemagen.Value(schemaOf(`{"type": "number", "minimum": 3, "maximum": 9, "multipleOf": 2}`), highest{})).To(Equal(int64(8)))
+	})
+
+	It("keeps the items of sets unique", func() {
+		Expect(schemagen.Value(schemaOf(`{
+			"type": "array", "maxItems": 3, "x-kubernetes-list-type": "set",
+			"items": {"type": "string"}
+		}`), highest{})).To(Equal([]any{"same", "same-1", "same-2"}))
+		Expect(schemagen.Value(schemaOf(`{
+			"type": "array", "maxItems": 3, "x-kubernetes-list-type": "set",
+			"items": {"type": "boolean"}
+		}`), highest{})).To(Equal([]any{true}))
+	})
+})
@@ -0,0 +1,386 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package kratixgofakes
+
+import (
+	"sync"
+
+	kratix "github.com/syntasso/kratix-go"
+)
+
+type FakeInputReader struct {
+	ReadDestinationSelectorsStub        func() ([]kratix.DestinationSelector, error)
+	readDestinationSelectorsMutex       sync.RWMutex
+	readDestinationSelectorsArgsForCall []struct {
+	}
+	readDestinationSelectorsReturns struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}
+	readDestinationSelectorsReturnsOnCall map[int]struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}
+	ReadEffectiveDestinationSelectorsStub        func(kratix.Promise) ([]kratix.DestinationSelector, error)
+	readEffectiveDestinationSelectorsMutex       sync.RWMutex
+	readEffectiveDestinationSelectorsArgsForCall []struct {
+		arg1 kratix.Promise
+	}
+	readEffectiveDestinationSelectorsReturns struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}
+	readEffectiveDestinationSelectorsReturnsOnCall map[int]struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}
+	ReadPromiseInputStub        func() (kratix.Promise, error)
+	readPromiseInputMutex       sync.RWMutex
+	readPromiseInputArgsForCall []struct {
+	}
+	readPromiseInputReturns struct {
+		result1 kratix.Promise
+		result2 error
+	}
+	readPromiseInputReturnsOnCall map[int]struct {
+		result1 kratix.Promise
+		result2 error
+	}
+	ReadResourceInputStub        func() (kratix.Resource, error)
+	readResourceInputMutex       sync.RWMutex
+	readResourceInputArgsForCall []struct {
+	}
+	readResourceInputReturns struct {
+		result1 kratix.Resource
+		result2 error
+	}
+	readResourceInputReturnsOnCall map[int]struct {
+		result1 kratix.Resource
+		result2 error
+	}
+	ReadStatusStub        func() (kratix.Status, error)
+	readStatusMutex       sync.RWMutex
+	readStatusArgsForCall []struct {
+	}
+	readStatusReturns struct {
+		result1 kratix.Status
+		result2 error
+	}
+	readStatusReturnsOnCall map[int]struct {
+		result1 kratix.Status
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInputReader) ReadDestinationSelectors() ([]kratix.DestinationSelector, error) {
+	fake.readDestinationSelectorsMutex.Lock()
+	ret, specificReturn := fake.readDestinationSelectorsReturnsOnCall[len(fake.readDestinationSelectorsArgsForCall)]
+	fake.readDestinationSelectorsArgsForCall = append(fake.readDestinationSelectorsArgsForCall, struct {
+	}{})
+	stub := fake.ReadDestinationSelectorsStub
+	fakeReturns := fake.readDestinationSelectorsReturns
+	fake.recordInvocation("ReadDestinationSelectors", []interface{}{})
+	fake.readDestinationSelectorsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInputReader) ReadDestinationSelectorsCallCount() int {
+	fake.readDestinationSelectorsMutex.RLock()
+	defer fake.readDestinationSelectorsMutex.RUnlock()
+	return len(fake.readDestinationSelectorsArgsForCall)
+}
+
+func (fake *FakeInputReader) ReadDestinationSelectorsCalls(stub func() ([]kratix.DestinationSelector, error)) {
+	fake.readDestinationSelectorsMutex.Lock()
+	defer fake.readDestinationSelectorsMutex.Unlock()
+	fake.ReadDestinationSelectorsStub = stub
+}
+
+func (fake *FakeInputReader) ReadDestinationSelectorsReturns(result1 []kratix.DestinationSelector, result2 error) {
+	fake.readDestinationSelectorsMutex.Lock()
+	defer fake.readDestinationSelectorsMutex.Unlock()
+	fake.ReadDestinationSelectorsStub = nil
+	fake.readDestinationSelectorsReturns = struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputReader) ReadDestinationSelectorsReturnsOnCall(i int, result1 []kratix.DestinationSelector, result2 error) {
+	fake.readDestinationSelectorsMutex.Lock()
+	defer fake.readDestinationSelectorsMutex.Unlock()
+	fake.ReadDestinationSelectorsStub = nil
+	if fake.readDestinationSelectorsReturnsOnCall == nil {
+		fake.readDestinationSelectorsReturnsOnCall = make(map[int]struct {
+			result1 []kratix.DestinationSelector
+			result2 error
+		})
+	}
+	fake.readDestinationSelectorsReturnsOnCall[i] = struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputReader) ReadEffectiveDestinationSelectors(arg1 kratix.Promise) ([]kratix.DestinationSelector, error) {
+	fake.readEffectiveDestinationSelectorsMutex.Lock()
+	ret, specificReturn := fake.readEffectiveDestinationSelectorsReturnsOnCall[len(fake.readEffectiveDestinationSelectorsArgsForCall)]
+	fake.readEffectiveDestinationSelectorsArgsForCall = append(fake.readEffectiveDestinationSelectorsArgsForCall, struct {
+		arg1 kratix.Promise
+	}{arg1})
+	stub := fake.ReadEffectiveDestinationSelectorsStub
+	fakeReturns := fake.readEffectiveDestinationSelectorsReturns
+	fake.recordInvocation("ReadEffectiveDestinationSelectors", []interface{}{arg1})
+	fake.readEffectiveDestinationSelectorsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInputReader) ReadEffectiveDestinationSelectorsCallCount() int {
+	fake.readEffectiveDestinationSelectorsMutex.RLock()
+	defer fake.readEffectiveDestinationSelectorsMutex.RUnlock()
+	return len(fake.readEffectiveDestinationSelectorsArgsForCall)
+}
+
+func (fake *FakeInputReader) ReadEffectiveDestinationSelectorsCalls(stub func(kratix.Promise) ([]kratix.DestinationSelector, error)) {
+	fake.readEffectiveDestinationSelectorsMutex.Lock()
+	defer fake.readEffectiveDestinationSelectorsMutex.Unlock()
+	fake.ReadEffectiveDestinationSelectorsStub = stub
+}
+
+func (fake *FakeInputReader) ReadEffectiveDestinationSelectorsArgsForCall(i int) kratix.Promise {
+	fake.readEffectiveDestinationSelectorsMutex.RLock()
+	defer fake.readEffectiveDestinationSelectorsMutex.RUnlock()
+	argsForCall := fake.readEffectiveDestinationSelectorsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeInputReader) ReadEffectiveDestinationSelectorsReturns(result1 []kratix.DestinationSelector, result2 error) {
+	fake.readEffectiveDestinationSelectorsMutex.Lock()
+	defer fake.readEffectiveDestinationSelectorsMutex.Unlock()
+	fake.ReadEffectiveDestinationSelectorsStub = nil
+	fake.readEffectiveDestinationSelectorsReturns = struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputReader) ReadEffectiveDestinationSelectorsReturnsOnCall(i int, result1 []kratix.DestinationSelector, result2 error) {
+	fake.readEffectiveDestinationSelectorsMutex.Lock()
+	defer fake.readEffectiveDestinationSelectorsMutex.Unlock()
+	fake.ReadEffectiveDestinationSelectorsStub = nil
+	if fake.readEffectiveDestinationSelectorsReturnsOnCall == nil {
+		fake.readEffectiveDestinationSelectorsReturnsOnCall = make(map[int]struct {
+			result1 []kratix.DestinationSelector
+			result2 error
+		})
+	}
+	fake.readEffectiveDestinationSelectorsReturnsOnCall[i] = struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputReader) ReadPromiseInput() (kratix.Promise, error) {
+	fake.readPromiseInputMutex.Lock()
+	ret, specificReturn := fake.readPromiseInputReturnsOnCall[len(fake.readPromiseInputArgsForCall)]
+	fake.readPromiseInputArgsForCall = append(fake.readPromiseInputArgsForCall, struct {
+	}{})
+	stub := fake.ReadPromiseInputStub
+	fakeReturns := fake.readPromiseInputReturns
+	fake.recordInvocation("ReadPromiseInput", []interface{}{})
+	fake.readPromiseInputMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInputReader) ReadPromiseInputCallCount() int {
+	fake.readPromiseInputMutex.RLock()
+	defer fake.readPromiseInputMutex.RUnlock()
+	return len(fake.readPromiseInputArgsForCall)
+}
+
+func (fake *FakeInputReader) ReadPromiseInputCalls(stub func() (kratix.Promise, error)) {
+	fake.readPromiseInputMutex.Lock()
+	defer fake.readPromiseInputMutex.Unlock()
+	fake.ReadPromiseInputStub = stub
+}
+
+func (fake *FakeInputReader) ReadPromiseInputReturns(result1 kratix.Promise, result2 error) {
+	fake.readPromiseInputMutex.Lock()
+	defer fake.readPromiseInputMutex.Unlock()
+	fake.ReadPromiseInputStub = nil
+	fake.readPromiseInputReturns = struct {
+		result1 kratix.Promise
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputReader) ReadPromiseInputReturnsOnCall(i int, result1 kratix.Promise, result2 error) {
+	fake.readPromiseInputMutex.Lock()
+	defer fake.readPromiseInputMutex.Unlock()
+	fake.ReadPromiseInputStub = nil
+	if fake.readPromiseInputReturnsOnCall == nil {
+		fake.readPromiseInputReturnsOnCall = make(map[int]struct {
+			result1 kratix.Promise
+			result2 error
+		})
+	}
+	fake.readPromiseInputReturnsOnCall[i] = struct {
+		result1 kratix.Promise
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputReader) ReadResourceInput() (kratix.Resource, error) {
+	fake.readResourceInputMutex.Lock()
+	ret, specificReturn := fake.readResourceInputReturnsOnCall[len(fake.readResourceInputArgsForCall)]
+	fake.readResourceInputArgsForCall = append(fake.readResourceInputArgsForCall, struct {
+	}{})
+	stub := fake.ReadResourceInputStub
+	fakeReturns := fake.readResourceInputReturns
+	fake.recordInvocation("ReadResourceInput", []interface{}{})
+	fake.readResourceInputMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInputReader) ReadResourceInputCallCount() int {
+	fake.readResourceInputMutex.RLock()
+	defer fake.readResourceInputMutex.RUnlock()
+	return len(fake.readResourceInputArgsForCall)
+}
+
+func (fake *FakeInputReader) ReadResourceInputCalls(stub func() (kratix.Resource, error)) {
+	fake.readResourceInputMutex.Lock()
+	defer fake.readResourceInputMutex.Unlock()
+	fake.ReadResourceInputStub = stub
+}
+
+func (fake *FakeInputReader) ReadResourceInputReturns(result1 kratix.Resource, result2 error) {
+	fake.readResourceInputMutex.Lock()
+	defer fake.readResourceInputMutex.Unlock()
+	fake.ReadResourceInputStub = nil
+	fake.readResourceInputReturns = struct {
+		result1 kratix.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputReader) ReadResourceInputReturnsOnCall(i int, result1 kratix.Resource, result2 error) {
+	fake.readResourceInputMutex.Lock()
+	defer fake.readResourceInputMutex.Unlock()
+	fake.ReadResourceInputStub = nil
+	if fake.readResourceInputReturnsOnCall == nil {
+		fake.readResourceInputReturnsOnCall = make(map[int]struct {
+			result1 kratix.Resource
+			result2 error
+		})
+	}
+	fake.readResourceInputReturnsOnCall[i] = struct {
+		result1 kratix.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputReader) ReadStatus() (kratix.Status, error) {
+	fake.readStatusMutex.Lock()
+	ret, specificReturn := fake.readStatusReturnsOnCall[len(fake.readStatusArgsForCall)]
+	fake.readStatusArgsForCall = append(fake.readStatusArgsForCall, struct {
+	}{})
+	stub := fake.ReadStatusStub
+	fakeReturns := fake.readStatusReturns
+	fake.recordInvocation("ReadStatus", []interface{}{})
+	fake.readStatusMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInputReader) ReadStatusCallCount() int {
+	fake.readStatusMutex.RLock()
+	defer fake.readStatusMutex.RUnlock()
+	return len(fake.readStatusArgsForCall)
+}
+
+func (fake *FakeInputReader) ReadStatusCalls(stub func() (kratix.Status, error)) {
+	fake.readStatusMutex.Lock()
+	defer fake.readStatusMutex.Unlock()
+	fake.ReadStatusStub = stub
+}
+
+func (fake *FakeInputReader) ReadStatusReturns(result1 kratix.Status, result2 error) {
+	fake.readStatusMutex.Lock()
+	defer fake.readStatusMutex.Unlock()
+	fake.ReadStatusStub = nil
+	fake.readStatusReturns = struct {
+		result1 kratix.Status
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputReader) ReadStatusReturnsOnCall(i int, result1 kratix.Status, result2 error) {
+	fake.readStatusMutex.Lock()
+	defer fake.readStatusMutex.Unlock()
+	fake.ReadStatusStub = nil
+	if fake.readStatusReturnsOnCall == nil {
+		fake.readStatusReturnsOnCall = make(map[int]struct {
+			result1 kratix.Status
+			result2 error
+		})
+	}
+	fake.readStatusReturnsOnCall[i] = struct {
+		result1 kratix.Status
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInputReader) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInputReader) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ kratix.InputReader = new(FakeInputReader)
@@ -0,0 +1,495 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package kratixgofakes
+
+import (
+	"sync"
+
+	kratix "github.com/syntasso/kratix-go"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type FakeOutputWriter struct {
+	WriteDependenciesStub        func(kratix.Promise, string, ...kratix.ObjectTransformer) error
+	writeDependenciesMutex       sync.RWMutex
+	writeDependenciesArgsForCall []struct {
+		arg1 kratix.Promise
+		arg2 string
+		arg3 []kratix.ObjectTransformer
+	}
+	writeDependenciesReturns struct {
+		result1 error
+	}
+	writeDependenciesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteDestinationSelectorsStub        func([]kratix.DestinationSelector) error
+	writeDestinationSelectorsMutex       sync.RWMutex
+	writeDestinationSelectorsArgsForCall []struct {
+		arg1 []kratix.DestinationSelector
+	}
+	writeDestinationSelectorsReturns struct {
+		result1 error
+	}
+	writeDestinationSelectorsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteObjectsStub        func(string, []*unstructured.Unstructured, ...kratix.ObjectTransformer) error
+	writeObjectsMutex       sync.RWMutex
+	writeObjectsArgsForCall []struct {
+		arg1 string
+		arg2 []*unstructured.Unstructured
+		arg3 []kratix.ObjectTransformer
+	}
+	writeObjectsReturns struct {
+		result1 error
+	}
+	writeObjectsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteOutputStub        func(string, []byte) error
+	writeOutputMutex       sync.RWMutex
+	writeOutputArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+	}
+	writeOutputReturns struct {
+		result1 error
+	}
+	writeOutputReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteStatusStub        func(kratix.Status) error
+	writeStatusMutex       sync.RWMutex
+	writeStatusArgsForCall []struct {
+		arg1 kratix.Status
+	}
+	writeStatusReturns struct {
+		result1 error
+	}
+	writeStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteSubResourceRequestsStub        func(...*unstructured.Unstructured) error
+	writeSubResourceRequestsMutex       sync.RWMutex
+	writeSubResourceRequestsArgsForCall []struct {
+		arg1 []*unstructured.Unstructured
+	}
+	writeSubResourceRequestsReturns struct {
+		result1 error
+	}
+	writeSubResourceRequestsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOutputWriter) WriteDependencies(arg1 kratix.Promise, arg2 string, arg3 ...kratix.ObjectTransformer) error {
+	fake.writeDependenciesMutex.Lock()
+	ret, specificReturn := fake.writeDependenciesReturnsOnCall[len(fake.writeDependenciesArgsForCall)]
+	fake.writeDependenciesArgsForCall = append(fake.writeDependenciesArgsForCall, struct {
+		arg1 kratix.Promise
+		arg2 string
+		arg3 []kratix.ObjectTransformer
+	}{arg1, arg2, arg3})
+	stub := fake.WriteDependenciesStub
+	fakeReturns := fake.writeDependenciesReturns
+	fake.recordInvocation("WriteDependencies", []interface{}{arg1, arg2, arg3})
+	fake.writeDependenciesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeOutputWriter) WriteDependenciesCallCount() int {
+	fake.writeDependenciesMutex.RLock()
+	defer fake.writeDependenciesMutex.RUnlock()
+	return len(fake.writeDependenciesArgsForCall)
+}
+
+func (fake *FakeOutputWriter) WriteDependenciesCalls(stub func(kratix.Promise, string, ...kratix.ObjectTransformer) error) {
+	fake.writeDependenciesMutex.Lock()
+	defer fake.writeDependenciesMutex.Unlock()
+	fake.WriteDependenciesStub = stub
+}
+
+func (fake *FakeOutputWriter) WriteDependenciesArgsForCall(i int) (kratix.Promise, string, []kratix.ObjectTransformer) {
+	fake.writeDependenciesMutex.RLock()
+	defer fake.writeDependenciesMutex.RUnlock()
+	argsForCall := fake.writeDependenciesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeOutputWriter) WriteDependenciesReturns(result1 error) {
+	fake.writeDependenciesMutex.Lock()
+	defer fake.writeDependenciesMutex.Unlock()
+	fake.WriteDependenciesStub = nil
+	fake.writeDependenciesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOutputWriter) WriteDependenciesReturnsOnCall(i int, result1 error) {
+	fake.writeDependenciesMutex.Lock()
+	defer fake.writeDependenciesMutex.Unlock()
+	fake.WriteDependenciesStub = nil
+	if fake.writeDependenciesReturnsOnCall == nil {
+		fake.writeDependenciesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeDependenciesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOutputWriter) WriteDestinationSelectors(arg1 []kratix.DestinationSelector) error {
+	var arg1Copy []kratix.DestinationSelector
+	if arg1 != nil {
+		arg1Copy = make([]kratix.DestinationSelector, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.writeDestinationSelectorsMutex.Lock()
+	ret, specificReturn := fake.writeDestinationSelectorsReturnsOnCall[len(fake.writeDestinationSelectorsArgsForCall)]
+	fake.writeDestinationSelectorsArgsForCall = append(fake.writeDestinationSelectorsArgsForCall, struct {
+		arg1 []kratix.DestinationSelector
+	}{arg1Copy})
+	stub := fake.WriteDestinationSelectorsStub
+	fakeReturns := fake.writeDestinationSelectorsReturns
+	fake.recordInvocation("WriteDestinationSelectors", []interface{}{arg1Copy})
+	fake.writeDestinationSelectorsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeOutputWriter) WriteDestinationSelectorsCallCount() int {
+	fake.writeDestinationSelectorsMutex.RLock()
+	defer fake.writeDestinationSelectorsMutex.RUnlock()
+	return len(fake.writeDestinationSelectorsArgsForCall)
+}
+
+func (fake *FakeOutputWriter) WriteDestinationSelectorsCalls(stub func([]kratix.DestinationSelector) error) {
+	fake.writeDestinationSelectorsMutex.Lock()
+	defer fake.writeDestinationSelectorsMutex.Unlock()
+	fake.WriteDestinationSelectorsStub = stub
+}
+
+func (fake *FakeOutputWriter) WriteDestinationSelectorsArgsForCall(i int) []kratix.DestinationSelector {
+	fake.writeDestinationSelectorsMutex.RLock()
+	defer fake.writeDestinationSelectorsMutex.RUnlock()
+	argsForCall := fake.writeDestinationSelectorsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeOutputWriter) WriteDestinationSelectorsReturns(result1 error) {
+	fake.writeDestinationSelectorsMutex.Lock()
+	defer fake.writeDestinationSelectorsMutex.Unlock()
+	fake.WriteDestinationSelectorsStub = nil
+	fake.writeDestinationSelectorsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOutputWriter) WriteDestinationSelectorsReturnsOnCall(i int, result1 error) {
+	fake.writeDestinationSelectorsMutex.Lock()
+	defer fake.writeDestinationSelectorsMutex.Unlock()
+	fake.WriteDestinationSelectorsStub = nil
+	if fake.writeDestinationSelectorsReturnsOnCall == nil {
+		fake.writeDestinationSelectorsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeDestinationSelectorsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOutputWriter) WriteObjects(arg1 string, arg2 []*unstructured.Unstructured, arg3 ...kratix.ObjectTransformer) error {
+	var arg2Copy []*unstructured.Unstructured
+	if arg2 != nil {
+		arg2Copy = make([]*unstructured.Unstructured, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.writeObjectsMutex.Lock()
+	ret, specificReturn := fake.writeObjectsReturnsOnCall[len(fake.writeObjectsArgsForCall)]
+	fake.writeObjectsArgsForCall = append(fake.writeObjectsArgsForCall, struct {
+		arg1 string
+		arg2 []*unstructured.Unstructured
+		arg3 []kratix.ObjectTransformer
+	}{arg1, arg2Copy, arg3})
+	stub := fake.WriteObjectsStub
+	fakeReturns := fake.writeObjectsReturns
+	fake.recordInvocation("WriteObjects", []interface{}{arg1, arg2Copy, arg3})
+	fake.writeObjectsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeOutputWriter) WriteObjectsCallCount() int {
+	fake.writeObjectsMutex.RLock()
+	defer fake.writeObjectsMutex.RUnlock()
+	return len(fake.writeObjectsArgsForCall)
+}
+
+func (fake *FakeOutputWriter) WriteObjectsCalls(stub func(string, []*unstructured.Unstructured, ...kratix.ObjectTransformer) error) {
+	fake.writeObjectsMutex.Lock()
+	defer fake.writeObjectsMutex.Unlock()
+	fake.WriteObjectsStub = stub
+}
+
+func (fake *FakeOutputWriter) WriteObjectsArgsForCall(i int) (string, []*unstructured.Unstructured, []kratix.ObjectTransformer) {
+	fake.writeObjectsMutex.RLock()
+	defer fake.writeObjectsMutex.RUnlock()
+	argsForCall := fake.writeObjectsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeOutputWriter) WriteObjectsReturns(result1 error) {
+	fake.writeObjectsMutex.Lock()
+	defer fake.writeObjectsMutex.Unlock()
+	fake.WriteObjectsStub = nil
+	fake.writeObjectsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOutputWriter) WriteObjectsReturnsOnCall(i int, result1 error) {
+	fake.writeObjectsMutex.Lock()
+	defer fake.writeObjectsMutex.Unlock()
+	fake.WriteObjectsStub = nil
+	if fake.writeObjectsReturnsOnCall == nil {
+		fake.writeObjectsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeObjectsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOutputWriter) WriteOutput(arg1 string, arg2 []byte) error {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.writeOutputMutex.Lock()
+	ret, specificReturn := fake.writeOutputReturnsOnCall[len(fake.writeOutputArgsForCall)]
+	fake.writeOutputArgsForCall = append(fake.writeOutputArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+	}{arg1, arg2Copy})
+	stub := fake.WriteOutputStub
+	fakeReturns := fake.writeOutputReturns
+	fake.recordInvocation("WriteOutput", []interface{}{arg1, arg2Copy})
+	fake.writeOutputMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeOutputWriter) WriteOutputCallCount() int {
+	fake.writeOutputMutex.RLock()
+	defer fake.writeOutputMutex.RUnlock()
+	return len(fake.writeOutputArgsForCall)
+}
+
+func (fake *FakeOutputWriter) WriteOutputCalls(stub func(string, []byte) error) {
+	fake.writeOutputMutex.Lock()
+	defer fake.writeOutputMutex.Unlock()
+	fake.WriteOutputStub = stub
+}
+
+func (fake *FakeOutputWriter) WriteOutputArgsForCall(i int) (string, []byte) {
+	fake.writeOutputMutex.RLock()
+	defer fake.writeOutputMutex.RUnlock()
+	argsForCall := fake.writeOutputArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeOutputWriter) WriteOutputReturns(result1 error) {
+	fake.writeOutputMutex.Lock()
+	defer fake.writeOutputMutex.Unlock()
+	fake.WriteOutputStub = nil
+	fake.writeOutputReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOutputWriter) WriteOutputReturnsOnCall(i int, result1 error) {
+	fake.writeOutputMutex.Lock()
+	defer fake.writeOutputMutex.Unlock()
+	fake.WriteOutputStub = nil
+	if fake.writeOutputReturnsOnCall == nil {
+		fake.writeOutputReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeOutputReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOutputWriter) WriteStatus(arg1 kratix.Status) error {
+	fake.writeStatusMutex.Lock()
+	ret, specificReturn := fake.writeStatusReturnsOnCall[len(fake.writeStatusArgsForCall)]
+	fake.writeStatusArgsForCall = append(fake.writeStatusArgsForCall, struct {
+		arg1 kratix.Status
+	}{arg1})
+	stub := fake.WriteStatusStub
+	fakeReturns := fake.writeStatusReturns
+	fake.recordInvocation("WriteStatus", []interface{}{arg1})
+	fake.writeStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeOutputWriter) WriteStatusCallCount() int {
+	fake.writeStatusMutex.RLock()
+	defer fake.writeStatusMutex.RUnlock()
+	return len(fake.writeStatusArgsForCall)
+}
+
+func (fake *FakeOutputWriter) WriteStatusCalls(stub func(kratix.Status) error) {
+	fake.writeStatusMutex.Lock()
+	defer fake.writeStatusMutex.Unlock()
+	fake.WriteStatusStub = stub
+}
+
+func (fake *FakeOutputWriter) WriteStatusArgsForCall(i int) kratix.Status {
+	fake.writeStatusMutex.RLock()
+	defer fake.writeStatusMutex.RUnlock()
+	argsForCall := fake.writeStatusArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeOutputWriter) WriteStatusReturns(result1 error) {
+	fake.writeStatusMutex.Lock()
+	defer fake.writeStatusMutex.Unlock()
+	fake.WriteStatusStub = nil
+	fake.writeStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOutputWriter) WriteStatusReturnsOnCall(i int, result1 error) {
+	fake.writeStatusMutex.Lock()
+	defer fake.writeStatusMutex.Unlock()
+	fake.WriteStatusStub = nil
+	if fake.writeStatusReturnsOnCall == nil {
+		fake.writeStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOutputWriter) WriteSubResourceRequests(arg1 ...*unstructured.Unstructured) error {
+	fake.writeSubResourceRequestsMutex.Lock()
+	ret, specificReturn := fake.writeSubResourceRequestsReturnsOnCall[len(fake.writeSubResourceRequestsArgsForCall)]
+	fake.writeSubResourceRequestsArgsForCall = append(fake.writeSubResourceRequestsArgsForCall, struct {
+		arg1 []*unstructured.Unstructured
+	}{arg1})
+	stub := fake.WriteSubResourceRequestsStub
+	fakeReturns := fake.writeSubResourceRequestsReturns
+	fake.recordInvocation("WriteSubResourceRequests", []interface{}{arg1})
+	fake.writeSubResourceRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeOutputWriter) WriteSubResourceRequestsCallCount() int {
+	fake.writeSubResourceRequestsMutex.RLock()
+	defer fake.writeSubResourceRequestsMutex.RUnlock()
+	return len(fake.writeSubResourceRequestsArgsForCall)
+}
+
+func (fake *FakeOutputWriter) WriteSubResourceRequestsCalls(stub func(...*unstructured.Unstructured) error) {
+	fake.writeSubResourceRequestsMutex.Lock()
+	defer fake.writeSubResourceRequestsMutex.Unlock()
+	fake.WriteSubResourceRequestsStub = stub
+}
+
+func (fake *FakeOutputWriter) WriteSubResourceRequestsArgsForCall(i int) []*unstructured.Unstructured {
+	fake.writeSubResourceRequestsMutex.RLock()
+	defer fake.writeSubResourceRequestsMutex.RUnlock()
+	argsForCall := fake.writeSubResourceRequestsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeOutputWriter) WriteSubResourceRequestsReturns(result1 error) {
+	fake.writeSubResourceRequestsMutex.Lock()
+	defer fake.writeSubResourceRequestsMutex.Unlock()
+	fake.WriteSubResourceRequestsStub = nil
+	fake.writeSubResourceRequestsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOutputWriter) WriteSubResourceRequestsReturnsOnCall(i int, result1 error) {
+	fake.writeSubResourceRequestsMutex.Lock()
+	defer fake.writeSubResourceRequestsMutex.Unlock()
+	fake.WriteSubResourceRequestsStub = nil
+	if fake.writeSubResourceRequestsReturnsOnCall == nil {
+		fake.writeSubResourceRequestsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeSubResourceRequestsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOutputWriter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeOutputWriter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ kratix.OutputWriter = new(FakeOutputWriter)
@@ -0,0 +1,418 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package kratixgofakes
+
+import (
+	"sync"
+
+	kratix "github.com/syntasso/kratix-go"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type FakePlatformClient struct {
+	AggregateChildStatusStub        func(kratix.Resource, ...string) (kratix.ChildrenStatus, error)
+	aggregateChildStatusMutex       sync.RWMutex
+	aggregateChildStatusArgsForCall []struct {
+		arg1 kratix.Resource
+		arg2 []string
+	}
+	aggregateChildStatusReturns struct {
+		result1 kratix.ChildrenStatus
+		result2 error
+	}
+	aggregateChildStatusReturnsOnCall map[int]struct {
+		result1 kratix.ChildrenStatus
+		result2 error
+	}
+	BuildSubResourceRequestStub        func(kratix.Resource, string, string, map[string]any) (*unstructured.Unstructured, error)
+	buildSubResourceRequestMutex       sync.RWMutex
+	buildSubResourceRequestArgsForCall []struct {
+		arg1 kratix.Resource
+		arg2 string
+		arg3 string
+		arg4 map[string]any
+	}
+	buildSubResourceRequestReturns struct {
+		result1 *unstructured.Unstructured
+		result2 error
+	}
+	buildSubResourceRequestReturnsOnCall map[int]struct {
+		result1 *unstructured.Unstructured
+		result2 error
+	}
+	CheckRequiredPromisesStub        func(kratix.Promise) (kratix.RequiredPromisesResult, error)
+	checkRequiredPromisesMutex       sync.RWMutex
+	checkRequiredPromisesArgsForCall []struct {
+		arg1 kratix.Promise
+	}
+	checkRequiredPromisesReturns struct {
+		result1 kratix.RequiredPromisesResult
+		result2 error
+	}
+	checkRequiredPromisesReturnsOnCall map[int]struct {
+		result1 kratix.RequiredPromisesResult
+		result2 error
+	}
+	ChildSelectorStub        func(kratix.Resource) labels.Selector
+	childSelectorMutex       sync.RWMutex
+	childSelectorArgsForCall []struct {
+		arg1 kratix.Resource
+	}
+	childSelectorReturns struct {
+		result1 labels.Selector
+	}
+	childSelectorReturnsOnCall map[int]struct {
+		result1 labels.Selector
+	}
+	FetchPromiseStub        func() (kratix.Promise, error)
+	fetchPromiseMutex       sync.RWMutex
+	fetchPromiseArgsForCall []struct {
+	}
+	fetchPromiseReturns struct {
+		result1 kratix.Promise
+		result2 error
+	}
+	fetchPromiseReturnsOnCall map[int]struct {
+		result1 kratix.Promise
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePlatformClient) AggregateChildStatus(arg1 kratix.Resource, arg2 ...string) (kratix.ChildrenStatus, error) {
+	fake.aggregateChildStatusMutex.Lock()
+	ret, specificReturn := fake.aggregateChildStatusReturnsOnCall[len(fake.aggregateChildStatusArgsForCall)]
+	fake.aggregateChildStatusArgsForCall = append(fake.aggregateChildStatusArgsForCall, struct {
+		arg1 kratix.Resource
+		arg2 []string
+	}{arg1, arg2})
+	stub := fake.AggregateChildStatusStub
+	fakeReturns := fake.aggregateChildStatusReturns
+	fake.recordInvocation("AggregateChildStatus", []interface{}{arg1, arg2})
+	fake.aggregateChildStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePlatformClient) AggregateChildStatusCallCount() int {
+	fake.aggregateChildStatusMutex.RLock()
+	defer fake.aggregateChildStatusMutex.RUnlock()
+	return len(fake.aggregateChildStatusArgsForCall)
+}
+
+func (fake *FakePlatformClient) AggregateChildStatusCalls(stub func(kratix.Resource, ...string) (kratix.ChildrenStatus, error)) {
+	fake.aggregateChildStatusMutex.Lock()
+	defer fake.aggregateChildStatusMutex.Unlock()
+	fake.AggregateChildStatusStub = stub
+}
+
+func (fake *FakePlatformClient) AggregateChildStatusArgsForCall(i int) (kratix.Resource, []string) {
+	fake.aggregateChildStatusMutex.RLock()
+	defer fake.aggregateChildStatusMutex.RUnlock()
+	argsForCall := fake.aggregateChildStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePlatformClient) AggregateChildStatusReturns(result1 kratix.ChildrenStatus, result2 error) {
+	fake.aggregateChildStatusMutex.Lock()
+	defer fake.aggregateChildStatusMutex.Unlock()
+	fake.AggregateChildStatusStub = nil
+	fake.aggregateChildStatusReturns = struct {
+		result1 kratix.ChildrenStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePlatformClient) AggregateChildStatusReturnsOnCall(i int, result1 kratix.ChildrenStatus, result2 error) {
+	fake.aggregateChildStatusMutex.Lock()
+	defer fake.aggregateChildStatusMutex.Unlock()
+	fake.AggregateChildStatusStub = nil
+	if fake.aggregateChildStatusReturnsOnCall == nil {
+		fake.aggregateChildStatusReturnsOnCall = make(map[int]struct {
+			result1 kratix.ChildrenStatus
+			result2 error
+		})
+	}
+	fake.aggregateChildStatusReturnsOnCall[i] = struct {
+		result1 kratix.ChildrenStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePlatformClient) BuildSubResourceRequest(arg1 kratix.Resource, arg2 string, arg3 string, arg4 map[string]any) (*unstructured.Unstructured, error) {
+	fake.buildSubResourceRequestMutex.Lock()
+	ret, specificReturn := fake.buildSubResourceRequestReturnsOnCall[len(fake.buildSubResourceRequestArgsForCall)]
+	fake.buildSubResourceRequestArgsForCall = append(fake.buildSubResourceRequestArgsForCall, struct {
+		arg1 kratix.Resource
+		arg2 string
+		arg3 string
+		arg4 map[string]any
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.BuildSubResourceRequestStub
+	fakeReturns := fake.buildSubResourceRequestReturns
+	fake.recordInvocation("BuildSubResourceRequest", []interface{}{arg1, arg2, arg3, arg4})
+	fake.buildSubResourceRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePlatformClient) BuildSubResourceRequestCallCount() int {
+	fake.buildSubResourceRequestMutex.RLock()
+	defer fake.buildSubResourceRequestMutex.RUnlock()
+	return len(fake.buildSubResourceRequestArgsForCall)
+}
+
+func (fake *FakePlatformClient) BuildSubResourceRequestCalls(stub func(kratix.Resource, string, string, map[string]any) (*unstructured.Unstructured, error)) {
+	fake.buildSubResourceRequestMutex.Lock()
+	defer fake.buildSubResourceRequestMutex.Unlock()
+	fake.BuildSubResourceRequestStub = stub
+}
+
+func (fake *FakePlatformClient) BuildSubResourceRequestArgsForCall(i int) (kratix.Resource, string, string, map[string]any) {
+	fake.buildSubResourceRequestMutex.RLock()
+	defer fake.buildSubResourceRequestMutex.RUnlock()
+	argsForCall := fake.buildSubResourceRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePlatformClient) BuildSubResourceRequestReturns(result1 *unstructured.Unstructured, result2 error) {
+	fake.buildSubResourceRequestMutex.Lock()
+	defer fake.buildSubResourceRequestMutex.Unlock()
+	fake.BuildSubResourceRequestStub = nil
+	fake.buildSubResourceRequestReturns = struct {
+		result1 *unstructured.Unstructured
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePlatformClient) BuildSubResourceRequestReturnsOnCall(i int, result1 *unstructured.Unstructured, result2 error) {
+	fake.buildSubResourceRequestMutex.Lock()
+	defer fake.buildSubResourceRequestMutex.Unlock()
+	fake.BuildSubResourceRequestStub = nil
+	if fake.buildSubResourceRequestReturnsOnCall == nil {
+		fake.buildSubResourceRequestReturnsOnCall = make(map[int]struct {
+			result1 *unstructured.Unstructured
+			result2 error
+		})
+	}
+	fake.buildSubResourceRequestReturnsOnCall[i] = struct {
+		result1 *unstructured.Unstructured
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePlatformClient) CheckRequiredPromises(arg1 kratix.Promise) (kratix.RequiredPromisesResult, error) {
+	fake.checkRequiredPromisesMutex.Lock()
+	ret, specificReturn := fake.checkRequiredPromisesReturnsOnCall[len(fake.checkRequiredPromisesArgsForCall)]
+	fake.checkRequiredPromisesArgsForCall = append(fake.checkRequiredPromisesArgsForCall, struct {
+		arg1 kratix.Promise
+	}{arg1})
+	stub := fake.CheckRequiredPromisesStub
+	fakeReturns := fake.checkRequiredPromisesReturns
+	fake.recordInvocation("CheckRequiredPromises", []interface{}{arg1})
+	fake.checkRequiredPromisesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePlatformClient) CheckRequiredPromisesCallCount() int {
+	fake.checkRequiredPromisesMutex.RLock()
+	defer fake.checkRequiredPromisesMutex.RUnlock()
+	return len(fake.checkRequiredPromisesArgsForCall)
+}
+
+func (fake *FakePlatformClient) CheckRequiredPromisesCalls(stub func(kratix.Promise) (kratix.RequiredPromisesResult, error)) {
+	fake.checkRequiredPromisesMutex.Lock()
+	defer fake.checkRequiredPromisesMutex.Unlock()
+	fake.CheckRequiredPromisesStub = stub
+}
+
+func (fake *FakePlatformClient) CheckRequiredPromisesArgsForCall(i int) kratix.Promise {
+	fake.checkRequiredPromisesMutex.RLock()
+	defer fake.checkRequiredPromisesMutex.RUnlock()
+	argsForCall := fake.checkRequiredPromisesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePlatformClient) CheckRequiredPromisesReturns(result1 kratix.RequiredPromisesResult, result2 error) {
+	fake.checkRequiredPromisesMutex.Lock()
+	defer fake.checkRequiredPromisesMutex.Unlock()
+	fake.CheckRequiredPromisesStub = nil
+	fake.checkRequiredPromisesReturns = struct {
+		result1 kratix.RequiredPromisesResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePlatformClient) CheckRequiredPromisesReturnsOnCall(i int, result1 kratix.RequiredPromisesResult, result2 error) {
+	fake.checkRequiredPromisesMutex.Lock()
+	defer fake.checkRequiredPromisesMutex.Unlock()
+	fake.CheckRequiredPromisesStub = nil
+	if fake.checkRequiredPromisesReturnsOnCall == nil {
+		fake.checkRequiredPromisesReturnsOnCall = make(map[int]struct {
+			result1 kratix.RequiredPromisesResult
+			result2 error
+		})
+	}
+	fake.checkRequiredPromisesReturnsOnCall[i] = struct {
+		result1 kratix.RequiredPromisesResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePlatformClient) ChildSelector(arg1 kratix.Resource) labels.Selector {
+	fake.childSelectorMutex.Lock()
+	ret, specificReturn := fake.childSelectorReturnsOnCall[len(fake.childSelectorArgsForCall)]
+	fake.childSelectorArgsForCall = append(fake.childSelectorArgsForCall, struct {
+		arg1 kratix.Resource
+	}{arg1})
+	stub := fake.ChildSelectorStub
+	fakeReturns := fake.childSelectorReturns
+	fake.recordInvocation("ChildSelector", []interface{}{arg1})
+	fake.childSelectorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePlatformClient) ChildSelectorCallCount() int {
+	fake.childSelectorMutex.RLock()
+	defer fake.childSelectorMutex.RUnlock()
+	return len(fake.childSelectorArgsForCall)
+}
+
+func (fake *FakePlatformClient) ChildSelectorCalls(stub func(kratix.Resource) labels.Selector) {
+	fake.childSelectorMutex.Lock()
+	defer fake.childSelectorMutex.Unlock()
+	fake.ChildSelectorStub = stub
+}
+
+func (fake *FakePlatformClient) ChildSelectorArgsForCall(i int) kratix.Resource {
+	fake.childSelectorMutex.RLock()
+	defer fake.childSelectorMutex.RUnlock()
+	argsForCall := fake.childSelectorArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePlatformClient) ChildSelectorReturns(result1 labels.Selector) {
+	fake.childSelectorMutex.Lock()
+	defer fake.childSelectorMutex.Unlock()
+	fake.ChildSelectorStub = nil
+	fake.childSelectorReturns = struct {
+		result1 labels.Selector
+	}{result1}
+}
+
+func (fake *FakePlatformClient) ChildSelectorReturnsOnCall(i int, result1 labels.Selector) {
+	fake.childSelectorMutex.Lock()
+	defer fake.childSelectorMutex.Unlock()
+	fake.ChildSelectorStub = nil
+	if fake.childSelectorReturnsOnCall == nil {
+		fake.childSelectorReturnsOnCall = make(map[int]struct {
+			result1 labels.Selector
+		})
+	}
+	fake.childSelectorReturnsOnCall[i] = struct {
+		result1 labels.Selector
+	}{result1}
+}
+
+func (fake *FakePlatformClient) FetchPromise() (kratix.Promise, error) {
+	fake.fetchPromiseMutex.Lock()
+	ret, specificReturn := fake.fetchPromiseReturnsOnCall[len(fake.fetchPromiseArgsForCall)]
+	fake.fetchPromiseArgsForCall = append(fake.fetchPromiseArgsForCall, struct {
+	}{})
+	stub := fake.FetchPromiseStub
+	fakeReturns := fake.fetchPromiseReturns
+	fake.recordInvocation("FetchPromise", []interface{}{})
+	fake.fetchPromiseMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePlatformClient) FetchPromiseCallCount() int {
+	fake.fetchPromiseMutex.RLock()
+	defer fake.fetchPromiseMutex.RUnlock()
+	return len(fake.fetchPromiseArgsForCall)
+}
+
+func (fake *FakePlatformClient) FetchPromiseCalls(stub func() (kratix.Promise, error)) {
+	fake.fetchPromiseMutex.Lock()
+	defer fake.fetchPromiseMutex.Unlock()
+	fake.FetchPromiseStub = stub
+}
+
+func (fake *FakePlatformClient) FetchPromiseReturns(result1 kratix.Promise, result2 error) {
+	fake.fetchPromiseMutex.Lock()
+	defer fake.fetchPromiseMutex.Unlock()
+	fake.FetchPromiseStub = nil
+	fake.fetchPromiseReturns = struct {
+		result1 kratix.Promise
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePlatformClient) FetchPromiseReturnsOnCall(i int, result1 kratix.Promise, result2 error) {
+	fake.fetchPromiseMutex.Lock()
+	defer fake.fetchPromiseMutex.Unlock()
+	fake.FetchPromiseStub = nil
+	if fake.fetchPromiseReturnsOnCall == nil {
+		fake.fetchPromiseReturnsOnCall = make(map[int]struct {
+			result1 kratix.Promise
+			result2 error
+		})
+	}
+	fake.fetchPromiseReturnsOnCall[i] = struct {
+		result1 kratix.Promise
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePlatformClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePlatformClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ kratix.PlatformClient = new(FakePlatformClient)
@@ -0,0 +1,3184 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package kratixgofakes
+
+import (
+	"sync"
+	"time"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix/api/v1alpha1"
+	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1a "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type FakePromise struct {
+	DiffStub        func() ([]byte, error)
+	diffMutex       sync.RWMutex
+	diffArgsForCall []struct {
+	}
+	diffReturns struct {
+		result1 []byte
+		result2 error
+	}
+	diffReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	GetAPISchemaStub        func(string) (*v1.JSONSchemaProps, error)
+	getAPISchemaMutex       sync.RWMutex
+	getAPISchemaArgsForCall []struct {
+		arg1 string
+	}
+	getAPISchemaReturns struct {
+		result1 *v1.JSONSchemaProps
+		result2 error
+	}
+	getAPISchemaReturnsOnCall map[int]struct {
+		result1 *v1.JSONSchemaProps
+		result2 error
+	}
+	GetAnnotationsStub        func() map[string]string
+	getAnnotationsMutex       sync.RWMutex
+	getAnnotationsArgsForCall []struct {
+	}
+	getAnnotationsReturns struct {
+		result1 map[string]string
+	}
+	getAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
+	GetBoolStub        func(string) (bool, error)
+	getBoolMutex       sync.RWMutex
+	getBoolArgsForCall []struct {
+		arg1 string
+	}
+	getBoolReturns struct {
+		result1 bool
+		result2 error
+	}
+	getBoolReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	GetBoolOrDefaultStub        func(string, bool) (bool, error)
+	getBoolOrDefaultMutex       sync.RWMutex
+	getBoolOrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 bool
+	}
+	getBoolOrDefaultReturns struct {
+		result1 bool
+		result2 error
+	}
+	getBoolOrDefaultReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	GetCRDStub        func() (*v1.CustomResourceDefinition, error)
+	getCRDMutex       sync.RWMutex
+	getCRDArgsForCall []struct {
+	}
+	getCRDReturns struct {
+		result1 *v1.CustomResourceDefinition
+		result2 error
+	}
+	getCRDReturnsOnCall map[int]struct {
+		result1 *v1.CustomResourceDefinition
+		result2 error
+	}
+	GetCreationTimestampStub        func() v1a.Time
+	getCreationTimestampMutex       sync.RWMutex
+	getCreationTimestampArgsForCall []struct {
+	}
+	getCreationTimestampReturns struct {
+		result1 v1a.Time
+	}
+	getCreationTimestampReturnsOnCall map[int]struct {
+		result1 v1a.Time
+	}
+	GetDeletionTimestampStub        func() *v1a.Time
+	getDeletionTimestampMutex       sync.RWMutex
+	getDeletionTimestampArgsForCall []struct {
+	}
+	getDeletionTimestampReturns struct {
+		result1 *v1a.Time
+	}
+	getDeletionTimestampReturnsOnCall map[int]struct {
+		result1 *v1a.Time
+	}
+	GetDependenciesStub        func() []*unstructured.Unstructured
+	getDependenciesMutex       sync.RWMutex
+	getDependenciesArgsForCall []struct {
+	}
+	getDependenciesReturns struct {
+		result1 []*unstructured.Unstructured
+	}
+	getDependenciesReturnsOnCall map[int]struct {
+		result1 []*unstructured.Unstructured
+	}
+	GetDurationStub        func(string) (time.Duration, error)
+	getDurationMutex       sync.RWMutex
+	getDurationArgsForCall []struct {
+		arg1 string
+	}
+	getDurationReturns struct {
+		result1 time.Duration
+		result2 error
+	}
+	getDurationReturnsOnCall map[int]struct {
+		result1 time.Duration
+		result2 error
+	}
+	GetDurationOrDefaultStub        func(string, time.Duration) (time.Duration, error)
+	getDurationOrDefaultMutex       sync.RWMutex
+	getDurationOrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+	}
+	getDurationOrDefaultReturns struct {
+		result1 time.Duration
+		result2 error
+	}
+	getDurationOrDefaultReturnsOnCall map[int]struct {
+		result1 time.Duration
+		result2 error
+	}
+	GetFinalizersStub        func() []string
+	getFinalizersMutex       sync.RWMutex
+	getFinalizersArgsForCall []struct {
+	}
+	getFinalizersReturns struct {
+		result1 []string
+	}
+	getFinalizersReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	GetFloat64Stub        func(string) (float64, error)
+	getFloat64Mutex       sync.RWMutex
+	getFloat64ArgsForCall []struct {
+		arg1 string
+	}
+	getFloat64Returns struct {
+		result1 float64
+		result2 error
+	}
+	getFloat64ReturnsOnCall map[int]struct {
+		result1 float64
+		result2 error
+	}
+	GetFloat64OrDefaultStub        func(string, float64) (float64, error)
+	getFloat64OrDefaultMutex       sync.RWMutex
+	getFloat64OrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 float64
+	}
+	getFloat64OrDefaultReturns struct {
+		result1 float64
+		result2 error
+	}
+	getFloat64OrDefaultReturnsOnCall map[int]struct {
+		result1 float64
+		result2 error
+	}
+	GetGenerationStub        func() int64
+	getGenerationMutex       sync.RWMutex
+	getGenerationArgsForCall []struct {
+	}
+	getGenerationReturns struct {
+		result1 int64
+	}
+	getGenerationReturnsOnCall map[int]struct {
+		result1 int64
+	}
+	GetGroupVersionKindStub        func() schema.GroupVersionKind
+	getGroupVersionKindMutex       sync.RWMutex
+	getGroupVersionKindArgsForCall []struct {
+	}
+	getGroupVersionKindReturns struct {
+		result1 schema.GroupVersionKind
+	}
+	getGroupVersionKindReturnsOnCall map[int]struct {
+		result1 schema.GroupVersionKind
+	}
+	GetInt64Stub        func(string) (int64, error)
+	getInt64Mutex       sync.RWMutex
+	getInt64ArgsForCall []struct {
+		arg1 string
+	}
+	getInt64Returns struct {
+		result1 int64
+		result2 error
+	}
+	getInt64ReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	GetInt64OrDefaultStub        func(string, int64) (int64, error)
+	getInt64OrDefaultMutex       sync.RWMutex
+	getInt64OrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 int64
+	}
+	getInt64OrDefaultReturns struct {
+		result1 int64
+		result2 error
+	}
+	getInt64OrDefaultReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	GetLabelsStub        func() map[string]string
+	getLabelsMutex       sync.RWMutex
+	getLabelsArgsForCall []struct {
+	}
+	getLabelsReturns struct {
+		result1 map[string]string
+	}
+	getLabelsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
+	GetMetadataStub        func() map[string]any
+	getMetadataMutex       sync.RWMutex
+	getMetadataArgsForCall []struct {
+	}
+	getMetadataReturns struct {
+		result1 map[string]any
+	}
+	getMetadataReturnsOnCall map[int]struct {
+		result1 map[string]any
+	}
+	GetNameStub        func() string
+	getNameMutex       sync.RWMutex
+	getNameArgsForCall []struct {
+	}
+	getNameReturns struct {
+		result1 string
+	}
+	getNameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetNamespaceStub        func() string
+	getNamespaceMutex       sync.RWMutex
+	getNamespaceArgsForCall []struct {
+	}
+	getNamespaceReturns struct {
+		result1 string
+	}
+	getNamespaceReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetOwnerReferencesStub        func() []v1a.OwnerReference
+	getOwnerReferencesMutex       sync.RWMutex
+	getOwnerReferencesArgsForCall []struct {
+	}
+	getOwnerReferencesReturns struct {
+		result1 []v1a.OwnerReference
+	}
+	getOwnerReferencesReturnsOnCall map[int]struct {
+		result1 []v1a.OwnerReference
+	}
+	GetPipelineStub        func(string, string, string) (*v1alpha1.Pipeline, error)
+	getPipelineMutex       sync.RWMutex
+	getPipelineArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getPipelineReturns struct {
+		result1 *v1alpha1.Pipeline
+		result2 error
+	}
+	getPipelineReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Pipeline
+		result2 error
+	}
+	GetPipelinesStub        func(string, string) ([]v1alpha1.Pipeline, error)
+	getPipelinesMutex       sync.RWMutex
+	getPipelinesArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getPipelinesReturns struct {
+		result1 []v1alpha1.Pipeline
+		result2 error
+	}
+	getPipelinesReturnsOnCall map[int]struct {
+		result1 []v1alpha1.Pipeline
+		result2 error
+	}
+	GetPromiseStub        func() *v1alpha1.Promise
+	getPromiseMutex       sync.RWMutex
+	getPromiseArgsForCall []struct {
+	}
+	getPromiseReturns struct {
+		result1 *v1alpha1.Promise
+	}
+	getPromiseReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Promise
+	}
+	GetQuantityStub        func(string) (resource.Quantity, error)
+	getQuantityMutex       sync.RWMutex
+	getQuantityArgsForCall []struct {
+		arg1 string
+	}
+	getQuantityReturns struct {
+		result1 resource.Quantity
+		result2 error
+	}
+	getQuantityReturnsOnCall map[int]struct {
+		result1 resource.Quantity
+		result2 error
+	}
+	GetQuantityOrDefaultStub        func(string, resource.Quantity) (resource.Quantity, error)
+	getQuantityOrDefaultMutex       sync.RWMutex
+	getQuantityOrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 resource.Quantity
+	}
+	getQuantityOrDefaultReturns struct {
+		result1 resource.Quantity
+		result2 error
+	}
+	getQuantityOrDefaultReturnsOnCall map[int]struct {
+		result1 resource.Quantity
+		result2 error
+	}
+	GetResourceVersionStub        func() string
+	getResourceVersionMutex       sync.RWMutex
+	getResourceVersionArgsForCall []struct {
+	}
+	getResourceVersionReturns struct {
+		result1 string
+	}
+	getResourceVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetStatusStub        func() (kratix.Status, error)
+	getStatusMutex       sync.RWMutex
+	getStatusArgsForCall []struct {
+	}
+	getStatusReturns struct {
+		result1 kratix.Status
+		result2 error
+	}
+	getStatusReturnsOnCall map[int]struct {
+		result1 kratix.Status
+		result2 error
+	}
+	GetStorageVersionStub        func() (string, error)
+	getStorageVersionMutex       sync.RWMutex
+	getStorageVersionArgsForCall []struct {
+	}
+	getStorageVersionReturns struct {
+		result1 string
+		result2 error
+	}
+	getStorageVersionReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetStringStub        func(string) (string, error)
+	getStringMutex       sync.RWMutex
+	getStringArgsForCall []struct {
+		arg1 string
+	}
+	getStringReturns struct {
+		result1 string
+		result2 error
+	}
+	getStringReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetStringMapStub        func(string) (map[string]string, error)
+	getStringMapMutex       sync.RWMutex
+	getStringMapArgsForCall []struct {
+		arg1 string
+	}
+	getStringMapReturns struct {
+		result1 map[string]string
+		result2 error
+	}
+	getStringMapReturnsOnCall map[int]struct {
+		result1 map[string]string
+		result2 error
+	}
+	GetStringMapOrDefaultStub        func(string, map[string]string) (map[string]string, error)
+	getStringMapOrDefaultMutex       sync.RWMutex
+	getStringMapOrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 map[string]string
+	}
+	getStringMapOrDefaultReturns struct {
+		result1 map[string]string
+		result2 error
+	}
+	getStringMapOrDefaultReturnsOnCall map[int]struct {
+		result1 map[string]string
+		result2 error
+	}
+	GetStringOrDefaultStub        func(string, string) (string, error)
+	getStringOrDefaultMutex       sync.RWMutex
+	getStringOrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getStringOrDefaultReturns struct {
+		result1 string
+		result2 error
+	}
+	getStringOrDefaultReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetStringSliceStub        func(string) ([]string, error)
+	getStringSliceMutex       sync.RWMutex
+	getStringSliceArgsForCall []struct {
+		arg1 string
+	}
+	getStringSliceReturns struct {
+		result1 []string
+		result2 error
+	}
+	getStringSliceReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	GetStringSliceOrDefaultStub        func(string, []string) ([]string, error)
+	getStringSliceOrDefaultMutex       sync.RWMutex
+	getStringSliceOrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	getStringSliceOrDefaultReturns struct {
+		result1 []string
+		result2 error
+	}
+	getStringSliceOrDefaultReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	GetUIDStub        func() types.UID
+	getUIDMutex       sync.RWMutex
+	getUIDArgsForCall []struct {
+	}
+	getUIDReturns struct {
+		result1 types.UID
+	}
+	getUIDReturnsOnCall map[int]struct {
+		result1 types.UID
+	}
+	GetValueStub        func(string) (any, error)
+	getValueMutex       sync.RWMutex
+	getValueArgsForCall []struct {
+		arg1 string
+	}
+	getValueReturns struct {
+		result1 any
+		result2 error
+	}
+	getValueReturnsOnCall map[int]struct {
+		result1 any
+		result2 error
+	}
+	GetValuesStub        func(string) ([]any, error)
+	getValuesMutex       sync.RWMutex
+	getValuesArgsForCall []struct {
+		arg1 string
+	}
+	getValuesReturns struct {
+		result1 []any
+		result2 error
+	}
+	getValuesReturnsOnCall map[int]struct {
+		result1 []any
+		result2 error
+	}
+	SetAnnotationStub        func(string, string)
+	setAnnotationMutex       sync.RWMutex
+	setAnnotationArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	SetLabelStub        func(string, string)
+	setLabelMutex       sync.RWMutex
+	setLabelArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	SetValueStub        func(string, any) error
+	setValueMutex       sync.RWMutex
+	setValueArgsForCall []struct {
+		arg1 string
+		arg2 any
+	}
+	setValueReturns struct {
+		result1 error
+	}
+	setValueReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ToOwnerReferenceStub        func() v1a.OwnerReference
+	toOwnerReferenceMutex       sync.RWMutex
+	toOwnerReferenceArgsForCall []struct {
+	}
+	toOwnerReferenceReturns struct {
+		result1 v1a.OwnerReference
+	}
+	toOwnerReferenceReturnsOnCall map[int]struct {
+		result1 v1a.OwnerReference
+	}
+	ToUnstructuredStub        func() unstructured.Unstructured
+	toUnstructuredMutex       sync.RWMutex
+	toUnstructuredArgsForCall []struct {
+	}
+	toUnstructuredReturns struct {
+		result1 unstructured.Unstructured
+	}
+	toUnstructuredReturnsOnCall map[int]struct {
+		result1 unstructured.Unstructured
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePromise) Diff() ([]byte, error) {
+	fake.diffMutex.Lock()
+	ret, specificReturn := fake.diffReturnsOnCall[len(fake.diffArgsForCall)]
+	fake.diffArgsForCall = append(fake.diffArgsForCall, struct {
+	}{})
+	stub := fake.DiffStub
+	fakeReturns := fake.diffReturns
+	fake.recordInvocation("Diff", []interface{}{})
+	fake.diffMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) DiffCallCount() int {
+	fake.diffMutex.RLock()
+	defer fake.diffMutex.RUnlock()
+	return len(fake.diffArgsForCall)
+}
+
+func (fake *FakePromise) DiffCalls(stub func() ([]byte, error)) {
+	fake.diffMutex.Lock()
+	defer fake.diffMutex.Unlock()
+	fake.DiffStub = stub
+}
+
+func (fake *FakePromise) DiffReturns(result1 []byte, result2 error) {
+	fake.diffMutex.Lock()
+	defer fake.diffMutex.Unlock()
+	fake.DiffStub = nil
+	fake.diffReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) DiffReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.diffMutex.Lock()
+	defer fake.diffMutex.Unlock()
+	fake.DiffStub = nil
+	if fake.diffReturnsOnCall == nil {
+		fake.diffReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.diffReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetAPISchema(arg1 string) (*v1.JSONSchemaProps, error) {
+	fake.getAPISchemaMutex.Lock()
+	ret, specificReturn := fake.getAPISchemaReturnsOnCall[len(fake.getAPISchemaArgsForCall)]
+	fake.getAPISchemaArgsForCall = append(fake.getAPISchemaArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetAPISchemaStub
+	fakeReturns := fake.getAPISchemaReturns
+	fake.recordInvocation("GetAPISchema", []interface{}{arg1})
+	fake.getAPISchemaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetAPISchemaCallCount() int {
+	fake.getAPISchemaMutex.RLock()
+	defer fake.getAPISchemaMutex.RUnlock()
+	return len(fake.getAPISchemaArgsForCall)
+}
+
+func (fake *FakePromise) GetAPISchemaCalls(stub func(string) (*v1.JSONSchemaProps, error)) {
+	fake.getAPISchemaMutex.Lock()
+	defer fake.getAPISchemaMutex.Unlock()
+	fake.GetAPISchemaStub = stub
+}
+
+func (fake *FakePromise) GetAPISchemaArgsForCall(i int) string {
+	fake.getAPISchemaMutex.RLock()
+	defer fake.getAPISchemaMutex.RUnlock()
+	argsForCall := fake.getAPISchemaArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePromise) GetAPISchemaReturns(result1 *v1.JSONSchemaProps, result2 error) {
+	fake.getAPISchemaMutex.Lock()
+	defer fake.getAPISchemaMutex.Unlock()
+	fake.GetAPISchemaStub = nil
+	fake.getAPISchemaReturns = struct {
+		result1 *v1.JSONSchemaProps
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetAPISchemaReturnsOnCall(i int, result1 *v1.JSONSchemaProps, result2 error) {
+	fake.getAPISchemaMutex.Lock()
+	defer fake.getAPISchemaMutex.Unlock()
+	fake.GetAPISchemaStub = nil
+	if fake.getAPISchemaReturnsOnCall == nil {
+		fake.getAPISchemaReturnsOnCall = make(map[int]struct {
+			result1 *v1.JSONSchemaProps
+			result2 error
+		})
+	}
+	fake.getAPISchemaReturnsOnCall[i] = struct {
+		result1 *v1.JSONSchemaProps
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetAnnotations() map[string]string {
+	fake.getAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getAnnotationsReturnsOnCall[len(fake.getAnnotationsArgsForCall)]
+	fake.getAnnotationsArgsForCall = append(fake.getAnnotationsArgsForCall, struct {
+	}{})
+	stub := fake.GetAnnotationsStub
+	fakeReturns := fake.getAnnotationsReturns
+	fake.recordInvocation("GetAnnotations", []interface{}{})
+	fake.getAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetAnnotationsCallCount() int {
+	fake.getAnnotationsMutex.RLock()
+	defer fake.getAnnotationsMutex.RUnlock()
+	return len(fake.getAnnotationsArgsForCall)
+}
+
+func (fake *FakePromise) GetAnnotationsCalls(stub func() map[string]string) {
+	fake.getAnnotationsMutex.Lock()
+	defer fake.getAnnotationsMutex.Unlock()
+	fake.GetAnnotationsStub = stub
+}
+
+func (fake *FakePromise) GetAnnotationsReturns(result1 map[string]string) {
+	fake.getAnnotationsMutex.Lock()
+	defer fake.getAnnotationsMutex.Unlock()
+	fake.GetAnnotationsStub = nil
+	fake.getAnnotationsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakePromise) GetAnnotationsReturnsOnCall(i int, result1 map[string]string) {
+	fake.getAnnotationsMutex.Lock()
+	defer fake.getAnnotationsMutex.Unlock()
+	fake.GetAnnotationsStub = nil
+	if fake.getAnnotationsReturnsOnCall == nil {
+		fake.getAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.getAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakePromise) GetBool(arg1 string) (bool, error) {
+	fake.getBoolMutex.Lock()
+	ret, specificReturn := fake.getBoolReturnsOnCall[len(fake.getBoolArgsForCall)]
+	fake.getBoolArgsForCall = append(fake.getBoolArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetBoolStub
+	fakeReturns := fake.getBoolReturns
+	fake.recordInvocation("GetBool", []interface{}{arg1})
+	fake.getBoolMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetBoolCallCount() int {
+	fake.getBoolMutex.RLock()
+	defer fake.getBoolMutex.RUnlock()
+	return len(fake.getBoolArgsForCall)
+}
+
+func (fake *FakePromise) GetBoolCalls(stub func(string) (bool, error)) {
+	fake.getBoolMutex.Lock()
+	defer fake.getBoolMutex.Unlock()
+	fake.GetBoolStub = stub
+}
+
+func (fake *FakePromise) GetBoolArgsForCall(i int) string {
+	fake.getBoolMutex.RLock()
+	defer fake.getBoolMutex.RUnlock()
+	argsForCall := fake.getBoolArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePromise) GetBoolReturns(result1 bool, result2 error) {
+	fake.getBoolMutex.Lock()
+	defer fake.getBoolMutex.Unlock()
+	fake.GetBoolStub = nil
+	fake.getBoolReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetBoolReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.getBoolMutex.Lock()
+	defer fake.getBoolMutex.Unlock()
+	fake.GetBoolStub = nil
+	if fake.getBoolReturnsOnCall == nil {
+		fake.getBoolReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.getBoolReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetBoolOrDefault(arg1 string, arg2 bool) (bool, error) {
+	fake.getBoolOrDefaultMutex.Lock()
+	ret, specificReturn := fake.getBoolOrDefaultReturnsOnCall[len(fake.getBoolOrDefaultArgsForCall)]
+	fake.getBoolOrDefaultArgsForCall = append(fake.getBoolOrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 bool
+	}{arg1, arg2})
+	stub := fake.GetBoolOrDefaultStub
+	fakeReturns := fake.getBoolOrDefaultReturns
+	fake.recordInvocation("GetBoolOrDefault", []interface{}{arg1, arg2})
+	fake.getBoolOrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetBoolOrDefaultCallCount() int {
+	fake.getBoolOrDefaultMutex.RLock()
+	defer fake.getBoolOrDefaultMutex.RUnlock()
+	return len(fake.getBoolOrDefaultArgsForCall)
+}
+
+func (fake *FakePromise) GetBoolOrDefaultCalls(stub func(string, bool) (bool, error)) {
+	fake.getBoolOrDefaultMutex.Lock()
+	defer fake.getBoolOrDefaultMutex.Unlock()
+	fake.GetBoolOrDefaultStub = stub
+}
+
+func (fake *FakePromise) GetBoolOrDefaultArgsForCall(i int) (string, bool) {
+	fake.getBoolOrDefaultMutex.RLock()
+	defer fake.getBoolOrDefaultMutex.RUnlock()
+	argsForCall := fake.getBoolOrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromise) GetBoolOrDefaultReturns(result1 bool, result2 error) {
+	fake.getBoolOrDefaultMutex.Lock()
+	defer fake.getBoolOrDefaultMutex.Unlock()
+	fake.GetBoolOrDefaultStub = nil
+	fake.getBoolOrDefaultReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetBoolOrDefaultReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.getBoolOrDefaultMutex.Lock()
+	defer fake.getBoolOrDefaultMutex.Unlock()
+	fake.GetBoolOrDefaultStub = nil
+	if fake.getBoolOrDefaultReturnsOnCall == nil {
+		fake.getBoolOrDefaultReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.getBoolOrDefaultReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetCRD() (*v1.CustomResourceDefinition, error) {
+	fake.getCRDMutex.Lock()
+	ret, specificReturn := fake.getCRDReturnsOnCall[len(fake.getCRDArgsForCall)]
+	fake.getCRDArgsForCall = append(fake.getCRDArgsForCall, struct {
+	}{})
+	stub := fake.GetCRDStub
+	fakeReturns := fake.getCRDReturns
+	fake.recordInvocation("GetCRD", []interface{}{})
+	fake.getCRDMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetCRDCallCount() int {
+	fake.getCRDMutex.RLock()
+	defer fake.getCRDMutex.RUnlock()
+	return len(fake.getCRDArgsForCall)
+}
+
+func (fake *FakePromise) GetCRDCalls(stub func() (*v1.CustomResourceDefinition, error)) {
+	fake.getCRDMutex.Lock()
+	defer fake.getCRDMutex.Unlock()
+	fake.GetCRDStub = stub
+}
+
+func (fake *FakePromise) GetCRDReturns(result1 *v1.CustomResourceDefinition, result2 error) {
+	fake.getCRDMutex.Lock()
+	defer fake.getCRDMutex.Unlock()
+	fake.GetCRDStub = nil
+	fake.getCRDReturns = struct {
+		result1 *v1.CustomResourceDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetCRDReturnsOnCall(i int, result1 *v1.CustomResourceDefinition, result2 error) {
+	fake.getCRDMutex.Lock()
+	defer fake.getCRDMutex.Unlock()
+	fake.GetCRDStub = nil
+	if fake.getCRDReturnsOnCall == nil {
+		fake.getCRDReturnsOnCall = make(map[int]struct {
+			result1 *v1.CustomResourceDefinition
+			result2 error
+		})
+	}
+	fake.getCRDReturnsOnCall[i] = struct {
+		result1 *v1.CustomResourceDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetCreationTimestamp() v1a.Time {
+	fake.getCreationTimestampMutex.Lock()
+	ret, specificReturn := fake.getCreationTimestampReturnsOnCall[len(fake.getCreationTimestampArgsForCall)]
+	fake.getCreationTimestampArgsForCall = append(fake.getCreationTimestampArgsForCall, struct {
+	}{})
+	stub := fake.GetCreationTimestampStub
+	fakeReturns := fake.getCreationTimestampReturns
+	fake.recordInvocation("GetCreationTimestamp", []interface{}{})
+	fake.getCreationTimestampMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetCreationTimestampCallCount() int {
+	fake.getCreationTimestampMutex.RLock()
+	defer fake.getCreationTimestampMutex.RUnlock()
+	return len(fake.getCreationTimestampArgsForCall)
+}
+
+func (fake *FakePromise) GetCreationTimestampCalls(stub func() v1a.Time) {
+	fake.getCreationTimestampMutex.Lock()
+	defer fake.getCreationTimestampMutex.Unlock()
+	fake.GetCreationTimestampStub = stub
+}
+
+func (fake *FakePromise) GetCreationTimestampReturns(result1 v1a.Time) {
+	fake.getCreationTimestampMutex.Lock()
+	defer fake.getCreationTimestampMutex.Unlock()
+	fake.GetCreationTimestampStub = nil
+	fake.getCreationTimestampReturns = struct {
+		result1 v1a.Time
+	}{result1}
+}
+
+func (fake *FakePromise) GetCreationTimestampReturnsOnCall(i int, result1 v1a.Time) {
+	fake.getCreationTimestampMutex.Lock()
+	defer fake.getCreationTimestampMutex.Unlock()
+	fake.GetCreationTimestampStub = nil
+	if fake.getCreationTimestampReturnsOnCall == nil {
+		fake.getCreationTimestampReturnsOnCall = make(map[int]struct {
+			result1 v1a.Time
+		})
+	}
+	fake.getCreationTimestampReturnsOnCall[i] = struct {
+		result1 v1a.Time
+	}{result1}
+}
+
+func (fake *FakePromise) GetDeletionTimestamp() *v1a.Time {
+	fake.getDeletionTimestampMutex.Lock()
+	ret, specificReturn := fake.getDeletionTimestampReturnsOnCall[len(fake.getDeletionTimestampArgsForCall)]
+	fake.getDeletionTimestampArgsForCall = append(fake.getDeletionTimestampArgsForCall, struct {
+	}{})
+	stub := fake.GetDeletionTimestampStub
+	fakeReturns := fake.getDeletionTimestampReturns
+	fake.recordInvocation("GetDeletionTimestamp", []interface{}{})
+	fake.getDeletionTimestampMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetDeletionTimestampCallCount() int {
+	fake.getDeletionTimestampMutex.RLock()
+	defer fake.getDeletionTimestampMutex.RUnlock()
+	return len(fake.getDeletionTimestampArgsForCall)
+}
+
+func (fake *FakePromise) GetDeletionTimestampCalls(stub func() *v1a.Time) {
+	fake.getDeletionTimestampMutex.Lock()
+	defer fake.getDeletionTimestampMutex.Unlock()
+	fake.GetDeletionTimestampStub = stub
+}
+
+func (fake *FakePromise) GetDeletionTimestampReturns(result1 *v1a.Time) {
+	fake.getDeletionTimestampMutex.Lock()
+	defer fake.getDeletionTimestampMutex.Unlock()
+	fake.GetDeletionTimestampStub = nil
+	fake.getDeletionTimestampReturns = struct {
+		result1 *v1a.Time
+	}{result1}
+}
+
+func (fake *FakePromise) GetDeletionTimestampReturnsOnCall(i int, result1 *v1a.Time) {
+	fake.getDeletionTimestampMutex.Lock()
+	defer fake.getDeletionTimestampMutex.Unlock()
+	fake.GetDeletionTimestampStub = nil
+	if fake.getDeletionTimestampReturnsOnCall == nil {
+		fake.getDeletionTimestampReturnsOnCall = make(map[int]struct {
+			result1 *v1a.Time
+		})
+	}
+	fake.getDeletionTimestampReturnsOnCall[i] = struct {
+		result1 *v1a.Time
+	}{result1}
+}
+
+func (fake *FakePromise) GetDependencies() []*unstructured.Unstructured {
+	fake.getDependenciesMutex.Lock()
+	ret, specificReturn := fake.getDependenciesReturnsOnCall[len(fake.getDependenciesArgsForCall)]
+	fake.getDependenciesArgsForCall = append(fake.getDependenciesArgsForCall, struct {
+	}{})
+	stub := fake.GetDependenciesStub
+	fakeReturns := fake.getDependenciesReturns
+	fake.recordInvocation("GetDependencies", []interface{}{})
+	fake.getDependenciesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetDependenciesCallCount() int {
+	fake.getDependenciesMutex.RLock()
+	defer fake.getDependenciesMutex.RUnlock()
+	return len(fake.getDependenciesArgsForCall)
+}
+
+func (fake *FakePromise) GetDependenciesCalls(stub func() []*unstructured.Unstructured) {
+	fake.getDependenciesMutex.Lock()
+	defer fake.getDependenciesMutex.Unlock()
+	fake.GetDependenciesStub = stub
+}
+
+func (fake *FakePromise) GetDependenciesReturns(result1 []*unstructured.Unstructured) {
+	fake.getDependenciesMutex.Lock()
+	defer fake.getDependenciesMutex.Unlock()
+	fake.GetDependenciesStub = nil
+	fake.getDependenciesReturns = struct {
+		result1 []*unstructured.Unstructured
+	}{result1}
+}
+
+func (fake *FakePromise) GetDependenciesReturnsOnCall(i int, result1 []*unstructured.Unstructured) {
+	fake.getDependenciesMutex.Lock()
+	defer fake.getDependenciesMutex.Unlock()
+	fake.GetDependenciesStub = nil
+	if fake.getDependenciesReturnsOnCall == nil {
+		fake.getDependenciesReturnsOnCall = make(map[int]struct {
+			result1 []*unstructured.Unstructured
+		})
+	}
+	fake.getDependenciesReturnsOnCall[i] = struct {
+		result1 []*unstructured.Unstructured
+	}{result1}
+}
+
+func (fake *FakePromise) GetDuration(arg1 string) (time.Duration, error) {
+	fake.getDurationMutex.Lock()
+	ret, specificReturn := fake.getDurationReturnsOnCall[len(fake.getDurationArgsForCall)]
+	fake.getDurationArgsForCall = append(fake.getDurationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetDurationStub
+	fakeReturns := fake.getDurationReturns
+	fake.recordInvocation("GetDuration", []interface{}{arg1})
+	fake.getDurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetDurationCallCount() int {
+	fake.getDurationMutex.RLock()
+	defer fake.getDurationMutex.RUnlock()
+	return len(fake.getDurationArgsForCall)
+}
+
+func (fake *FakePromise) GetDurationCalls(stub func(string) (time.Duration, error)) {
+	fake.getDurationMutex.Lock()
+	defer fake.getDurationMutex.Unlock()
+	fake.GetDurationStub = stub
+}
+
+func (fake *FakePromise) GetDurationArgsForCall(i int) string {
+	fake.getDurationMutex.RLock()
+	defer fake.getDurationMutex.RUnlock()
+	argsForCall := fake.getDurationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePromise) GetDurationReturns(result1 time.Duration, result2 error) {
+	fake.getDurationMutex.Lock()
+	defer fake.getDurationMutex.Unlock()
+	fake.GetDurationStub = nil
+	fake.getDurationReturns = struct {
+		result1 time.Duration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetDurationReturnsOnCall(i int, result1 time.Duration, result2 error) {
+	fake.getDurationMutex.Lock()
+	defer fake.getDurationMutex.Unlock()
+	fake.GetDurationStub = nil
+	if fake.getDurationReturnsOnCall == nil {
+		fake.getDurationReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+			result2 error
+		})
+	}
+	fake.getDurationReturnsOnCall[i] = struct {
+		result1 time.Duration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetDurationOrDefault(arg1 string, arg2 time.Duration) (time.Duration, error) {
+	fake.getDurationOrDefaultMutex.Lock()
+	ret, specificReturn := fake.getDurationOrDefaultReturnsOnCall[len(fake.getDurationOrDefaultArgsForCall)]
+	fake.getDurationOrDefaultArgsForCall = append(fake.getDurationOrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+	}{arg1, arg2})
+	stub := fake.GetDurationOrDefaultStub
+	fakeReturns := fake.getDurationOrDefaultReturns
+	fake.recordInvocation("GetDurationOrDefault", []interface{}{arg1, arg2})
+	fake.getDurationOrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetDurationOrDefaultCallCount() int {
+	fake.getDurationOrDefaultMutex.RLock()
+	defer fake.getDurationOrDefaultMutex.RUnlock()
+	return len(fake.getDurationOrDefaultArgsForCall)
+}
+
+func (fake *FakePromise) GetDurationOrDefaultCalls(stub func(string, time.Duration) (time.Duration, error)) {
+	fake.getDurationOrDefaultMutex.Lock()
+	defer fake.getDurationOrDefaultMutex.Unlock()
+	fake.GetDurationOrDefaultStub = stub
+}
+
+func (fake *FakePromise) GetDurationOrDefaultArgsForCall(i int) (string, time.Duration) {
+	fake.getDurationOrDefaultMutex.RLock()
+	defer fake.getDurationOrDefaultMutex.RUnlock()
+	argsForCall := fake.getDurationOrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromise) GetDurationOrDefaultReturns(result1 time.Duration, result2 error) {
+	fake.getDurationOrDefaultMutex.Lock()
+	defer fake.getDurationOrDefaultMutex.Unlock()
+	fake.GetDurationOrDefaultStub = nil
+	fake.getDurationOrDefaultReturns = struct {
+		result1 time.Duration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetDurationOrDefaultReturnsOnCall(i int, result1 time.Duration, result2 error) {
+	fake.getDurationOrDefaultMutex.Lock()
+	defer fake.getDurationOrDefaultMutex.Unlock()
+	fake.GetDurationOrDefaultStub = nil
+	if fake.getDurationOrDefaultReturnsOnCall == nil {
+		fake.getDurationOrDefaultReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+			result2 error
+		})
+	}
+	fake.getDurationOrDefaultReturnsOnCall[i] = struct {
+		result1 time.Duration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetFinalizers() []string {
+	fake.getFinalizersMutex.Lock()
+	ret, specificReturn := fake.getFinalizersReturnsOnCall[len(fake.getFinalizersArgsForCall)]
+	fake.getFinalizersArgsForCall = append(fake.getFinalizersArgsForCall, struct {
+	}{})
+	stub := fake.GetFinalizersStub
+	fakeReturns := fake.getFinalizersReturns
+	fake.recordInvocation("GetFinalizers", []interface{}{})
+	fake.getFinalizersMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetFinalizersCallCount() int {
+	fake.getFinalizersMutex.RLock()
+	defer fake.getFinalizersMutex.RUnlock()
+	return len(fake.getFinalizersArgsForCall)
+}
+
+func (fake *FakePromise) GetFinalizersCalls(stub func() []string) {
+	fake.getFinalizersMutex.Lock()
+	defer fake.getFinalizersMutex.Unlock()
+	fake.GetFinalizersStub = stub
+}
+
+func (fake *FakePromise) GetFinalizersReturns(result1 []string) {
+	fake.getFinalizersMutex.Lock()
+	defer fake.getFinalizersMutex.Unlock()
+	fake.GetFinalizersStub = nil
+	fake.getFinalizersReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakePromise) GetFinalizersReturnsOnCall(i int, result1 []string) {
+	fake.getFinalizersMutex.Lock()
+	defer fake.getFinalizersMutex.Unlock()
+	fake.GetFinalizersStub = nil
+	if fake.getFinalizersReturnsOnCall == nil {
+		fake.getFinalizersReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.getFinalizersReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakePromise) GetFloat64(arg1 string) (float64, error) {
+	fake.getFloat64Mutex.Lock()
+	ret, specificReturn := fake.getFloat64ReturnsOnCall[len(fake.getFloat64ArgsForCall)]
+	fake.getFloat64ArgsForCall = append(fake.getFloat64ArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetFloat64Stub
+	fakeReturns := fake.getFloat64Returns
+	fake.recordInvocation("GetFloat64", []interface{}{arg1})
+	fake.getFloat64Mutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetFloat64CallCount() int {
+	fake.getFloat64Mutex.RLock()
+	defer fake.getFloat64Mutex.RUnlock()
+	return len(fake.getFloat64ArgsForCall)
+}
+
+func (fake *FakePromise) GetFloat64Calls(stub func(string) (float64, error)) {
+	fake.getFloat64Mutex.Lock()
+	defer fake.getFloat64Mutex.Unlock()
+	fake.GetFloat64Stub = stub
+}
+
+func (fake *FakePromise) GetFloat64ArgsForCall(i int) string {
+	fake.getFloat64Mutex.RLock()
+	defer fake.getFloat64Mutex.RUnlock()
+	argsForCall := fake.getFloat64ArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePromise) GetFloat64Returns(result1 float64, result2 error) {
+	fake.getFloat64Mutex.Lock()
+	defer fake.getFloat64Mutex.Unlock()
+	fake.GetFloat64Stub = nil
+	fake.getFloat64Returns = struct {
+		result1 float64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetFloat64ReturnsOnCall(i int, result1 float64, result2 error) {
+	fake.getFloat64Mutex.Lock()
+	defer fake.getFloat64Mutex.Unlock()
+	fake.GetFloat64Stub = nil
+	if fake.getFloat64ReturnsOnCall == nil {
+		fake.getFloat64ReturnsOnCall = make(map[int]struct {
+			result1 float64
+			result2 error
+		})
+	}
+	fake.getFloat64ReturnsOnCall[i] = struct {
+		result1 float64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetFloat64OrDefault(arg1 string, arg2 float64) (float64, error) {
+	fake.getFloat64OrDefaultMutex.Lock()
+	ret, specificReturn := fake.getFloat64OrDefaultReturnsOnCall[len(fake.getFloat64OrDefaultArgsForCall)]
+	fake.getFloat64OrDefaultArgsForCall = append(fake.getFloat64OrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 float64
+	}{arg1, arg2})
+	stub := fake.GetFloat64OrDefaultStub
+	fakeReturns := fake.getFloat64OrDefaultReturns
+	fake.recordInvocation("GetFloat64OrDefault", []interface{}{arg1, arg2})
+	fake.getFloat64OrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetFloat64OrDefaultCallCount() int {
+	fake.getFloat64OrDefaultMutex.RLock()
+	defer fake.getFloat64OrDefaultMutex.RUnlock()
+	return len(fake.getFloat64OrDefaultArgsForCall)
+}
+
+func (fake *FakePromise) GetFloat64OrDefaultCalls(stub func(string, float64) (float64, error)) {
+	fake.getFloat64OrDefaultMutex.Lock()
+	defer fake.getFloat64OrDefaultMutex.Unlock()
+	fake.GetFloat64OrDefaultStub = stub
+}
+
+func (fake *FakePromise) GetFloat64OrDefaultArgsForCall(i int) (string, float64) {
+	fake.getFloat64OrDefaultMutex.RLock()
+	defer fake.getFloat64OrDefaultMutex.RUnlock()
+	argsForCall := fake.getFloat64OrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromise) GetFloat64OrDefaultReturns(result1 float64, result2 error) {
+	fake.getFloat64OrDefaultMutex.Lock()
+	defer fake.getFloat64OrDefaultMutex.Unlock()
+	fake.GetFloat64OrDefaultStub = nil
+	fake.getFloat64OrDefaultReturns = struct {
+		result1 float64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetFloat64OrDefaultReturnsOnCall(i int, result1 float64, result2 error) {
+	fake.getFloat64OrDefaultMutex.Lock()
+	defer fake.getFloat64OrDefaultMutex.Unlock()
+	fake.GetFloat64OrDefaultStub = nil
+	if fake.getFloat64OrDefaultReturnsOnCall == nil {
+		fake.getFloat64OrDefaultReturnsOnCall = make(map[int]struct {
+			result1 float64
+			result2 error
+		})
+	}
+	fake.getFloat64OrDefaultReturnsOnCall[i] = struct {
+		result1 float64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetGeneration() int64 {
+	fake.getGenerationMutex.Lock()
+	ret, specificReturn := fake.getGenerationReturnsOnCall[len(fake.getGenerationArgsForCall)]
+	fake.getGenerationArgsForCall = append(fake.getGenerationArgsForCall, struct {
+	}{})
+	stub := fake.GetGenerationStub
+	fakeReturns := fake.getGenerationReturns
+	fake.recordInvocation("GetGeneration", []interface{}{})
+	fake.getGenerationMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetGenerationCallCount() int {
+	fake.getGenerationMutex.RLock()
+	defer fake.getGenerationMutex.RUnlock()
+	return len(fake.getGenerationArgsForCall)
+}
+
+func (fake *FakePromise) GetGenerationCalls(stub func() int64) {
+	fake.getGenerationMutex.Lock()
+	defer fake.getGenerationMutex.Unlock()
+	fake.GetGenerationStub = stub
+}
+
+func (fake *FakePromise) GetGenerationReturns(result1 int64) {
+	fake.getGenerationMutex.Lock()
+	defer fake.getGenerationMutex.Unlock()
+	fake.GetGenerationStub = nil
+	fake.getGenerationReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakePromise) GetGenerationReturnsOnCall(i int, result1 int64) {
+	fake.getGenerationMutex.Lock()
+	defer fake.getGenerationMutex.Unlock()
+	fake.GetGenerationStub = nil
+	if fake.getGenerationReturnsOnCall == nil {
+		fake.getGenerationReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.getGenerationReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakePromise) GetGroupVersionKind() schema.GroupVersionKind {
+	fake.getGroupVersionKindMutex.Lock()
+	ret, specificReturn := fake.getGroupVersionKindReturnsOnCall[len(fake.getGroupVersionKindArgsForCall)]
+	fake.getGroupVersionKindArgsForCall = append(fake.getGroupVersionKindArgsForCall, struct {
+	}{})
+	stub := fake.GetGroupVersionKindStub
+	fakeReturns := fake.getGroupVersionKindReturns
+	fake.recordInvocation("GetGroupVersionKind", []interface{}{})
+	fake.getGroupVersionKindMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetGroupVersionKindCallCount() int {
+	fake.getGroupVersionKindMutex.RLock()
+	defer fake.getGroupVersionKindMutex.RUnlock()
+	return len(fake.getGroupVersionKindArgsForCall)
+}
+
+func (fake *FakePromise) GetGroupVersionKindCalls(stub func() schema.GroupVersionKind) {
+	fake.getGroupVersionKindMutex.Lock()
+	defer fake.getGroupVersionKindMutex.Unlock()
+	fake.GetGroupVersionKindStub = stub
+}
+
+func (fake *FakePromise) GetGroupVersionKindReturns(result1 schema.GroupVersionKind) {
+	fake.getGroupVersionKindMutex.Lock()
+	defer fake.getGroupVersionKindMutex.Unlock()
+	fake.GetGroupVersionKindStub = nil
+	fake.getGroupVersionKindReturns = struct {
+		result1 schema.GroupVersionKind
+	}{result1}
+}
+
+func (fake *FakePromise) GetGroupVersionKindReturnsOnCall(i int, result1 schema.GroupVersionKind) {
+	fake.getGroupVersionKindMutex.Lock()
+	defer fake.getGroupVersionKindMutex.Unlock()
+	fake.GetGroupVersionKindStub = nil
+	if fake.getGroupVersionKindReturnsOnCall == nil {
+		fake.getGroupVersionKindReturnsOnCall = make(map[int]struct {
+			result1 schema.GroupVersionKind
+		})
+	}
+	fake.getGroupVersionKindReturnsOnCall[i] = struct {
+		result1 schema.GroupVersionKind
+	}{result1}
+}
+
+func (fake *FakePromise) GetInt64(arg1 string) (int64, error) {
+	fake.getInt64Mutex.Lock()
+	ret, specificReturn := fake.getInt64ReturnsOnCall[len(fake.getInt64ArgsForCall)]
+	fake.getInt64ArgsForCall = append(fake.getInt64ArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetInt64Stub
+	fakeReturns := fake.getInt64Returns
+	fake.recordInvocation("GetInt64", []interface{}{arg1})
+	fake.getInt64Mutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetInt64CallCount() int {
+	fake.getInt64Mutex.RLock()
+	defer fake.getInt64Mutex.RUnlock()
+	return len(fake.getInt64ArgsForCall)
+}
+
+func (fake *FakePromise) GetInt64Calls(stub func(string) (int64, error)) {
+	fake.getInt64Mutex.Lock()
+	defer fake.getInt64Mutex.Unlock()
+	fake.GetInt64Stub = stub
+}
+
+func (fake *FakePromise) GetInt64ArgsForCall(i int) string {
+	fake.getInt64Mutex.RLock()
+	defer fake.getInt64Mutex.RUnlock()
+	argsForCall := fake.getInt64ArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePromise) GetInt64Returns(result1 int64, result2 error) {
+	fake.getInt64Mutex.Lock()
+	defer fake.getInt64Mutex.Unlock()
+	fake.GetInt64Stub = nil
+	fake.getInt64Returns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetInt64ReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.getInt64Mutex.Lock()
+	defer fake.getInt64Mutex.Unlock()
+	fake.GetInt64Stub = nil
+	if fake.getInt64ReturnsOnCall == nil {
+		fake.getInt64ReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.getInt64ReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetInt64OrDefault(arg1 string, arg2 int64) (int64, error) {
+	fake.getInt64OrDefaultMutex.Lock()
+	ret, specificReturn := fake.getInt64OrDefaultReturnsOnCall[len(fake.getInt64OrDefaultArgsForCall)]
+	fake.getInt64OrDefaultArgsForCall = append(fake.getInt64OrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 int64
+	}{arg1, arg2})
+	stub := fake.GetInt64OrDefaultStub
+	fakeReturns := fake.getInt64OrDefaultReturns
+	fake.recordInvocation("GetInt64OrDefault", []interface{}{arg1, arg2})
+	fake.getInt64OrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetInt64OrDefaultCallCount() int {
+	fake.getInt64OrDefaultMutex.RLock()
+	defer fake.getInt64OrDefaultMutex.RUnlock()
+	return len(fake.getInt64OrDefaultArgsForCall)
+}
+
+func (fake *FakePromise) GetInt64OrDefaultCalls(stub func(string, int64) (int64, error)) {
+	fake.getInt64OrDefaultMutex.Lock()
+	defer fake.getInt64OrDefaultMutex.Unlock()
+	fake.GetInt64OrDefaultStub = stub
+}
+
+func (fake *FakePromise) GetInt64OrDefaultArgsForCall(i int) (string, int64) {
+	fake.getInt64OrDefaultMutex.RLock()
+	defer fake.getInt64OrDefaultMutex.RUnlock()
+	argsForCall := fake.getInt64OrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromise) GetInt64OrDefaultReturns(result1 int64, result2 error) {
+	fake.getInt64OrDefaultMutex.Lock()
+	defer fake.getInt64OrDefaultMutex.Unlock()
+	fake.GetInt64OrDefaultStub = nil
+	fake.getInt64OrDefaultReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetInt64OrDefaultReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.getInt64OrDefaultMutex.Lock()
+	defer fake.getInt64OrDefaultMutex.Unlock()
+	fake.GetInt64OrDefaultStub = nil
+	if fake.getInt64OrDefaultReturnsOnCall == nil {
+		fake.getInt64OrDefaultReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.getInt64OrDefaultReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetLabels() map[string]string {
+	fake.getLabelsMutex.Lock()
+	ret, specificReturn := fake.getLabelsReturnsOnCall[len(fake.getLabelsArgsForCall)]
+	fake.getLabelsArgsForCall = append(fake.getLabelsArgsForCall, struct {
+	}{})
+	stub := fake.GetLabelsStub
+	fakeReturns := fake.getLabelsReturns
+	fake.recordInvocation("GetLabels", []interface{}{})
+	fake.getLabelsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetLabelsCallCount() int {
+	fake.getLabelsMutex.RLock()
+	defer fake.getLabelsMutex.RUnlock()
+	return len(fake.getLabelsArgsForCall)
+}
+
+func (fake *FakePromise) GetLabelsCalls(stub func() map[string]string) {
+	fake.getLabelsMutex.Lock()
+	defer fake.getLabelsMutex.Unlock()
+	fake.GetLabelsStub = stub
+}
+
+func (fake *FakePromise) GetLabelsReturns(result1 map[string]string) {
+	fake.getLabelsMutex.Lock()
+	defer fake.getLabelsMutex.Unlock()
+	fake.GetLabelsStub = nil
+	fake.getLabelsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakePromise) GetLabelsReturnsOnCall(i int, result1 map[string]string) {
+	fake.getLabelsMutex.Lock()
+	defer fake.getLabelsMutex.Unlock()
+	fake.GetLabelsStub = nil
+	if fake.getLabelsReturnsOnCall == nil {
+		fake.getLabelsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.getLabelsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakePromise) GetMetadata() map[string]any {
+	fake.getMetadataMutex.Lock()
+	ret, specificReturn := fake.getMetadataReturnsOnCall[len(fake.getMetadataArgsForCall)]
+	fake.getMetadataArgsForCall = append(fake.getMetadataArgsForCall, struct {
+	}{})
+	stub := fake.GetMetadataStub
+	fakeReturns := fake.getMetadataReturns
+	fake.recordInvocation("GetMetadata", []interface{}{})
+	fake.getMetadataMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetMetadataCallCount() int {
+	fake.getMetadataMutex.RLock()
+	defer fake.getMetadataMutex.RUnlock()
+	return len(fake.getMetadataArgsForCall)
+}
+
+func (fake *FakePromise) GetMetadataCalls(stub func() map[string]any) {
+	fake.getMetadataMutex.Lock()
+	defer fake.getMetadataMutex.Unlock()
+	fake.GetMetadataStub = stub
+}
+
+func (fake *FakePromise) GetMetadataReturns(result1 map[string]any) {
+	fake.getMetadataMutex.Lock()
+	defer fake.getMetadataMutex.Unlock()
+	fake.GetMetadataStub = nil
+	fake.getMetadataReturns = struct {
+		result1 map[string]any
+	}{result1}
+}
+
+func (fake *FakePromise) GetMetadataReturnsOnCall(i int, result1 map[string]any) {
+	fake.getMetadataMutex.Lock()
+	defer fake.getMetadataMutex.Unlock()
+	fake.GetMetadataStub = nil
+	if fake.getMetadataReturnsOnCall == nil {
+		fake.getMetadataReturnsOnCall = make(map[int]struct {
+			result1 map[string]any
+		})
+	}
+	fake.getMetadataReturnsOnCall[i] = struct {
+		result1 map[string]any
+	}{result1}
+}
+
+func (fake *FakePromise) GetName() string {
+	fake.getNameMutex.Lock()
+	ret, specificReturn := fake.getNameReturnsOnCall[len(fake.getNameArgsForCall)]
+	fake.getNameArgsForCall = append(fake.getNameArgsForCall, struct {
+	}{})
+	stub := fake.GetNameStub
+	fakeReturns := fake.getNameReturns
+	fake.recordInvocation("GetName", []interface{}{})
+	fake.getNameMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetNameCallCount() int {
+	fake.getNameMutex.RLock()
+	defer fake.getNameMutex.RUnlock()
+	return len(fake.getNameArgsForCall)
+}
+
+func (fake *FakePromise) GetNameCalls(stub func() string) {
+	fake.getNameMutex.Lock()
+	defer fake.getNameMutex.Unlock()
+	fake.GetNameStub = stub
+}
+
+func (fake *FakePromise) GetNameReturns(result1 string) {
+	fake.getNameMutex.Lock()
+	defer fake.getNameMutex.Unlock()
+	fake.GetNameStub = nil
+	fake.getNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePromise) GetNameReturnsOnCall(i int, result1 string) {
+	fake.getNameMutex.Lock()
+	defer fake.getNameMutex.Unlock()
+	fake.GetNameStub = nil
+	if fake.getNameReturnsOnCall == nil {
+		fake.getNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePromise) GetNamespace() string {
+	fake.getNamespaceMutex.Lock()
+	ret, specificReturn := fake.getNamespaceReturnsOnCall[len(fake.getNamespaceArgsForCall)]
+	fake.getNamespaceArgsForCall = append(fake.getNamespaceArgsForCall, struct {
+	}{})
+	stub := fake.GetNamespaceStub
+	fakeReturns := fake.getNamespaceReturns
+	fake.recordInvocation("GetNamespace", []interface{}{})
+	fake.getNamespaceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetNamespaceCallCount() int {
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
+	return len(fake.getNamespaceArgsForCall)
+}
+
+func (fake *FakePromise) GetNamespaceCalls(stub func() string) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = stub
+}
+
+func (fake *FakePromise) GetNamespaceReturns(result1 string) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = nil
+	fake.getNamespaceReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePromise) GetNamespaceReturnsOnCall(i int, result1 string) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = nil
+	if fake.getNamespaceReturnsOnCall == nil {
+		fake.getNamespaceReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getNamespaceReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePromise) GetOwnerReferences() []v1a.OwnerReference {
+	fake.getOwnerReferencesMutex.Lock()
+	ret, specificReturn := fake.getOwnerReferencesReturnsOnCall[len(fake.getOwnerReferencesArgsForCall)]
+	fake.getOwnerReferencesArgsForCall = append(fake.getOwnerReferencesArgsForCall, struct {
+	}{})
+	stub := fake.GetOwnerReferencesStub
+	fakeReturns := fake.getOwnerReferencesReturns
+	fake.recordInvocation("GetOwnerReferences", []interface{}{})
+	fake.getOwnerReferencesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetOwnerReferencesCallCount() int {
+	fake.getOwnerReferencesMutex.RLock()
+	defer fake.getOwnerReferencesMutex.RUnlock()
+	return len(fake.getOwnerReferencesArgsForCall)
+}
+
+func (fake *FakePromise) GetOwnerReferencesCalls(stub func() []v1a.OwnerReference) {
+	fake.getOwnerReferencesMutex.Lock()
+	defer fake.getOwnerReferencesMutex.Unlock()
+	fake.GetOwnerReferencesStub = stub
+}
+
+func (fake *FakePromise) GetOwnerReferencesReturns(result1 []v1a.OwnerReference) {
+	fake.getOwnerReferencesMutex.Lock()
+	defer fake.getOwnerReferencesMutex.Unlock()
+	fake.GetOwnerReferencesStub = nil
+	fake.getOwnerReferencesReturns = struct {
+		result1 []v1a.OwnerReference
+	}{result1}
+}
+
+func (fake *FakePromise) GetOwnerReferencesReturnsOnCall(i int, result1 []v1a.OwnerReference) {
+	fake.getOwnerReferencesMutex.Lock()
+	defer fake.getOwnerReferencesMutex.Unlock()
+	fake.GetOwnerReferencesStub = nil
+	if fake.getOwnerReferencesReturnsOnCall == nil {
+		fake.getOwnerReferencesReturnsOnCall = make(map[int]struct {
+			result1 []v1a.OwnerReference
+		})
+	}
+	fake.getOwnerReferencesReturnsOnCall[i] = struct {
+		result1 []v1a.OwnerReference
+	}{result1}
+}
+
+func (fake *FakePromise) GetPipeline(arg1 string, arg2 string, arg3 string) (*v1alpha1.Pipeline, error) {
+	fake.getPipelineMutex.Lock()
+	ret, specificReturn := fake.getPipelineReturnsOnCall[len(fake.getPipelineArgsForCall)]
+	fake.getPipelineArgsForCall = append(fake.getPipelineArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetPipelineStub
+	fakeReturns := fake.getPipelineReturns
+	fake.recordInvocation("GetPipeline", []interface{}{arg1, arg2, arg3})
+	fake.getPipelineMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetPipelineCallCount() int {
+	fake.getPipelineMutex.RLock()
+	defer fake.getPipelineMutex.RUnlock()
+	return len(fake.getPipelineArgsForCall)
+}
+
+func (fake *FakePromise) GetPipelineCalls(stub func(string, string, string) (*v1alpha1.Pipeline, error)) {
+	fake.getPipelineMutex.Lock()
+	defer fake.getPipelineMutex.Unlock()
+	fake.GetPipelineStub = stub
+}
+
+func (fake *FakePromise) GetPipelineArgsForCall(i int) (string, string, string) {
+	fake.getPipelineMutex.RLock()
+	defer fake.getPipelineMutex.RUnlock()
+	argsForCall := fake.getPipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePromise) GetPipelineReturns(result1 *v1alpha1.Pipeline, result2 error) {
+	fake.getPipelineMutex.Lock()
+	defer fake.getPipelineMutex.Unlock()
+	fake.GetPipelineStub = nil
+	fake.getPipelineReturns = struct {
+		result1 *v1alpha1.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetPipelineReturnsOnCall(i int, result1 *v1alpha1.Pipeline, result2 error) {
+	fake.getPipelineMutex.Lock()
+	defer fake.getPipelineMutex.Unlock()
+	fake.GetPipelineStub = nil
+	if fake.getPipelineReturnsOnCall == nil {
+		fake.getPipelineReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Pipeline
+			result2 error
+		})
+	}
+	fake.getPipelineReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetPipelines(arg1 string, arg2 string) ([]v1alpha1.Pipeline, error) {
+	fake.getPipelinesMutex.Lock()
+	ret, specificReturn := fake.getPipelinesReturnsOnCall[len(fake.getPipelinesArgsForCall)]
+	fake.getPipelinesArgsForCall = append(fake.getPipelinesArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetPipelinesStub
+	fakeReturns := fake.getPipelinesReturns
+	fake.recordInvocation("GetPipelines", []interface{}{arg1, arg2})
+	fake.getPipelinesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetPipelinesCallCount() int {
+	fake.getPipelinesMutex.RLock()
+	defer fake.getPipelinesMutex.RUnlock()
+	return len(fake.getPipelinesArgsForCall)
+}
+
+func (fake *FakePromise) GetPipelinesCalls(stub func(string, string) ([]v1alpha1.Pipeline, error)) {
+	fake.getPipelinesMutex.Lock()
+	defer fake.getPipelinesMutex.Unlock()
+	fake.GetPipelinesStub = stub
+}
+
+func (fake *FakePromise) GetPipelinesArgsForCall(i int) (string, string) {
+	fake.getPipelinesMutex.RLock()
+	defer fake.getPipelinesMutex.RUnlock()
+	argsForCall := fake.getPipelinesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromise) GetPipelinesReturns(result1 []v1alpha1.Pipeline, result2 error) {
+	fake.getPipelinesMutex.Lock()
+	defer fake.getPipelinesMutex.Unlock()
+	fake.GetPipelinesStub = nil
+	fake.getPipelinesReturns = struct {
+		result1 []v1alpha1.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetPipelinesReturnsOnCall(i int, result1 []v1alpha1.Pipeline, result2 error) {
+	fake.getPipelinesMutex.Lock()
+	defer fake.getPipelinesMutex.Unlock()
+	fake.GetPipelinesStub = nil
+	if fake.getPipelinesReturnsOnCall == nil {
+		fake.getPipelinesReturnsOnCall = make(map[int]struct {
+			result1 []v1alpha1.Pipeline
+			result2 error
+		})
+	}
+	fake.getPipelinesReturnsOnCall[i] = struct {
+		result1 []v1alpha1.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetPromise() *v1alpha1.Promise {
+	fake.getPromiseMutex.Lock()
+	ret, specificReturn := fake.getPromiseReturnsOnCall[len(fake.getPromiseArgsForCall)]
+	fake.getPromiseArgsForCall = append(fake.getPromiseArgsForCall, struct {
+	}{})
+	stub := fake.GetPromiseStub
+	fakeReturns := fake.getPromiseReturns
+	fake.recordInvocation("GetPromise", []interface{}{})
+	fake.getPromiseMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetPromiseCallCount() int {
+	fake.getPromiseMutex.RLock()
+	defer fake.getPromiseMutex.RUnlock()
+	return len(fake.getPromiseArgsForCall)
+}
+
+func (fake *FakePromise) GetPromiseCalls(stub func() *v1alpha1.Promise) {
+	fake.getPromiseMutex.Lock()
+	defer fake.getPromiseMutex.Unlock()
+	fake.GetPromiseStub = stub
+}
+
+func (fake *FakePromise) GetPromiseReturns(result1 *v1alpha1.Promise) {
+	fake.getPromiseMutex.Lock()
+	defer fake.getPromiseMutex.Unlock()
+	fake.GetPromiseStub = nil
+	fake.getPromiseReturns = struct {
+		result1 *v1alpha1.Promise
+	}{result1}
+}
+
+func (fake *FakePromise) GetPromiseReturnsOnCall(i int, result1 *v1alpha1.Promise) {
+	fake.getPromiseMutex.Lock()
+	defer fake.getPromiseMutex.Unlock()
+	fake.GetPromiseStub = nil
+	if fake.getPromiseReturnsOnCall == nil {
+		fake.getPromiseReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Promise
+		})
+	}
+	fake.getPromiseReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Promise
+	}{result1}
+}
+
+func (fake *FakePromise) GetQuantity(arg1 string) (resource.Quantity, error) {
+	fake.getQuantityMutex.Lock()
+	ret, specificReturn := fake.getQuantityReturnsOnCall[len(fake.getQuantityArgsForCall)]
+	fake.getQuantityArgsForCall = append(fake.getQuantityArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetQuantityStub
+	fakeReturns := fake.getQuantityReturns
+	fake.recordInvocation("GetQuantity", []interface{}{arg1})
+	fake.getQuantityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetQuantityCallCount() int {
+	fake.getQuantityMutex.RLock()
+	defer fake.getQuantityMutex.RUnlock()
+	return len(fake.getQuantityArgsForCall)
+}
+
+func (fake *FakePromise) GetQuantityCalls(stub func(string) (resource.Quantity, error)) {
+	fake.getQuantityMutex.Lock()
+	defer fake.getQuantityMutex.Unlock()
+	fake.GetQuantityStub = stub
+}
+
+func (fake *FakePromise) GetQuantityArgsForCall(i int) string {
+	fake.getQuantityMutex.RLock()
+	defer fake.getQuantityMutex.RUnlock()
+	argsForCall := fake.getQuantityArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePromise) GetQuantityReturns(result1 resource.Quantity, result2 error) {
+	fake.getQuantityMutex.Lock()
+	defer fake.getQuantityMutex.Unlock()
+	fake.GetQuantityStub = nil
+	fake.getQuantityReturns = struct {
+		result1 resource.Quantity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetQuantityReturnsOnCall(i int, result1 resource.Quantity, result2 error) {
+	fake.getQuantityMutex.Lock()
+	defer fake.getQuantityMutex.Unlock()
+	fake.GetQuantityStub = nil
+	if fake.getQuantityReturnsOnCall == nil {
+		fake.getQuantityReturnsOnCall = make(map[int]struct {
+			result1 resource.Quantity
+			result2 error
+		})
+	}
+	fake.getQuantityReturnsOnCall[i] = struct {
+		result1 resource.Quantity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetQuantityOrDefault(arg1 string, arg2 resource.Quantity) (resource.Quantity, error) {
+	fake.getQuantityOrDefaultMutex.Lock()
+	ret, specificReturn := fake.getQuantityOrDefaultReturnsOnCall[len(fake.getQuantityOrDefaultArgsForCall)]
+	fake.getQuantityOrDefaultArgsForCall = append(fake.getQuantityOrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 resource.Quantity
+	}{arg1, arg2})
+	stub := fake.GetQuantityOrDefaultStub
+	fakeReturns := fake.getQuantityOrDefaultReturns
+	fake.recordInvocation("GetQuantityOrDefault", []interface{}{arg1, arg2})
+	fake.getQuantityOrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetQuantityOrDefaultCallCount() int {
+	fake.getQuantityOrDefaultMutex.RLock()
+	defer fake.getQuantityOrDefaultMutex.RUnlock()
+	return len(fake.getQuantityOrDefaultArgsForCall)
+}
+
+func (fake *FakePromise) GetQuantityOrDefaultCalls(stub func(string, resource.Quantity) (resource.Quantity, error)) {
+	fake.getQuantityOrDefaultMutex.Lock()
+	defer fake.getQuantityOrDefaultMutex.Unlock()
+	fake.GetQuantityOrDefaultStub = stub
+}
+
+func (fake *FakePromise) GetQuantityOrDefaultArgsForCall(i int) (string, resource.Quantity) {
+	fake.getQuantityOrDefaultMutex.RLock()
+	defer fake.getQuantityOrDefaultMutex.RUnlock()
+	argsForCall := fake.getQuantityOrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromise) GetQuantityOrDefaultReturns(result1 resource.Quantity, result2 error) {
+	fake.getQuantityOrDefaultMutex.Lock()
+	defer fake.getQuantityOrDefaultMutex.Unlock()
+	fake.GetQuantityOrDefaultStub = nil
+	fake.getQuantityOrDefaultReturns = struct {
+		result1 resource.Quantity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetQuantityOrDefaultReturnsOnCall(i int, result1 resource.Quantity, result2 error) {
+	fake.getQuantityOrDefaultMutex.Lock()
+	defer fake.getQuantityOrDefaultMutex.Unlock()
+	fake.GetQuantityOrDefaultStub = nil
+	if fake.getQuantityOrDefaultReturnsOnCall == nil {
+		fake.getQuantityOrDefaultReturnsOnCall = make(map[int]struct {
+			result1 resource.Quantity
+			result2 error
+		})
+	}
+	fake.getQuantityOrDefaultReturnsOnCall[i] = struct {
+		result1 resource.Quantity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetResourceVersion() string {
+	fake.getResourceVersionMutex.Lock()
+	ret, specificReturn := fake.getResourceVersionReturnsOnCall[len(fake.getResourceVersionArgsForCall)]
+	fake.getResourceVersionArgsForCall = append(fake.getResourceVersionArgsForCall, struct {
+	}{})
+	stub := fake.GetResourceVersionStub
+	fakeReturns := fake.getResourceVersionReturns
+	fake.recordInvocation("GetResourceVersion", []interface{}{})
+	fake.getResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetResourceVersionCallCount() int {
+	fake.getResourceVersionMutex.RLock()
+	defer fake.getResourceVersionMutex.RUnlock()
+	return len(fake.getResourceVersionArgsForCall)
+}
+
+func (fake *FakePromise) GetResourceVersionCalls(stub func() string) {
+	fake.getResourceVersionMutex.Lock()
+	defer fake.getResourceVersionMutex.Unlock()
+	fake.GetResourceVersionStub = stub
+}
+
+func (fake *FakePromise) GetResourceVersionReturns(result1 string) {
+	fake.getResourceVersionMutex.Lock()
+	defer fake.getResourceVersionMutex.Unlock()
+	fake.GetResourceVersionStub = nil
+	fake.getResourceVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePromise) GetResourceVersionReturnsOnCall(i int, result1 string) {
+	fake.getResourceVersionMutex.Lock()
+	defer fake.getResourceVersionMutex.Unlock()
+	fake.GetResourceVersionStub = nil
+	if fake.getResourceVersionReturnsOnCall == nil {
+		fake.getResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getResourceVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePromise) GetStatus() (kratix.Status, error) {
+	fake.getStatusMutex.Lock()
+	ret, specificReturn := fake.getStatusReturnsOnCall[len(fake.getStatusArgsForCall)]
+	fake.getStatusArgsForCall = append(fake.getStatusArgsForCall, struct {
+	}{})
+	stub := fake.GetStatusStub
+	fakeReturns := fake.getStatusReturns
+	fake.recordInvocation("GetStatus", []interface{}{})
+	fake.getStatusMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetStatusCallCount() int {
+	fake.getStatusMutex.RLock()
+	defer fake.getStatusMutex.RUnlock()
+	return len(fake.getStatusArgsForCall)
+}
+
+func (fake *FakePromise) GetStatusCalls(stub func() (kratix.Status, error)) {
+	fake.getStatusMutex.Lock()
+	defer fake.getStatusMutex.Unlock()
+	fake.GetStatusStub = stub
+}
+
+func (fake *FakePromise) GetStatusReturns(result1 kratix.Status, result2 error) {
+	fake.getStatusMutex.Lock()
+	defer fake.getStatusMutex.Unlock()
+	fake.GetStatusStub = nil
+	fake.getStatusReturns = struct {
+		result1 kratix.Status
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetStatusReturnsOnCall(i int, result1 kratix.Status, result2 error) {
+	fake.getStatusMutex.Lock()
+	defer fake.getStatusMutex.Unlock()
+	fake.GetStatusStub = nil
+	if fake.getStatusReturnsOnCall == nil {
+		fake.getStatusReturnsOnCall = make(map[int]struct {
+			result1 kratix.Status
+			result2 error
+		})
+	}
+	fake.getStatusReturnsOnCall[i] = struct {
+		result1 kratix.Status
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetStorageVersion() (string, error) {
+	fake.getStorageVersionMutex.Lock()
+	ret, specificReturn := fake.getStorageVersionReturnsOnCall[len(fake.getStorageVersionArgsForCall)]
+	fake.getStorageVersionArgsForCall = append(fake.getStorageVersionArgsForCall, struct {
+	}{})
+	stub := fake.GetStorageVersionStub
+	fakeReturns := fake.getStorageVersionReturns
+	fake.recordInvocation("GetStorageVersion", []interface{}{})
+	fake.getStorageVersionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetStorageVersionCallCount() int {
+	fake.getStorageVersionMutex.RLock()
+	defer fake.getStorageVersionMutex.RUnlock()
+	return len(fake.getStorageVersionArgsForCall)
+}
+
+func (fake *FakePromise) GetStorageVersionCalls(stub func() (string, error)) {
+	fake.getStorageVersionMutex.Lock()
+	defer fake.getStorageVersionMutex.Unlock()
+	fake.GetStorageVersionStub = stub
+}
+
+func (fake *FakePromise) GetStorageVersionReturns(result1 string, result2 error) {
+	fake.getStorageVersionMutex.Lock()
+	defer fake.getStorageVersionMutex.Unlock()
+	fake.GetStorageVersionStub = nil
+	fake.getStorageVersionReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetStorageVersionReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getStorageVersionMutex.Lock()
+	defer fake.getStorageVersionMutex.Unlock()
+	fake.GetStorageVersionStub = nil
+	if fake.getStorageVersionReturnsOnCall == nil {
+		fake.getStorageVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getStorageVersionReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetString(arg1 string) (string, error) {
+	fake.getStringMutex.Lock()
+	ret, specificReturn := fake.getStringReturnsOnCall[len(fake.getStringArgsForCall)]
+	fake.getStringArgsForCall = append(fake.getStringArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetStringStub
+	fakeReturns := fake.getStringReturns
+	fake.recordInvocation("GetString", []interface{}{arg1})
+	fake.getStringMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetStringCallCount() int {
+	fake.getStringMutex.RLock()
+	defer fake.getStringMutex.RUnlock()
+	return len(fake.getStringArgsForCall)
+}
+
+func (fake *FakePromise) GetStringCalls(stub func(string) (string, error)) {
+	fake.getStringMutex.Lock()
+	defer fake.getStringMutex.Unlock()
+	fake.GetStringStub = stub
+}
+
+func (fake *FakePromise) GetStringArgsForCall(i int) string {
+	fake.getStringMutex.RLock()
+	defer fake.getStringMutex.RUnlock()
+	argsForCall := fake.getStringArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePromise) GetStringReturns(result1 string, result2 error) {
+	fake.getStringMutex.Lock()
+	defer fake.getStringMutex.Unlock()
+	fake.GetStringStub = nil
+	fake.getStringReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetStringReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getStringMutex.Lock()
+	defer fake.getStringMutex.Unlock()
+	fake.GetStringStub = nil
+	if fake.getStringReturnsOnCall == nil {
+		fake.getStringReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getStringReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetStringMap(arg1 string) (map[string]string, error) {
+	fake.getStringMapMutex.Lock()
+	ret, specificReturn := fake.getStringMapReturnsOnCall[len(fake.getStringMapArgsForCall)]
+	fake.getStringMapArgsForCall = append(fake.getStringMapArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetStringMapStub
+	fakeReturns := fake.getStringMapReturns
+	fake.recordInvocation("GetStringMap", []interface{}{arg1})
+	fake.getStringMapMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetStringMapCallCount() int {
+	fake.getStringMapMutex.RLock()
+	defer fake.getStringMapMutex.RUnlock()
+	return len(fake.getStringMapArgsForCall)
+}
+
+func (fake *FakePromise) GetStringMapCalls(stub func(string) (map[string]string, error)) {
+	fake.getStringMapMutex.Lock()
+	defer fake.getStringMapMutex.Unlock()
+	fake.GetStringMapStub = stub
+}
+
+func (fake *FakePromise) GetStringMapArgsForCall(i int) string {
+	fake.getStringMapMutex.RLock()
+	defer fake.getStringMapMutex.RUnlock()
+	argsForCall := fake.getStringMapArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePromise) GetStringMapReturns(result1 map[string]string, result2 error) {
+	fake.getStringMapMutex.Lock()
+	defer fake.getStringMapMutex.Unlock()
+	fake.GetStringMapStub = nil
+	fake.getStringMapReturns = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetStringMapReturnsOnCall(i int, result1 map[string]string, result2 error) {
+	fake.getStringMapMutex.Lock()
+	defer fake.getStringMapMutex.Unlock()
+	fake.GetStringMapStub = nil
+	if fake.getStringMapReturnsOnCall == nil {
+		fake.getStringMapReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+			result2 error
+		})
+	}
+	fake.getStringMapReturnsOnCall[i] = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetStringMapOrDefault(arg1 string, arg2 map[string]string) (map[string]string, error) {
+	fake.getStringMapOrDefaultMutex.Lock()
+	ret, specificReturn := fake.getStringMapOrDefaultReturnsOnCall[len(fake.getStringMapOrDefaultArgsForCall)]
+	fake.getStringMapOrDefaultArgsForCall = append(fake.getStringMapOrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 map[string]string
+	}{arg1, arg2})
+	stub := fake.GetStringMapOrDefaultStub
+	fakeReturns := fake.getStringMapOrDefaultReturns
+	fake.recordInvocation("GetStringMapOrDefault", []interface{}{arg1, arg2})
+	fake.getStringMapOrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetStringMapOrDefaultCallCount() int {
+	fake.getStringMapOrDefaultMutex.RLock()
+	defer fake.getStringMapOrDefaultMutex.RUnlock()
+	return len(fake.getStringMapOrDefaultArgsForCall)
+}
+
+func (fake *FakePromise) GetStringMapOrDefaultCalls(stub func(string, map[string]string) (map[string]string, error)) {
+	fake.getStringMapOrDefaultMutex.Lock()
+	defer fake.getStringMapOrDefaultMutex.Unlock()
+	fake.GetStringMapOrDefaultStub = stub
+}
+
+func (fake *FakePromise) GetStringMapOrDefaultArgsForCall(i int) (string, map[string]string) {
+	fake.getStringMapOrDefaultMutex.RLock()
+	defer fake.getStringMapOrDefaultMutex.RUnlock()
+	argsForCall := fake.getStringMapOrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromise) GetStringMapOrDefaultReturns(result1 map[string]string, result2 error) {
+	fake.getStringMapOrDefaultMutex.Lock()
+	defer fake.getStringMapOrDefaultMutex.Unlock()
+	fake.GetStringMapOrDefaultStub = nil
+	fake.getStringMapOrDefaultReturns = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetStringMapOrDefaultReturnsOnCall(i int, result1 map[string]string, result2 error) {
+	fake.getStringMapOrDefaultMutex.Lock()
+	defer fake.getStringMapOrDefaultMutex.Unlock()
+	fake.GetStringMapOrDefaultStub = nil
+	if fake.getStringMapOrDefaultReturnsOnCall == nil {
+		fake.getStringMapOrDefaultReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+			result2 error
+		})
+	}
+	fake.getStringMapOrDefaultReturnsOnCall[i] = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetStringOrDefault(arg1 string, arg2 string) (string, error) {
+	fake.getStringOrDefaultMutex.Lock()
+	ret, specificReturn := fake.getStringOrDefaultReturnsOnCall[len(fake.getStringOrDefaultArgsForCall)]
+	fake.getStringOrDefaultArgsForCall = append(fake.getStringOrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetStringOrDefaultStub
+	fakeReturns := fake.getStringOrDefaultReturns
+	fake.recordInvocation("GetStringOrDefault", []interface{}{arg1, arg2})
+	fake.getStringOrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetStringOrDefaultCallCount() int {
+	fake.getStringOrDefaultMutex.RLock()
+	defer fake.getStringOrDefaultMutex.RUnlock()
+	return len(fake.getStringOrDefaultArgsForCall)
+}
+
+func (fake *FakePromise) GetStringOrDefaultCalls(stub func(string, string) (string, error)) {
+	fake.getStringOrDefaultMutex.Lock()
+	defer fake.getStringOrDefaultMutex.Unlock()
+	fake.GetStringOrDefaultStub = stub
+}
+
+func (fake *FakePromise) GetStringOrDefaultArgsForCall(i int) (string, string) {
+	fake.getStringOrDefaultMutex.RLock()
+	defer fake.getStringOrDefaultMutex.RUnlock()
+	argsForCall := fake.getStringOrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromise) GetStringOrDefaultReturns(result1 string, result2 error) {
+	fake.getStringOrDefaultMutex.Lock()
+	defer fake.getStringOrDefaultMutex.Unlock()
+	fake.GetStringOrDefaultStub = nil
+	fake.getStringOrDefaultReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetStringOrDefaultReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getStringOrDefaultMutex.Lock()
+	defer fake.getStringOrDefaultMutex.Unlock()
+	fake.GetStringOrDefaultStub = nil
+	if fake.getStringOrDefaultReturnsOnCall == nil {
+		fake.getStringOrDefaultReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getStringOrDefaultReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetStringSlice(arg1 string) ([]string, error) {
+	fake.getStringSliceMutex.Lock()
+	ret, specificReturn := fake.getStringSliceReturnsOnCall[len(fake.getStringSliceArgsForCall)]
+	fake.getStringSliceArgsForCall = append(fake.getStringSliceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetStringSliceStub
+	fakeReturns := fake.getStringSliceReturns
+	fake.recordInvocation("GetStringSlice", []interface{}{arg1})
+	fake.getStringSliceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetStringSliceCallCount() int {
+	fake.getStringSliceMutex.RLock()
+	defer fake.getStringSliceMutex.RUnlock()
+	return len(fake.getStringSliceArgsForCall)
+}
+
+func (fake *FakePromise) GetStringSliceCalls(stub func(string) ([]string, error)) {
+	fake.getStringSliceMutex.Lock()
+	defer fake.getStringSliceMutex.Unlock()
+	fake.GetStringSliceStub = stub
+}
+
+func (fake *FakePromise) GetStringSliceArgsForCall(i int) string {
+	fake.getStringSliceMutex.RLock()
+	defer fake.getStringSliceMutex.RUnlock()
+	argsForCall := fake.getStringSliceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePromise) GetStringSliceReturns(result1 []string, result2 error) {
+	fake.getStringSliceMutex.Lock()
+	defer fake.getStringSliceMutex.Unlock()
+	fake.GetStringSliceStub = nil
+	fake.getStringSliceReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetStringSliceReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.getStringSliceMutex.Lock()
+	defer fake.getStringSliceMutex.Unlock()
+	fake.GetStringSliceStub = nil
+	if fake.getStringSliceReturnsOnCall == nil {
+		fake.getStringSliceReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.getStringSliceReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetStringSliceOrDefault(arg1 string, arg2 []string) ([]string, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.getStringSliceOrDefaultMutex.Lock()
+	ret, specificReturn := fake.getStringSliceOrDefaultReturnsOnCall[len(fake.getStringSliceOrDefaultArgsForCall)]
+	fake.getStringSliceOrDefaultArgsForCall = append(fake.getStringSliceOrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.GetStringSliceOrDefaultStub
+	fakeReturns := fake.getStringSliceOrDefaultReturns
+	fake.recordInvocation("GetStringSliceOrDefault", []interface{}{arg1, arg2Copy})
+	fake.getStringSliceOrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetStringSliceOrDefaultCallCount() int {
+	fake.getStringSliceOrDefaultMutex.RLock()
+	defer fake.getStringSliceOrDefaultMutex.RUnlock()
+	return len(fake.getStringSliceOrDefaultArgsForCall)
+}
+
+func (fake *FakePromise) GetStringSliceOrDefaultCalls(stub func(string, []string) ([]string, error)) {
+	fake.getStringSliceOrDefaultMutex.Lock()
+	defer fake.getStringSliceOrDefaultMutex.Unlock()
+	fake.GetStringSliceOrDefaultStub = stub
+}
+
+func (fake *FakePromise) GetStringSliceOrDefaultArgsForCall(i int) (string, []string) {
+	fake.getStringSliceOrDefaultMutex.RLock()
+	defer fake.getStringSliceOrDefaultMutex.RUnlock()
+	argsForCall := fake.getStringSliceOrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromise) GetStringSliceOrDefaultReturns(result1 []string, result2 error) {
+	fake.getStringSliceOrDefaultMutex.Lock()
+	defer fake.getStringSliceOrDefaultMutex.Unlock()
+	fake.GetStringSliceOrDefaultStub = nil
+	fake.getStringSliceOrDefaultReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetStringSliceOrDefaultReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.getStringSliceOrDefaultMutex.Lock()
+	defer fake.getStringSliceOrDefaultMutex.Unlock()
+	fake.GetStringSliceOrDefaultStub = nil
+	if fake.getStringSliceOrDefaultReturnsOnCall == nil {
+		fake.getStringSliceOrDefaultReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.getStringSliceOrDefaultReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetUID() types.UID {
+	fake.getUIDMutex.Lock()
+	ret, specificReturn := fake.getUIDReturnsOnCall[len(fake.getUIDArgsForCall)]
+	fake.getUIDArgsForCall = append(fake.getUIDArgsForCall, struct {
+	}{})
+	stub := fake.GetUIDStub
+	fakeReturns := fake.getUIDReturns
+	fake.recordInvocation("GetUID", []interface{}{})
+	fake.getUIDMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) GetUIDCallCount() int {
+	fake.getUIDMutex.RLock()
+	defer fake.getUIDMutex.RUnlock()
+	return len(fake.getUIDArgsForCall)
+}
+
+func (fake *FakePromise) GetUIDCalls(stub func() types.UID) {
+	fake.getUIDMutex.Lock()
+	defer fake.getUIDMutex.Unlock()
+	fake.GetUIDStub = stub
+}
+
+func (fake *FakePromise) GetUIDReturns(result1 types.UID) {
+	fake.getUIDMutex.Lock()
+	defer fake.getUIDMutex.Unlock()
+	fake.GetUIDStub = nil
+	fake.getUIDReturns = struct {
+		result1 types.UID
+	}{result1}
+}
+
+func (fake *FakePromise) GetUIDReturnsOnCall(i int, result1 types.UID) {
+	fake.getUIDMutex.Lock()
+	defer fake.getUIDMutex.Unlock()
+	fake.GetUIDStub = nil
+	if fake.getUIDReturnsOnCall == nil {
+		fake.getUIDReturnsOnCall = make(map[int]struct {
+			result1 types.UID
+		})
+	}
+	fake.getUIDReturnsOnCall[i] = struct {
+		result1 types.UID
+	}{result1}
+}
+
+func (fake *FakePromise) GetValue(arg1 string) (any, error) {
+	fake.getValueMutex.Lock()
+	ret, specificReturn := fake.getValueReturnsOnCall[len(fake.getValueArgsForCall)]
+	fake.getValueArgsForCall = append(fake.getValueArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetValueStub
+	fakeReturns := fake.getValueReturns
+	fake.recordInvocation("GetValue", []interface{}{arg1})
+	fake.getValueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetValueCallCount() int {
+	fake.getValueMutex.RLock()
+	defer fake.getValueMutex.RUnlock()
+	return len(fake.getValueArgsForCall)
+}
+
+func (fake *FakePromise) GetValueCalls(stub func(string) (any, error)) {
+	fake.getValueMutex.Lock()
+	defer fake.getValueMutex.Unlock()
+	fake.GetValueStub = stub
+}
+
+func (fake *FakePromise) GetValueArgsForCall(i int) string {
+	fake.getValueMutex.RLock()
+	defer fake.getValueMutex.RUnlock()
+	argsForCall := fake.getValueArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePromise) GetValueReturns(result1 any, result2 error) {
+	fake.getValueMutex.Lock()
+	defer fake.getValueMutex.Unlock()
+	fake.GetValueStub = nil
+	fake.getValueReturns = struct {
+		result1 any
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetValueReturnsOnCall(i int, result1 any, result2 error) {
+	fake.getValueMutex.Lock()
+	defer fake.getValueMutex.Unlock()
+	fake.GetValueStub = nil
+	if fake.getValueReturnsOnCall == nil {
+		fake.getValueReturnsOnCall = make(map[int]struct {
+			result1 any
+			result2 error
+		})
+	}
+	fake.getValueReturnsOnCall[i] = struct {
+		result1 any
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetValues(arg1 string) ([]any, error) {
+	fake.getValuesMutex.Lock()
+	ret, specificReturn := fake.getValuesReturnsOnCall[len(fake.getValuesArgsForCall)]
+	fake.getValuesArgsForCall = append(fake.getValuesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetValuesStub
+	fakeReturns := fake.getValuesReturns
+	fake.recordInvocation("GetValues", []interface{}{arg1})
+	fake.getValuesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePromise) GetValuesCallCount() int {
+	fake.getValuesMutex.RLock()
+	defer fake.getValuesMutex.RUnlock()
+	return len(fake.getValuesArgsForCall)
+}
+
+func (fake *FakePromise) GetValuesCalls(stub func(string) ([]any, error)) {
+	fake.getValuesMutex.Lock()
+	defer fake.getValuesMutex.Unlock()
+	fake.GetValuesStub = stub
+}
+
+func (fake *FakePromise) GetValuesArgsForCall(i int) string {
+	fake.getValuesMutex.RLock()
+	defer fake.getValuesMutex.RUnlock()
+	argsForCall := fake.getValuesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePromise) GetValuesReturns(result1 []any, result2 error) {
+	fake.getValuesMutex.Lock()
+	defer fake.getValuesMutex.Unlock()
+	fake.GetValuesStub = nil
+	fake.getValuesReturns = struct {
+		result1 []any
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) GetValuesReturnsOnCall(i int, result1 []any, result2 error) {
+	fake.getValuesMutex.Lock()
+	defer fake.getValuesMutex.Unlock()
+	fake.GetValuesStub = nil
+	if fake.getValuesReturnsOnCall == nil {
+		fake.getValuesReturnsOnCall = make(map[int]struct {
+			result1 []any
+			result2 error
+		})
+	}
+	fake.getValuesReturnsOnCall[i] = struct {
+		result1 []any
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePromise) SetAnnotation(arg1 string, arg2 string) {
+	fake.setAnnotationMutex.Lock()
+	fake.setAnnotationArgsForCall = append(fake.setAnnotationArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SetAnnotationStub
+	fake.recordInvocation("SetAnnotation", []interface{}{arg1, arg2})
+	fake.setAnnotationMutex.Unlock()
+	if stub != nil {
+		fake.SetAnnotationStub(arg1, arg2)
+	}
+}
+
+func (fake *FakePromise) SetAnnotationCallCount() int {
+	fake.setAnnotationMutex.RLock()
+	defer fake.setAnnotationMutex.RUnlock()
+	return len(fake.setAnnotationArgsForCall)
+}
+
+func (fake *FakePromise) SetAnnotationCalls(stub func(string, string)) {
+	fake.setAnnotationMutex.Lock()
+	defer fake.setAnnotationMutex.Unlock()
+	fake.SetAnnotationStub = stub
+}
+
+func (fake *FakePromise) SetAnnotationArgsForCall(i int) (string, string) {
+	fake.setAnnotationMutex.RLock()
+	defer fake.setAnnotationMutex.RUnlock()
+	argsForCall := fake.setAnnotationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromise) SetLabel(arg1 string, arg2 string) {
+	fake.setLabelMutex.Lock()
+	fake.setLabelArgsForCall = append(fake.setLabelArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SetLabelStub
+	fake.recordInvocation("SetLabel", []interface{}{arg1, arg2})
+	fake.setLabelMutex.Unlock()
+	if stub != nil {
+		fake.SetLabelStub(arg1, arg2)
+	}
+}
+
+func (fake *FakePromise) SetLabelCallCount() int {
+	fake.setLabelMutex.RLock()
+	defer fake.setLabelMutex.RUnlock()
+	return len(fake.setLabelArgsForCall)
+}
+
+func (fake *FakePromise) SetLabelCalls(stub func(string, string)) {
+	fake.setLabelMutex.Lock()
+	defer fake.setLabelMutex.Unlock()
+	fake.SetLabelStub = stub
+}
+
+func (fake *FakePromise) SetLabelArgsForCall(i int) (string, string) {
+	fake.setLabelMutex.RLock()
+	defer fake.setLabelMutex.RUnlock()
+	argsForCall := fake.setLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromise) SetValue(arg1 string, arg2 any) error {
+	fake.setValueMutex.Lock()
+	ret, specificReturn := fake.setValueReturnsOnCall[len(fake.setValueArgsForCall)]
+	fake.setValueArgsForCall = append(fake.setValueArgsForCall, struct {
+		arg1 string
+		arg2 any
+	}{arg1, arg2})
+	stub := fake.SetValueStub
+	fakeReturns := fake.setValueReturns
+	fake.recordInvocation("SetValue", []interface{}{arg1, arg2})
+	fake.setValueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) SetValueCallCount() int {
+	fake.setValueMutex.RLock()
+	defer fake.setValueMutex.RUnlock()
+	return len(fake.setValueArgsForCall)
+}
+
+func (fake *FakePromise) SetValueCalls(stub func(string, any) error) {
+	fake.setValueMutex.Lock()
+	defer fake.setValueMutex.Unlock()
+	fake.SetValueStub = stub
+}
+
+func (fake *FakePromise) SetValueArgsForCall(i int) (string, any) {
+	fake.setValueMutex.RLock()
+	defer fake.setValueMutex.RUnlock()
+	argsForCall := fake.setValueArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePromise) SetValueReturns(result1 error) {
+	fake.setValueMutex.Lock()
+	defer fake.setValueMutex.Unlock()
+	fake.SetValueStub = nil
+	fake.setValueReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePromise) SetValueReturnsOnCall(i int, result1 error) {
+	fake.setValueMutex.Lock()
+	defer fake.setValueMutex.Unlock()
+	fake.SetValueStub = nil
+	if fake.setValueReturnsOnCall == nil {
+		fake.setValueReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setValueReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePromise) ToOwnerReference() v1a.OwnerReference {
+	fake.toOwnerReferenceMutex.Lock()
+	ret, specificReturn := fake.toOwnerReferenceReturnsOnCall[len(fake.toOwnerReferenceArgsForCall)]
+	fake.toOwnerReferenceArgsForCall = append(fake.toOwnerReferenceArgsForCall, struct {
+	}{})
+	stub := fake.ToOwnerReferenceStub
+	fakeReturns := fake.toOwnerReferenceReturns
+	fake.recordInvocation("ToOwnerReference", []interface{}{})
+	fake.toOwnerReferenceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) ToOwnerReferenceCallCount() int {
+	fake.toOwnerReferenceMutex.RLock()
+	defer fake.toOwnerReferenceMutex.RUnlock()
+	return len(fake.toOwnerReferenceArgsForCall)
+}
+
+func (fake *FakePromise) ToOwnerReferenceCalls(stub func() v1a.OwnerReference) {
+	fake.toOwnerReferenceMutex.Lock()
+	defer fake.toOwnerReferenceMutex.Unlock()
+	fake.ToOwnerReferenceStub = stub
+}
+
+func (fake *FakePromise) ToOwnerReferenceReturns(result1 v1a.OwnerReference) {
+	fake.toOwnerReferenceMutex.Lock()
+	defer fake.toOwnerReferenceMutex.Unlock()
+	fake.ToOwnerReferenceStub = nil
+	fake.toOwnerReferenceReturns = struct {
+		result1 v1a.OwnerReference
+	}{result1}
+}
+
+func (fake *FakePromise) ToOwnerReferenceReturnsOnCall(i int, result1 v1a.OwnerReference) {
+	fake.toOwnerReferenceMutex.Lock()
+	defer fake.toOwnerReferenceMutex.Unlock()
+	fake.ToOwnerReferenceStub = nil
+	if fake.toOwnerReferenceReturnsOnCall == nil {
+		fake.toOwnerReferenceReturnsOnCall = make(map[int]struct {
+			result1 v1a.OwnerReference
+		})
+	}
+	fake.toOwnerReferenceReturnsOnCall[i] = struct {
+		result1 v1a.OwnerReference
+	}{result1}
+}
+
+func (fake *FakePromise) ToUnstructured() unstructured.Unstructured {
+	fake.toUnstructuredMutex.Lock()
+	ret, specificReturn := fake.toUnstructuredReturnsOnCall[len(fake.toUnstructuredArgsForCall)]
+	fake.toUnstructuredArgsForCall = append(fake.toUnstructuredArgsForCall, struct {
+	}{})
+	stub := fake.ToUnstructuredStub
+	fakeReturns := fake.toUnstructuredReturns
+	fake.recordInvocation("ToUnstructured", []interface{}{})
+	fake.toUnstructuredMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePromise) ToUnstructuredCallCount() int {
+	fake.toUnstructuredMutex.RLock()
+	defer fake.toUnstructuredMutex.RUnlock()
+	return len(fake.toUnstructuredArgsForCall)
+}
+
+func (fake *FakePromise) ToUnstructuredCalls(stub func() unstructured.Unstructured) {
+	fake.toUnstructuredMutex.Lock()
+	defer fake.toUnstructuredMutex.Unlock()
+	fake.ToUnstructuredStub = stub
+}
+
+func (fake *FakePromise) ToUnstructuredReturns(result1 unstructured.Unstructured) {
+	fake.toUnstructuredMutex.Lock()
+	defer fake.toUnstructuredMutex.Unlock()
+	fake.ToUnstructuredStub = nil
+	fake.toUnstructuredReturns = struct {
+		result1 unstructured.Unstructured
+	}{result1}
+}
+
+func (fake *FakePromise) ToUnstructuredReturnsOnCall(i int, result1 unstructured.Unstructured) {
+	fake.toUnstructuredMutex.Lock()
+	defer fake.toUnstructuredMutex.Unlock()
+	fake.ToUnstructuredStub = nil
+	if fake.toUnstructuredReturnsOnCall == nil {
+		fake.toUnstructuredReturnsOnCall = make(map[int]struct {
+			result1 unstructured.Unstructured
+		})
+	}
+	fake.toUnstructuredReturnsOnCall[i] = struct {
+		result1 unstructured.Unstructured
+	}{result1}
+}
+
+func (fake *FakePromise) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePromise) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ kratix.Promise = new(FakePromise)
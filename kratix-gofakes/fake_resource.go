@@ -0,0 +1,2683 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package kratixgofakes
+
+import (
+	"sync"
+	"time"
+
+	kratix "github.com/syntasso/kratix-go"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type FakeResource struct {
+	DiffStub        func() ([]byte, error)
+	diffMutex       sync.RWMutex
+	diffArgsForCall []struct {
+	}
+	diffReturns struct {
+		result1 []byte
+		result2 error
+	}
+	diffReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	GetAnnotationsStub        func() map[string]string
+	getAnnotationsMutex       sync.RWMutex
+	getAnnotationsArgsForCall []struct {
+	}
+	getAnnotationsReturns struct {
+		result1 map[string]string
+	}
+	getAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
+	GetBoolStub        func(string) (bool, error)
+	getBoolMutex       sync.RWMutex
+	getBoolArgsForCall []struct {
+		arg1 string
+	}
+	getBoolReturns struct {
+		result1 bool
+		result2 error
+	}
+	getBoolReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	GetBoolOrDefaultStub        func(string, bool) (bool, error)
+	getBoolOrDefaultMutex       sync.RWMutex
+	getBoolOrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 bool
+	}
+	getBoolOrDefaultReturns struct {
+		result1 bool
+		result2 error
+	}
+	getBoolOrDefaultReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	GetCreationTimestampStub        func() v1.Time
+	getCreationTimestampMutex       sync.RWMutex
+	getCreationTimestampArgsForCall []struct {
+	}
+	getCreationTimestampReturns struct {
+		result1 v1.Time
+	}
+	getCreationTimestampReturnsOnCall map[int]struct {
+		result1 v1.Time
+	}
+	GetDeletionTimestampStub        func() *v1.Time
+	getDeletionTimestampMutex       sync.RWMutex
+	getDeletionTimestampArgsForCall []struct {
+	}
+	getDeletionTimestampReturns struct {
+		result1 *v1.Time
+	}
+	getDeletionTimestampReturnsOnCall map[int]struct {
+		result1 *v1.Time
+	}
+	GetDurationStub        func(string) (time.Duration, error)
+	getDurationMutex       sync.RWMutex
+	getDurationArgsForCall []struct {
+		arg1 string
+	}
+	getDurationReturns struct {
+		result1 time.Duration
+		result2 error
+	}
+	getDurationReturnsOnCall map[int]struct {
+		result1 time.Duration
+		result2 error
+	}
+	GetDurationOrDefaultStub        func(string, time.Duration) (time.Duration, error)
+	getDurationOrDefaultMutex       sync.RWMutex
+	getDurationOrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+	}
+	getDurationOrDefaultReturns struct {
+		result1 time.Duration
+		result2 error
+	}
+	getDurationOrDefaultReturnsOnCall map[int]struct {
+		result1 time.Duration
+		result2 error
+	}
+	GetFinalizersStub        func() []string
+	getFinalizersMutex       sync.RWMutex
+	getFinalizersArgsForCall []struct {
+	}
+	getFinalizersReturns struct {
+		result1 []string
+	}
+	getFinalizersReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	GetFloat64Stub        func(string) (float64, error)
+	getFloat64Mutex       sync.RWMutex
+	getFloat64ArgsForCall []struct {
+		arg1 string
+	}
+	getFloat64Returns struct {
+		result1 float64
+		result2 error
+	}
+	getFloat64ReturnsOnCall map[int]struct {
+		result1 float64
+		result2 error
+	}
+	GetFloat64OrDefaultStub        func(string, float64) (float64, error)
+	getFloat64OrDefaultMutex       sync.RWMutex
+	getFloat64OrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 float64
+	}
+	getFloat64OrDefaultReturns struct {
+		result1 float64
+		result2 error
+	}
+	getFloat64OrDefaultReturnsOnCall map[int]struct {
+		result1 float64
+		result2 error
+	}
+	GetGenerationStub        func() int64
+	getGenerationMutex       sync.RWMutex
+	getGenerationArgsForCall []struct {
+	}
+	getGenerationReturns struct {
+		result1 int64
+	}
+	getGenerationReturnsOnCall map[int]struct {
+		result1 int64
+	}
+	GetGroupVersionKindStub        func() schema.GroupVersionKind
+	getGroupVersionKindMutex       sync.RWMutex
+	getGroupVersionKindArgsForCall []struct {
+	}
+	getGroupVersionKindReturns struct {
+		result1 schema.GroupVersionKind
+	}
+	getGroupVersionKindReturnsOnCall map[int]struct {
+		result1 schema.GroupVersionKind
+	}
+	GetInt64Stub        func(string) (int64, error)
+	getInt64Mutex       sync.RWMutex
+	getInt64ArgsForCall []struct {
+		arg1 string
+	}
+	getInt64Returns struct {
+		result1 int64
+		result2 error
+	}
+	getInt64ReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	GetInt64OrDefaultStub        func(string, int64) (int64, error)
+	getInt64OrDefaultMutex       sync.RWMutex
+	getInt64OrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 int64
+	}
+	getInt64OrDefaultReturns struct {
+		result1 int64
+		result2 error
+	}
+	getInt64OrDefaultReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	GetLabelsStub        func() map[string]string
+	getLabelsMutex       sync.RWMutex
+	getLabelsArgsForCall []struct {
+	}
+	getLabelsReturns struct {
+		result1 map[string]string
+	}
+	getLabelsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
+	GetMetadataStub        func() map[string]any
+	getMetadataMutex       sync.RWMutex
+	getMetadataArgsForCall []struct {
+	}
+	getMetadataReturns struct {
+		result1 map[string]any
+	}
+	getMetadataReturnsOnCall map[int]struct {
+		result1 map[string]any
+	}
+	GetNameStub        func() string
+	getNameMutex       sync.RWMutex
+	getNameArgsForCall []struct {
+	}
+	getNameReturns struct {
+		result1 string
+	}
+	getNameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetNamespaceStub        func() string
+	getNamespaceMutex       sync.RWMutex
+	getNamespaceArgsForCall []struct {
+	}
+	getNamespaceReturns struct {
+		result1 string
+	}
+	getNamespaceReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetOwnerReferencesStub        func() []v1.OwnerReference
+	getOwnerReferencesMutex       sync.RWMutex
+	getOwnerReferencesArgsForCall []struct {
+	}
+	getOwnerReferencesReturns struct {
+		result1 []v1.OwnerReference
+	}
+	getOwnerReferencesReturnsOnCall map[int]struct {
+		result1 []v1.OwnerReference
+	}
+	GetQuantityStub        func(string) (resource.Quantity, error)
+	getQuantityMutex       sync.RWMutex
+	getQuantityArgsForCall []struct {
+		arg1 string
+	}
+	getQuantityReturns struct {
+		result1 resource.Quantity
+		result2 error
+	}
+	getQuantityReturnsOnCall map[int]struct {
+		result1 resource.Quantity
+		result2 error
+	}
+	GetQuantityOrDefaultStub        func(string, resource.Quantity) (resource.Quantity, error)
+	getQuantityOrDefaultMutex       sync.RWMutex
+	getQuantityOrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 resource.Quantity
+	}
+	getQuantityOrDefaultReturns struct {
+		result1 resource.Quantity
+		result2 error
+	}
+	getQuantityOrDefaultReturnsOnCall map[int]struct {
+		result1 resource.Quantity
+		result2 error
+	}
+	GetResourceVersionStub        func() string
+	getResourceVersionMutex       sync.RWMutex
+	getResourceVersionArgsForCall []struct {
+	}
+	getResourceVersionReturns struct {
+		result1 string
+	}
+	getResourceVersionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	GetStatusStub        func() (kratix.Status, error)
+	getStatusMutex       sync.RWMutex
+	getStatusArgsForCall []struct {
+	}
+	getStatusReturns struct {
+		result1 kratix.Status
+		result2 error
+	}
+	getStatusReturnsOnCall map[int]struct {
+		result1 kratix.Status
+		result2 error
+	}
+	GetStringStub        func(string) (string, error)
+	getStringMutex       sync.RWMutex
+	getStringArgsForCall []struct {
+		arg1 string
+	}
+	getStringReturns struct {
+		result1 string
+		result2 error
+	}
+	getStringReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetStringMapStub        func(string) (map[string]string, error)
+	getStringMapMutex       sync.RWMutex
+	getStringMapArgsForCall []struct {
+		arg1 string
+	}
+	getStringMapReturns struct {
+		result1 map[string]string
+		result2 error
+	}
+	getStringMapReturnsOnCall map[int]struct {
+		result1 map[string]string
+		result2 error
+	}
+	GetStringMapOrDefaultStub        func(string, map[string]string) (map[string]string, error)
+	getStringMapOrDefaultMutex       sync.RWMutex
+	getStringMapOrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 map[string]string
+	}
+	getStringMapOrDefaultReturns struct {
+		result1 map[string]string
+		result2 error
+	}
+	getStringMapOrDefaultReturnsOnCall map[int]struct {
+		result1 map[string]string
+		result2 error
+	}
+	GetStringOrDefaultStub        func(string, string) (string, error)
+	getStringOrDefaultMutex       sync.RWMutex
+	getStringOrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getStringOrDefaultReturns struct {
+		result1 string
+		result2 error
+	}
+	getStringOrDefaultReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetStringSliceStub        func(string) ([]string, error)
+	getStringSliceMutex       sync.RWMutex
+	getStringSliceArgsForCall []struct {
+		arg1 string
+	}
+	getStringSliceReturns struct {
+		result1 []string
+		result2 error
+	}
+	getStringSliceReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	GetStringSliceOrDefaultStub        func(string, []string) ([]string, error)
+	getStringSliceOrDefaultMutex       sync.RWMutex
+	getStringSliceOrDefaultArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	getStringSliceOrDefaultReturns struct {
+		result1 []string
+		result2 error
+	}
+	getStringSliceOrDefaultReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	GetUIDStub        func() types.UID
+	getUIDMutex       sync.RWMutex
+	getUIDArgsForCall []struct {
+	}
+	getUIDReturns struct {
+		result1 types.UID
+	}
+	getUIDReturnsOnCall map[int]struct {
+		result1 types.UID
+	}
+	GetValueStub        func(string) (any, error)
+	getValueMutex       sync.RWMutex
+	getValueArgsForCall []struct {
+		arg1 string
+	}
+	getValueReturns struct {
+		result1 any
+		result2 error
+	}
+	getValueReturnsOnCall map[int]struct {
+		result1 any
+		result2 error
+	}
+	GetValuesStub        func(string) ([]any, error)
+	getValuesMutex       sync.RWMutex
+	getValuesArgsForCall []struct {
+		arg1 string
+	}
+	getValuesReturns struct {
+		result1 []any
+		result2 error
+	}
+	getValuesReturnsOnCall map[int]struct {
+		result1 []any
+		result2 error
+	}
+	SetAnnotationStub        func(string, string)
+	setAnnotationMutex       sync.RWMutex
+	setAnnotationArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	SetLabelStub        func(string, string)
+	setLabelMutex       sync.RWMutex
+	setLabelArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	SetValueStub        func(string, any) error
+	setValueMutex       sync.RWMutex
+	setValueArgsForCall []struct {
+		arg1 string
+		arg2 any
+	}
+	setValueReturns struct {
+		result1 error
+	}
+	setValueReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ToOwnerReferenceStub        func() v1.OwnerReference
+	toOwnerReferenceMutex       sync.RWMutex
+	toOwnerReferenceArgsForCall []struct {
+	}
+	toOwnerReferenceReturns struct {
+		result1 v1.OwnerReference
+	}
+	toOwnerReferenceReturnsOnCall map[int]struct {
+		result1 v1.OwnerReference
+	}
+	ToUnstructuredStub        func() unstructured.Unstructured
+	toUnstructuredMutex       sync.RWMutex
+	toUnstructuredArgsForCall []struct {
+	}
+	toUnstructuredReturns struct {
+		result1 unstructured.Unstructured
+	}
+	toUnstructuredReturnsOnCall map[int]struct {
+		result1 unstructured.Unstructured
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeResource) Diff() ([]byte, error) {
+	fake.diffMutex.Lock()
+	ret, specificReturn := fake.diffReturnsOnCall[len(fake.diffArgsForCall)]
+	fake.diffArgsForCall = append(fake.diffArgsForCall, struct {
+	}{})
+	stub := fake.DiffStub
+	fakeReturns := fake.diffReturns
+	fake.recordInvocation("Diff", []interface{}{})
+	fake.diffMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) DiffCallCount() int {
+	fake.diffMutex.RLock()
+	defer fake.diffMutex.RUnlock()
+	return len(fake.diffArgsForCall)
+}
+
+func (fake *FakeResource) DiffCalls(stub func() ([]byte, error)) {
+	fake.diffMutex.Lock()
+	defer fake.diffMutex.Unlock()
+	fake.DiffStub = stub
+}
+
+func (fake *FakeResource) DiffReturns(result1 []byte, result2 error) {
+	fake.diffMutex.Lock()
+	defer fake.diffMutex.Unlock()
+	fake.DiffStub = nil
+	fake.diffReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) DiffReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.diffMutex.Lock()
+	defer fake.diffMutex.Unlock()
+	fake.DiffStub = nil
+	if fake.diffReturnsOnCall == nil {
+		fake.diffReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.diffReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetAnnotations() map[string]string {
+	fake.getAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getAnnotationsReturnsOnCall[len(fake.getAnnotationsArgsForCall)]
+	fake.getAnnotationsArgsForCall = append(fake.getAnnotationsArgsForCall, struct {
+	}{})
+	stub := fake.GetAnnotationsStub
+	fakeReturns := fake.getAnnotationsReturns
+	fake.recordInvocation("GetAnnotations", []interface{}{})
+	fake.getAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) GetAnnotationsCallCount() int {
+	fake.getAnnotationsMutex.RLock()
+	defer fake.getAnnotationsMutex.RUnlock()
+	return len(fake.getAnnotationsArgsForCall)
+}
+
+func (fake *FakeResource) GetAnnotationsCalls(stub func() map[string]string) {
+	fake.getAnnotationsMutex.Lock()
+	defer fake.getAnnotationsMutex.Unlock()
+	fake.GetAnnotationsStub = stub
+}
+
+func (fake *FakeResource) GetAnnotationsReturns(result1 map[string]string) {
+	fake.getAnnotationsMutex.Lock()
+	defer fake.getAnnotationsMutex.Unlock()
+	fake.GetAnnotationsStub = nil
+	fake.getAnnotationsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeResource) GetAnnotationsReturnsOnCall(i int, result1 map[string]string) {
+	fake.getAnnotationsMutex.Lock()
+	defer fake.getAnnotationsMutex.Unlock()
+	fake.GetAnnotationsStub = nil
+	if fake.getAnnotationsReturnsOnCall == nil {
+		fake.getAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.getAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeResource) GetBool(arg1 string) (bool, error) {
+	fake.getBoolMutex.Lock()
+	ret, specificReturn := fake.getBoolReturnsOnCall[len(fake.getBoolArgsForCall)]
+	fake.getBoolArgsForCall = append(fake.getBoolArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetBoolStub
+	fakeReturns := fake.getBoolReturns
+	fake.recordInvocation("GetBool", []interface{}{arg1})
+	fake.getBoolMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetBoolCallCount() int {
+	fake.getBoolMutex.RLock()
+	defer fake.getBoolMutex.RUnlock()
+	return len(fake.getBoolArgsForCall)
+}
+
+func (fake *FakeResource) GetBoolCalls(stub func(string) (bool, error)) {
+	fake.getBoolMutex.Lock()
+	defer fake.getBoolMutex.Unlock()
+	fake.GetBoolStub = stub
+}
+
+func (fake *FakeResource) GetBoolArgsForCall(i int) string {
+	fake.getBoolMutex.RLock()
+	defer fake.getBoolMutex.RUnlock()
+	argsForCall := fake.getBoolArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) GetBoolReturns(result1 bool, result2 error) {
+	fake.getBoolMutex.Lock()
+	defer fake.getBoolMutex.Unlock()
+	fake.GetBoolStub = nil
+	fake.getBoolReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetBoolReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.getBoolMutex.Lock()
+	defer fake.getBoolMutex.Unlock()
+	fake.GetBoolStub = nil
+	if fake.getBoolReturnsOnCall == nil {
+		fake.getBoolReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.getBoolReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetBoolOrDefault(arg1 string, arg2 bool) (bool, error) {
+	fake.getBoolOrDefaultMutex.Lock()
+	ret, specificReturn := fake.getBoolOrDefaultReturnsOnCall[len(fake.getBoolOrDefaultArgsForCall)]
+	fake.getBoolOrDefaultArgsForCall = append(fake.getBoolOrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 bool
+	}{arg1, arg2})
+	stub := fake.GetBoolOrDefaultStub
+	fakeReturns := fake.getBoolOrDefaultReturns
+	fake.recordInvocation("GetBoolOrDefault", []interface{}{arg1, arg2})
+	fake.getBoolOrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetBoolOrDefaultCallCount() int {
+	fake.getBoolOrDefaultMutex.RLock()
+	defer fake.getBoolOrDefaultMutex.RUnlock()
+	return len(fake.getBoolOrDefaultArgsForCall)
+}
+
+func (fake *FakeResource) GetBoolOrDefaultCalls(stub func(string, bool) (bool, error)) {
+	fake.getBoolOrDefaultMutex.Lock()
+	defer fake.getBoolOrDefaultMutex.Unlock()
+	fake.GetBoolOrDefaultStub = stub
+}
+
+func (fake *FakeResource) GetBoolOrDefaultArgsForCall(i int) (string, bool) {
+	fake.getBoolOrDefaultMutex.RLock()
+	defer fake.getBoolOrDefaultMutex.RUnlock()
+	argsForCall := fake.getBoolOrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) GetBoolOrDefaultReturns(result1 bool, result2 error) {
+	fake.getBoolOrDefaultMutex.Lock()
+	defer fake.getBoolOrDefaultMutex.Unlock()
+	fake.GetBoolOrDefaultStub = nil
+	fake.getBoolOrDefaultReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetBoolOrDefaultReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.getBoolOrDefaultMutex.Lock()
+	defer fake.getBoolOrDefaultMutex.Unlock()
+	fake.GetBoolOrDefaultStub = nil
+	if fake.getBoolOrDefaultReturnsOnCall == nil {
+		fake.getBoolOrDefaultReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.getBoolOrDefaultReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetCreationTimestamp() v1.Time {
+	fake.getCreationTimestampMutex.Lock()
+	ret, specificReturn := fake.getCreationTimestampReturnsOnCall[len(fake.getCreationTimestampArgsForCall)]
+	fake.getCreationTimestampArgsForCall = append(fake.getCreationTimestampArgsForCall, struct {
+	}{})
+	stub := fake.GetCreationTimestampStub
+	fakeReturns := fake.getCreationTimestampReturns
+	fake.recordInvocation("GetCreationTimestamp", []interface{}{})
+	fake.getCreationTimestampMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) GetCreationTimestampCallCount() int {
+	fake.getCreationTimestampMutex.RLock()
+	defer fake.getCreationTimestampMutex.RUnlock()
+	return len(fake.getCreationTimestampArgsForCall)
+}
+
+func (fake *FakeResource) GetCreationTimestampCalls(stub func() v1.Time) {
+	fake.getCreationTimestampMutex.Lock()
+	defer fake.getCreationTimestampMutex.Unlock()
+	fake.GetCreationTimestampStub = stub
+}
+
+func (fake *FakeResource) GetCreationTimestampReturns(result1 v1.Time) {
+	fake.getCreationTimestampMutex.Lock()
+	defer fake.getCreationTimestampMutex.Unlock()
+	fake.GetCreationTimestampStub = nil
+	fake.getCreationTimestampReturns = struct {
+		result1 v1.Time
+	}{result1}
+}
+
+func (fake *FakeResource) GetCreationTimestampReturnsOnCall(i int, result1 v1.Time) {
+	fake.getCreationTimestampMutex.Lock()
+	defer fake.getCreationTimestampMutex.Unlock()
+	fake.GetCreationTimestampStub = nil
+	if fake.getCreationTimestampReturnsOnCall == nil {
+		fake.getCreationTimestampReturnsOnCall = make(map[int]struct {
+			result1 v1.Time
+		})
+	}
+	fake.getCreationTimestampReturnsOnCall[i] = struct {
+		result1 v1.Time
+	}{result1}
+}
+
+func (fake *FakeResource) GetDeletionTimestamp() *v1.Time {
+	fake.getDeletionTimestampMutex.Lock()
+	ret, specificReturn := fake.getDeletionTimestampReturnsOnCall[len(fake.getDeletionTimestampArgsForCall)]
+	fake.getDeletionTimestampArgsForCall = append(fake.getDeletionTimestampArgsForCall, struct {
+	}{})
+	stub := fake.GetDeletionTimestampStub
+	fakeReturns := fake.getDeletionTimestampReturns
+	fake.recordInvocation("GetDeletionTimestamp", []interface{}{})
+	fake.getDeletionTimestampMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) GetDeletionTimestampCallCount() int {
+	fake.getDeletionTimestampMutex.RLock()
+	defer fake.getDeletionTimestampMutex.RUnlock()
+	return len(fake.getDeletionTimestampArgsForCall)
+}
+
+func (fake *FakeResource) GetDeletionTimestampCalls(stub func() *v1.Time) {
+	fake.getDeletionTimestampMutex.Lock()
+	defer fake.getDeletionTimestampMutex.Unlock()
+	fake.GetDeletionTimestampStub = stub
+}
+
+func (fake *FakeResource) GetDeletionTimestampReturns(result1 *v1.Time) {
+	fake.getDeletionTimestampMutex.Lock()
+	defer fake.getDeletionTimestampMutex.Unlock()
+	fake.GetDeletionTimestampStub = nil
+	fake.getDeletionTimestampReturns = struct {
+		result1 *v1.Time
+	}{result1}
+}
+
+func (fake *FakeResource) GetDeletionTimestampReturnsOnCall(i int, result1 *v1.Time) {
+	fake.getDeletionTimestampMutex.Lock()
+	defer fake.getDeletionTimestampMutex.Unlock()
+	fake.GetDeletionTimestampStub = nil
+	if fake.getDeletionTimestampReturnsOnCall == nil {
+		fake.getDeletionTimestampReturnsOnCall = make(map[int]struct {
+			result1 *v1.Time
+		})
+	}
+	fake.getDeletionTimestampReturnsOnCall[i] = struct {
+		result1 *v1.Time
+	}{result1}
+}
+
+func (fake *FakeResource) GetDuration(arg1 string) (time.Duration, error) {
+	fake.getDurationMutex.Lock()
+	ret, specificReturn := fake.getDurationReturnsOnCall[len(fake.getDurationArgsForCall)]
+	fake.getDurationArgsForCall = append(fake.getDurationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetDurationStub
+	fakeReturns := fake.getDurationReturns
+	fake.recordInvocation("GetDuration", []interface{}{arg1})
+	fake.getDurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetDurationCallCount() int {
+	fake.getDurationMutex.RLock()
+	defer fake.getDurationMutex.RUnlock()
+	return len(fake.getDurationArgsForCall)
+}
+
+func (fake *FakeResource) GetDurationCalls(stub func(string) (time.Duration, error)) {
+	fake.getDurationMutex.Lock()
+	defer fake.getDurationMutex.Unlock()
+	fake.GetDurationStub = stub
+}
+
+func (fake *FakeResource) GetDurationArgsForCall(i int) string {
+	fake.getDurationMutex.RLock()
+	defer fake.getDurationMutex.RUnlock()
+	argsForCall := fake.getDurationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) GetDurationReturns(result1 time.Duration, result2 error) {
+	fake.getDurationMutex.Lock()
+	defer fake.getDurationMutex.Unlock()
+	fake.GetDurationStub = nil
+	fake.getDurationReturns = struct {
+		result1 time.Duration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetDurationReturnsOnCall(i int, result1 time.Duration, result2 error) {
+	fake.getDurationMutex.Lock()
+	defer fake.getDurationMutex.Unlock()
+	fake.GetDurationStub = nil
+	if fake.getDurationReturnsOnCall == nil {
+		fake.getDurationReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+			result2 error
+		})
+	}
+	fake.getDurationReturnsOnCall[i] = struct {
+		result1 time.Duration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetDurationOrDefault(arg1 string, arg2 time.Duration) (time.Duration, error) {
+	fake.getDurationOrDefaultMutex.Lock()
+	ret, specificReturn := fake.getDurationOrDefaultReturnsOnCall[len(fake.getDurationOrDefaultArgsForCall)]
+	fake.getDurationOrDefaultArgsForCall = append(fake.getDurationOrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+	}{arg1, arg2})
+	stub := fake.GetDurationOrDefaultStub
+	fakeReturns := fake.getDurationOrDefaultReturns
+	fake.recordInvocation("GetDurationOrDefault", []interface{}{arg1, arg2})
+	fake.getDurationOrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetDurationOrDefaultCallCount() int {
+	fake.getDurationOrDefaultMutex.RLock()
+	defer fake.getDurationOrDefaultMutex.RUnlock()
+	return len(fake.getDurationOrDefaultArgsForCall)
+}
+
+func (fake *FakeResource) GetDurationOrDefaultCalls(stub func(string, time.Duration) (time.Duration, error)) {
+	fake.getDurationOrDefaultMutex.Lock()
+	defer fake.getDurationOrDefaultMutex.Unlock()
+	fake.GetDurationOrDefaultStub = stub
+}
+
+func (fake *FakeResource) GetDurationOrDefaultArgsForCall(i int) (string, time.Duration) {
+	fake.getDurationOrDefaultMutex.RLock()
+	defer fake.getDurationOrDefaultMutex.RUnlock()
+	argsForCall := fake.getDurationOrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) GetDurationOrDefaultReturns(result1 time.Duration, result2 error) {
+	fake.getDurationOrDefaultMutex.Lock()
+	defer fake.getDurationOrDefaultMutex.Unlock()
+	fake.GetDurationOrDefaultStub = nil
+	fake.getDurationOrDefaultReturns = struct {
+		result1 time.Duration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetDurationOrDefaultReturnsOnCall(i int, result1 time.Duration, result2 error) {
+	fake.getDurationOrDefaultMutex.Lock()
+	defer fake.getDurationOrDefaultMutex.Unlock()
+	fake.GetDurationOrDefaultStub = nil
+	if fake.getDurationOrDefaultReturnsOnCall == nil {
+		fake.getDurationOrDefaultReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+			result2 error
+		})
+	}
+	fake.getDurationOrDefaultReturnsOnCall[i] = struct {
+		result1 time.Duration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetFinalizers() []string {
+	fake.getFinalizersMutex.Lock()
+	ret, specificReturn := fake.getFinalizersReturnsOnCall[len(fake.getFinalizersArgsForCall)]
+	fake.getFinalizersArgsForCall = append(fake.getFinalizersArgsForCall, struct {
+	}{})
+	stub := fake.GetFinalizersStub
+	fakeReturns := fake.getFinalizersReturns
+	fake.recordInvocation("GetFinalizers", []interface{}{})
+	fake.getFinalizersMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) GetFinalizersCallCount() int {
+	fake.getFinalizersMutex.RLock()
+	defer fake.getFinalizersMutex.RUnlock()
+	return len(fake.getFinalizersArgsForCall)
+}
+
+func (fake *FakeResource) GetFinalizersCalls(stub func() []string) {
+	fake.getFinalizersMutex.Lock()
+	defer fake.getFinalizersMutex.Unlock()
+	fake.GetFinalizersStub = stub
+}
+
+func (fake *FakeResource) GetFinalizersReturns(result1 []string) {
+	fake.getFinalizersMutex.Lock()
+	defer fake.getFinalizersMutex.Unlock()
+	fake.GetFinalizersStub = nil
+	fake.getFinalizersReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeResource) GetFinalizersReturnsOnCall(i int, result1 []string) {
+	fake.getFinalizersMutex.Lock()
+	defer fake.getFinalizersMutex.Unlock()
+	fake.GetFinalizersStub = nil
+	if fake.getFinalizersReturnsOnCall == nil {
+		fake.getFinalizersReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.getFinalizersReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeResource) GetFloat64(arg1 string) (float64, error) {
+	fake.getFloat64Mutex.Lock()
+	ret, specificReturn := fake.getFloat64ReturnsOnCall[len(fake.getFloat64ArgsForCall)]
+	fake.getFloat64ArgsForCall = append(fake.getFloat64ArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetFloat64Stub
+	fakeReturns := fake.getFloat64Returns
+	fake.recordInvocation("GetFloat64", []interface{}{arg1})
+	fake.getFloat64Mutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetFloat64CallCount() int {
+	fake.getFloat64Mutex.RLock()
+	defer fake.getFloat64Mutex.RUnlock()
+	return len(fake.getFloat64ArgsForCall)
+}
+
+func (fake *FakeResource) GetFloat64Calls(stub func(string) (float64, error)) {
+	fake.getFloat64Mutex.Lock()
+	defer fake.getFloat64Mutex.Unlock()
+	fake.GetFloat64Stub = stub
+}
+
+func (fake *FakeResource) GetFloat64ArgsForCall(i int) string {
+	fake.getFloat64Mutex.RLock()
+	defer fake.getFloat64Mutex.RUnlock()
+	argsForCall := fake.getFloat64ArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) GetFloat64Returns(result1 float64, result2 error) {
+	fake.getFloat64Mutex.Lock()
+	defer fake.getFloat64Mutex.Unlock()
+	fake.GetFloat64Stub = nil
+	fake.getFloat64Returns = struct {
+		result1 float64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetFloat64ReturnsOnCall(i int, result1 float64, result2 error) {
+	fake.getFloat64Mutex.Lock()
+	defer fake.getFloat64Mutex.Unlock()
+	fake.GetFloat64Stub = nil
+	if fake.getFloat64ReturnsOnCall == nil {
+		fake.getFloat64ReturnsOnCall = make(map[int]struct {
+			result1 float64
+			result2 error
+		})
+	}
+	fake.getFloat64ReturnsOnCall[i] = struct {
+		result1 float64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetFloat64OrDefault(arg1 string, arg2 float64) (float64, error) {
+	fake.getFloat64OrDefaultMutex.Lock()
+	ret, specificReturn := fake.getFloat64OrDefaultReturnsOnCall[len(fake.getFloat64OrDefaultArgsForCall)]
+	fake.getFloat64OrDefaultArgsForCall = append(fake.getFloat64OrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 float64
+	}{arg1, arg2})
+	stub := fake.GetFloat64OrDefaultStub
+	fakeReturns := fake.getFloat64OrDefaultReturns
+	fake.recordInvocation("GetFloat64OrDefault", []interface{}{arg1, arg2})
+	fake.getFloat64OrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetFloat64OrDefaultCallCount() int {
+	fake.getFloat64OrDefaultMutex.RLock()
+	defer fake.getFloat64OrDefaultMutex.RUnlock()
+	return len(fake.getFloat64OrDefaultArgsForCall)
+}
+
+func (fake *FakeResource) GetFloat64OrDefaultCalls(stub func(string, float64) (float64, error)) {
+	fake.getFloat64OrDefaultMutex.Lock()
+	defer fake.getFloat64OrDefaultMutex.Unlock()
+	fake.GetFloat64OrDefaultStub = stub
+}
+
+func (fake *FakeResource) GetFloat64OrDefaultArgsForCall(i int) (string, float64) {
+	fake.getFloat64OrDefaultMutex.RLock()
+	defer fake.getFloat64OrDefaultMutex.RUnlock()
+	argsForCall := fake.getFloat64OrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) GetFloat64OrDefaultReturns(result1 float64, result2 error) {
+	fake.getFloat64OrDefaultMutex.Lock()
+	defer fake.getFloat64OrDefaultMutex.Unlock()
+	fake.GetFloat64OrDefaultStub = nil
+	fake.getFloat64OrDefaultReturns = struct {
+		result1 float64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetFloat64OrDefaultReturnsOnCall(i int, result1 float64, result2 error) {
+	fake.getFloat64OrDefaultMutex.Lock()
+	defer fake.getFloat64OrDefaultMutex.Unlock()
+	fake.GetFloat64OrDefaultStub = nil
+	if fake.getFloat64OrDefaultReturnsOnCall == nil {
+		fake.getFloat64OrDefaultReturnsOnCall = make(map[int]struct {
+			result1 float64
+			result2 error
+		})
+	}
+	fake.getFloat64OrDefaultReturnsOnCall[i] = struct {
+		result1 float64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetGeneration() int64 {
+	fake.getGenerationMutex.Lock()
+	ret, specificReturn := fake.getGenerationReturnsOnCall[len(fake.getGenerationArgsForCall)]
+	fake.getGenerationArgsForCall = append(fake.getGenerationArgsForCall, struct {
+	}{})
+	stub := fake.GetGenerationStub
+	fakeReturns := fake.getGenerationReturns
+	fake.recordInvocation("GetGeneration", []interface{}{})
+	fake.getGenerationMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) GetGenerationCallCount() int {
+	fake.getGenerationMutex.RLock()
+	defer fake.getGenerationMutex.RUnlock()
+	return len(fake.getGenerationArgsForCall)
+}
+
+func (fake *FakeResource) GetGenerationCalls(stub func() int64) {
+	fake.getGenerationMutex.Lock()
+	defer fake.getGenerationMutex.Unlock()
+	fake.GetGenerationStub = stub
+}
+
+func (fake *FakeResource) GetGenerationReturns(result1 int64) {
+	fake.getGenerationMutex.Lock()
+	defer fake.getGenerationMutex.Unlock()
+	fake.GetGenerationStub = nil
+	fake.getGenerationReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeResource) GetGenerationReturnsOnCall(i int, result1 int64) {
+	fake.getGenerationMutex.Lock()
+	defer fake.getGenerationMutex.Unlock()
+	fake.GetGenerationStub = nil
+	if fake.getGenerationReturnsOnCall == nil {
+		fake.getGenerationReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.getGenerationReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeResource) GetGroupVersionKind() schema.GroupVersionKind {
+	fake.getGroupVersionKindMutex.Lock()
+	ret, specificReturn := fake.getGroupVersionKindReturnsOnCall[len(fake.getGroupVersionKindArgsForCall)]
+	fake.getGroupVersionKindArgsForCall = append(fake.getGroupVersionKindArgsForCall, struct {
+	}{})
+	stub := fake.GetGroupVersionKindStub
+	fakeReturns := fake.getGroupVersionKindReturns
+	fake.recordInvocation("GetGroupVersionKind", []interface{}{})
+	fake.getGroupVersionKindMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) GetGroupVersionKindCallCount() int {
+	fake.getGroupVersionKindMutex.RLock()
+	defer fake.getGroupVersionKindMutex.RUnlock()
+	return len(fake.getGroupVersionKindArgsForCall)
+}
+
+func (fake *FakeResource) GetGroupVersionKindCalls(stub func() schema.GroupVersionKind) {
+	fake.getGroupVersionKindMutex.Lock()
+	defer fake.getGroupVersionKindMutex.Unlock()
+	fake.GetGroupVersionKindStub = stub
+}
+
+func (fake *FakeResource) GetGroupVersionKindReturns(result1 schema.GroupVersionKind) {
+	fake.getGroupVersionKindMutex.Lock()
+	defer fake.getGroupVersionKindMutex.Unlock()
+	fake.GetGroupVersionKindStub = nil
+	fake.getGroupVersionKindReturns = struct {
+		result1 schema.GroupVersionKind
+	}{result1}
+}
+
+func (fake *FakeResource) GetGroupVersionKindReturnsOnCall(i int, result1 schema.GroupVersionKind) {
+	fake.getGroupVersionKindMutex.Lock()
+	defer fake.getGroupVersionKindMutex.Unlock()
+	fake.GetGroupVersionKindStub = nil
+	if fake.getGroupVersionKindReturnsOnCall == nil {
+		fake.getGroupVersionKindReturnsOnCall = make(map[int]struct {
+			result1 schema.GroupVersionKind
+		})
+	}
+	fake.getGroupVersionKindReturnsOnCall[i] = struct {
+		result1 schema.GroupVersionKind
+	}{result1}
+}
+
+func (fake *FakeResource) GetInt64(arg1 string) (int64, error) {
+	fake.getInt64Mutex.Lock()
+	ret, specificReturn := fake.getInt64ReturnsOnCall[len(fake.getInt64ArgsForCall)]
+	fake.getInt64ArgsForCall = append(fake.getInt64ArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetInt64Stub
+	fakeReturns := fake.getInt64Returns
+	fake.recordInvocation("GetInt64", []interface{}{arg1})
+	fake.getInt64Mutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetInt64CallCount() int {
+	fake.getInt64Mutex.RLock()
+	defer fake.getInt64Mutex.RUnlock()
+	return len(fake.getInt64ArgsForCall)
+}
+
+func (fake *FakeResource) GetInt64Calls(stub func(string) (int64, error)) {
+	fake.getInt64Mutex.Lock()
+	defer fake.getInt64Mutex.Unlock()
+	fake.GetInt64Stub = stub
+}
+
+func (fake *FakeResource) GetInt64ArgsForCall(i int) string {
+	fake.getInt64Mutex.RLock()
+	defer fake.getInt64Mutex.RUnlock()
+	argsForCall := fake.getInt64ArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) GetInt64Returns(result1 int64, result2 error) {
+	fake.getInt64Mutex.Lock()
+	defer fake.getInt64Mutex.Unlock()
+	fake.GetInt64Stub = nil
+	fake.getInt64Returns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetInt64ReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.getInt64Mutex.Lock()
+	defer fake.getInt64Mutex.Unlock()
+	fake.GetInt64Stub = nil
+	if fake.getInt64ReturnsOnCall == nil {
+		fake.getInt64ReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.getInt64ReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetInt64OrDefault(arg1 string, arg2 int64) (int64, error) {
+	fake.getInt64OrDefaultMutex.Lock()
+	ret, specificReturn := fake.getInt64OrDefaultReturnsOnCall[len(fake.getInt64OrDefaultArgsForCall)]
+	fake.getInt64OrDefaultArgsForCall = append(fake.getInt64OrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 int64
+	}{arg1, arg2})
+	stub := fake.GetInt64OrDefaultStub
+	fakeReturns := fake.getInt64OrDefaultReturns
+	fake.recordInvocation("GetInt64OrDefault", []interface{}{arg1, arg2})
+	fake.getInt64OrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetInt64OrDefaultCallCount() int {
+	fake.getInt64OrDefaultMutex.RLock()
+	defer fake.getInt64OrDefaultMutex.RUnlock()
+	return len(fake.getInt64OrDefaultArgsForCall)
+}
+
+func (fake *FakeResource) GetInt64OrDefaultCalls(stub func(string, int64) (int64, error)) {
+	fake.getInt64OrDefaultMutex.Lock()
+	defer fake.getInt64OrDefaultMutex.Unlock()
+	fake.GetInt64OrDefaultStub = stub
+}
+
+func (fake *FakeResource) GetInt64OrDefaultArgsForCall(i int) (string, int64) {
+	fake.getInt64OrDefaultMutex.RLock()
+	defer fake.getInt64OrDefaultMutex.RUnlock()
+	argsForCall := fake.getInt64OrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) GetInt64OrDefaultReturns(result1 int64, result2 error) {
+	fake.getInt64OrDefaultMutex.Lock()
+	defer fake.getInt64OrDefaultMutex.Unlock()
+	fake.GetInt64OrDefaultStub = nil
+	fake.getInt64OrDefaultReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetInt64OrDefaultReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.getInt64OrDefaultMutex.Lock()
+	defer fake.getInt64OrDefaultMutex.Unlock()
+	fake.GetInt64OrDefaultStub = nil
+	if fake.getInt64OrDefaultReturnsOnCall == nil {
+		fake.getInt64OrDefaultReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.getInt64OrDefaultReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetLabels() map[string]string {
+	fake.getLabelsMutex.Lock()
+	ret, specificReturn := fake.getLabelsReturnsOnCall[len(fake.getLabelsArgsForCall)]
+	fake.getLabelsArgsForCall = append(fake.getLabelsArgsForCall, struct {
+	}{})
+	stub := fake.GetLabelsStub
+	fakeReturns := fake.getLabelsReturns
+	fake.recordInvocation("GetLabels", []interface{}{})
+	fake.getLabelsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) GetLabelsCallCount() int {
+	fake.getLabelsMutex.RLock()
+	defer fake.getLabelsMutex.RUnlock()
+	return len(fake.getLabelsArgsForCall)
+}
+
+func (fake *FakeResource) GetLabelsCalls(stub func() map[string]string) {
+	fake.getLabelsMutex.Lock()
+	defer fake.getLabelsMutex.Unlock()
+	fake.GetLabelsStub = stub
+}
+
+func (fake *FakeResource) GetLabelsReturns(result1 map[string]string) {
+	fake.getLabelsMutex.Lock()
+	defer fake.getLabelsMutex.Unlock()
+	fake.GetLabelsStub = nil
+	fake.getLabelsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeResource) GetLabelsReturnsOnCall(i int, result1 map[string]string) {
+	fake.getLabelsMutex.Lock()
+	defer fake.getLabelsMutex.Unlock()
+	fake.GetLabelsStub = nil
+	if fake.getLabelsReturnsOnCall == nil {
+		fake.getLabelsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.getLabelsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeResource) GetMetadata() map[string]any {
+	fake.getMetadataMutex.Lock()
+	ret, specificReturn := fake.getMetadataReturnsOnCall[len(fake.getMetadataArgsForCall)]
+	fake.getMetadataArgsForCall = append(fake.getMetadataArgsForCall, struct {
+	}{})
+	stub := fake.GetMetadataStub
+	fakeReturns := fake.getMetadataReturns
+	fake.recordInvocation("GetMetadata", []interface{}{})
+	fake.getMetadataMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) GetMetadataCallCount() int {
+	fake.getMetadataMutex.RLock()
+	defer fake.getMetadataMutex.RUnlock()
+	return len(fake.getMetadataArgsForCall)
+}
+
+func (fake *FakeResource) GetMetadataCalls(stub func() map[string]any) {
+	fake.getMetadataMutex.Lock()
+	defer fake.getMetadataMutex.Unlock()
+	fake.GetMetadataStub = stub
+}
+
+func (fake *FakeResource) GetMetadataReturns(result1 map[string]any) {
+	fake.getMetadataMutex.Lock()
+	defer fake.getMetadataMutex.Unlock()
+	fake.GetMetadataStub = nil
+	fake.getMetadataReturns = struct {
+		result1 map[string]any
+	}{result1}
+}
+
+func (fake *FakeResource) GetMetadataReturnsOnCall(i int, result1 map[string]any) {
+	fake.getMetadataMutex.Lock()
+	defer fake.getMetadataMutex.Unlock()
+	fake.GetMetadataStub = nil
+	if fake.getMetadataReturnsOnCall == nil {
+		fake.getMetadataReturnsOnCall = make(map[int]struct {
+			result1 map[string]any
+		})
+	}
+	fake.getMetadataReturnsOnCall[i] = struct {
+		result1 map[string]any
+	}{result1}
+}
+
+func (fake *FakeResource) GetName() string {
+	fake.getNameMutex.Lock()
+	ret, specificReturn := fake.getNameReturnsOnCall[len(fake.getNameArgsForCall)]
+	fake.getNameArgsForCall = append(fake.getNameArgsForCall, struct {
+	}{})
+	stub := fake.GetNameStub
+	fakeReturns := fake.getNameReturns
+	fake.recordInvocation("GetName", []interface{}{})
+	fake.getNameMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) GetNameCallCount() int {
+	fake.getNameMutex.RLock()
+	defer fake.getNameMutex.RUnlock()
+	return len(fake.getNameArgsForCall)
+}
+
+func (fake *FakeResource) GetNameCalls(stub func() string) {
+	fake.getNameMutex.Lock()
+	defer fake.getNameMutex.Unlock()
+	fake.GetNameStub = stub
+}
+
+func (fake *FakeResource) GetNameReturns(result1 string) {
+	fake.getNameMutex.Lock()
+	defer fake.getNameMutex.Unlock()
+	fake.GetNameStub = nil
+	fake.getNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) GetNameReturnsOnCall(i int, result1 string) {
+	fake.getNameMutex.Lock()
+	defer fake.getNameMutex.Unlock()
+	fake.GetNameStub = nil
+	if fake.getNameReturnsOnCall == nil {
+		fake.getNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) GetNamespace() string {
+	fake.getNamespaceMutex.Lock()
+	ret, specificReturn := fake.getNamespaceReturnsOnCall[len(fake.getNamespaceArgsForCall)]
+	fake.getNamespaceArgsForCall = append(fake.getNamespaceArgsForCall, struct {
+	}{})
+	stub := fake.GetNamespaceStub
+	fakeReturns := fake.getNamespaceReturns
+	fake.recordInvocation("GetNamespace", []interface{}{})
+	fake.getNamespaceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) GetNamespaceCallCount() int {
+	fake.getNamespaceMutex.RLock()
+	defer fake.getNamespaceMutex.RUnlock()
+	return len(fake.getNamespaceArgsForCall)
+}
+
+func (fake *FakeResource) GetNamespaceCalls(stub func() string) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = stub
+}
+
+func (fake *FakeResource) GetNamespaceReturns(result1 string) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = nil
+	fake.getNamespaceReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) GetNamespaceReturnsOnCall(i int, result1 string) {
+	fake.getNamespaceMutex.Lock()
+	defer fake.getNamespaceMutex.Unlock()
+	fake.GetNamespaceStub = nil
+	if fake.getNamespaceReturnsOnCall == nil {
+		fake.getNamespaceReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getNamespaceReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) GetOwnerReferences() []v1.OwnerReference {
+	fake.getOwnerReferencesMutex.Lock()
+	ret, specificReturn := fake.getOwnerReferencesReturnsOnCall[len(fake.getOwnerReferencesArgsForCall)]
+	fake.getOwnerReferencesArgsForCall = append(fake.getOwnerReferencesArgsForCall, struct {
+	}{})
+	stub := fake.GetOwnerReferencesStub
+	fakeReturns := fake.getOwnerReferencesReturns
+	fake.recordInvocation("GetOwnerReferences", []interface{}{})
+	fake.getOwnerReferencesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) GetOwnerReferencesCallCount() int {
+	fake.getOwnerReferencesMutex.RLock()
+	defer fake.getOwnerReferencesMutex.RUnlock()
+	return len(fake.getOwnerReferencesArgsForCall)
+}
+
+func (fake *FakeResource) GetOwnerReferencesCalls(stub func() []v1.OwnerReference) {
+	fake.getOwnerReferencesMutex.Lock()
+	defer fake.getOwnerReferencesMutex.Unlock()
+	fake.GetOwnerReferencesStub = stub
+}
+
+func (fake *FakeResource) GetOwnerReferencesReturns(result1 []v1.OwnerReference) {
+	fake.getOwnerReferencesMutex.Lock()
+	defer fake.getOwnerReferencesMutex.Unlock()
+	fake.GetOwnerReferencesStub = nil
+	fake.getOwnerReferencesReturns = struct {
+		result1 []v1.OwnerReference
+	}{result1}
+}
+
+func (fake *FakeResource) GetOwnerReferencesReturnsOnCall(i int, result1 []v1.OwnerReference) {
+	fake.getOwnerReferencesMutex.Lock()
+	defer fake.getOwnerReferencesMutex.Unlock()
+	fake.GetOwnerReferencesStub = nil
+	if fake.getOwnerReferencesReturnsOnCall == nil {
+		fake.getOwnerReferencesReturnsOnCall = make(map[int]struct {
+			result1 []v1.OwnerReference
+		})
+	}
+	fake.getOwnerReferencesReturnsOnCall[i] = struct {
+		result1 []v1.OwnerReference
+	}{result1}
+}
+
+func (fake *FakeResource) GetQuantity(arg1 string) (resource.Quantity, error) {
+	fake.getQuantityMutex.Lock()
+	ret, specificReturn := fake.getQuantityReturnsOnCall[len(fake.getQuantityArgsForCall)]
+	fake.getQuantityArgsForCall = append(fake.getQuantityArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetQuantityStub
+	fakeReturns := fake.getQuantityReturns
+	fake.recordInvocation("GetQuantity", []interface{}{arg1})
+	fake.getQuantityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetQuantityCallCount() int {
+	fake.getQuantityMutex.RLock()
+	defer fake.getQuantityMutex.RUnlock()
+	return len(fake.getQuantityArgsForCall)
+}
+
+func (fake *FakeResource) GetQuantityCalls(stub func(string) (resource.Quantity, error)) {
+	fake.getQuantityMutex.Lock()
+	defer fake.getQuantityMutex.Unlock()
+	fake.GetQuantityStub = stub
+}
+
+func (fake *FakeResource) GetQuantityArgsForCall(i int) string {
+	fake.getQuantityMutex.RLock()
+	defer fake.getQuantityMutex.RUnlock()
+	argsForCall := fake.getQuantityArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) GetQuantityReturns(result1 resource.Quantity, result2 error) {
+	fake.getQuantityMutex.Lock()
+	defer fake.getQuantityMutex.Unlock()
+	fake.GetQuantityStub = nil
+	fake.getQuantityReturns = struct {
+		result1 resource.Quantity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetQuantityReturnsOnCall(i int, result1 resource.Quantity, result2 error) {
+	fake.getQuantityMutex.Lock()
+	defer fake.getQuantityMutex.Unlock()
+	fake.GetQuantityStub = nil
+	if fake.getQuantityReturnsOnCall == nil {
+		fake.getQuantityReturnsOnCall = make(map[int]struct {
+			result1 resource.Quantity
+			result2 error
+		})
+	}
+	fake.getQuantityReturnsOnCall[i] = struct {
+		result1 resource.Quantity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetQuantityOrDefault(arg1 string, arg2 resource.Quantity) (resource.Quantity, error) {
+	fake.getQuantityOrDefaultMutex.Lock()
+	ret, specificReturn := fake.getQuantityOrDefaultReturnsOnCall[len(fake.getQuantityOrDefaultArgsForCall)]
+	fake.getQuantityOrDefaultArgsForCall = append(fake.getQuantityOrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 resource.Quantity
+	}{arg1, arg2})
+	stub := fake.GetQuantityOrDefaultStub
+	fakeReturns := fake.getQuantityOrDefaultReturns
+	fake.recordInvocation("GetQuantityOrDefault", []interface{}{arg1, arg2})
+	fake.getQuantityOrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetQuantityOrDefaultCallCount() int {
+	fake.getQuantityOrDefaultMutex.RLock()
+	defer fake.getQuantityOrDefaultMutex.RUnlock()
+	return len(fake.getQuantityOrDefaultArgsForCall)
+}
+
+func (fake *FakeResource) GetQuantityOrDefaultCalls(stub func(string, resource.Quantity) (resource.Quantity, error)) {
+	fake.getQuantityOrDefaultMutex.Lock()
+	defer fake.getQuantityOrDefaultMutex.Unlock()
+	fake.GetQuantityOrDefaultStub = stub
+}
+
+func (fake *FakeResource) GetQuantityOrDefaultArgsForCall(i int) (string, resource.Quantity) {
+	fake.getQuantityOrDefaultMutex.RLock()
+	defer fake.getQuantityOrDefaultMutex.RUnlock()
+	argsForCall := fake.getQuantityOrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) GetQuantityOrDefaultReturns(result1 resource.Quantity, result2 error) {
+	fake.getQuantityOrDefaultMutex.Lock()
+	defer fake.getQuantityOrDefaultMutex.Unlock()
+	fake.GetQuantityOrDefaultStub = nil
+	fake.getQuantityOrDefaultReturns = struct {
+		result1 resource.Quantity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetQuantityOrDefaultReturnsOnCall(i int, result1 resource.Quantity, result2 error) {
+	fake.getQuantityOrDefaultMutex.Lock()
+	defer fake.getQuantityOrDefaultMutex.Unlock()
+	fake.GetQuantityOrDefaultStub = nil
+	if fake.getQuantityOrDefaultReturnsOnCall == nil {
+		fake.getQuantityOrDefaultReturnsOnCall = make(map[int]struct {
+			result1 resource.Quantity
+			result2 error
+		})
+	}
+	fake.getQuantityOrDefaultReturnsOnCall[i] = struct {
+		result1 resource.Quantity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetResourceVersion() string {
+	fake.getResourceVersionMutex.Lock()
+	ret, specificReturn := fake.getResourceVersionReturnsOnCall[len(fake.getResourceVersionArgsForCall)]
+	fake.getResourceVersionArgsForCall = append(fake.getResourceVersionArgsForCall, struct {
+	}{})
+	stub := fake.GetResourceVersionStub
+	fakeReturns := fake.getResourceVersionReturns
+	fake.recordInvocation("GetResourceVersion", []interface{}{})
+	fake.getResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) GetResourceVersionCallCount() int {
+	fake.getResourceVersionMutex.RLock()
+	defer fake.getResourceVersionMutex.RUnlock()
+	return len(fake.getResourceVersionArgsForCall)
+}
+
+func (fake *FakeResource) GetResourceVersionCalls(stub func() string) {
+	fake.getResourceVersionMutex.Lock()
+	defer fake.getResourceVersionMutex.Unlock()
+	fake.GetResourceVersionStub = stub
+}
+
+func (fake *FakeResource) GetResourceVersionReturns(result1 string) {
+	fake.getResourceVersionMutex.Lock()
+	defer fake.getResourceVersionMutex.Unlock()
+	fake.GetResourceVersionStub = nil
+	fake.getResourceVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) GetResourceVersionReturnsOnCall(i int, result1 string) {
+	fake.getResourceVersionMutex.Lock()
+	defer fake.getResourceVersionMutex.Unlock()
+	fake.GetResourceVersionStub = nil
+	if fake.getResourceVersionReturnsOnCall == nil {
+		fake.getResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.getResourceVersionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeResource) GetStatus() (kratix.Status, error) {
+	fake.getStatusMutex.Lock()
+	ret, specificReturn := fake.getStatusReturnsOnCall[len(fake.getStatusArgsForCall)]
+	fake.getStatusArgsForCall = append(fake.getStatusArgsForCall, struct {
+	}{})
+	stub := fake.GetStatusStub
+	fakeReturns := fake.getStatusReturns
+	fake.recordInvocation("GetStatus", []interface{}{})
+	fake.getStatusMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetStatusCallCount() int {
+	fake.getStatusMutex.RLock()
+	defer fake.getStatusMutex.RUnlock()
+	return len(fake.getStatusArgsForCall)
+}
+
+func (fake *FakeResource) GetStatusCalls(stub func() (kratix.Status, error)) {
+	fake.getStatusMutex.Lock()
+	defer fake.getStatusMutex.Unlock()
+	fake.GetStatusStub = stub
+}
+
+func (fake *FakeResource) GetStatusReturns(result1 kratix.Status, result2 error) {
+	fake.getStatusMutex.Lock()
+	defer fake.getStatusMutex.Unlock()
+	fake.GetStatusStub = nil
+	fake.getStatusReturns = struct {
+		result1 kratix.Status
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetStatusReturnsOnCall(i int, result1 kratix.Status, result2 error) {
+	fake.getStatusMutex.Lock()
+	defer fake.getStatusMutex.Unlock()
+	fake.GetStatusStub = nil
+	if fake.getStatusReturnsOnCall == nil {
+		fake.getStatusReturnsOnCall = make(map[int]struct {
+			result1 kratix.Status
+			result2 error
+		})
+	}
+	fake.getStatusReturnsOnCall[i] = struct {
+		result1 kratix.Status
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetString(arg1 string) (string, error) {
+	fake.getStringMutex.Lock()
+	ret, specificReturn := fake.getStringReturnsOnCall[len(fake.getStringArgsForCall)]
+	fake.getStringArgsForCall = append(fake.getStringArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetStringStub
+	fakeReturns := fake.getStringReturns
+	fake.recordInvocation("GetString", []interface{}{arg1})
+	fake.getStringMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetStringCallCount() int {
+	fake.getStringMutex.RLock()
+	defer fake.getStringMutex.RUnlock()
+	return len(fake.getStringArgsForCall)
+}
+
+func (fake *FakeResource) GetStringCalls(stub func(string) (string, error)) {
+	fake.getStringMutex.Lock()
+	defer fake.getStringMutex.Unlock()
+	fake.GetStringStub = stub
+}
+
+func (fake *FakeResource) GetStringArgsForCall(i int) string {
+	fake.getStringMutex.RLock()
+	defer fake.getStringMutex.RUnlock()
+	argsForCall := fake.getStringArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) GetStringReturns(result1 string, result2 error) {
+	fake.getStringMutex.Lock()
+	defer fake.getStringMutex.Unlock()
+	fake.GetStringStub = nil
+	fake.getStringReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetStringReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getStringMutex.Lock()
+	defer fake.getStringMutex.Unlock()
+	fake.GetStringStub = nil
+	if fake.getStringReturnsOnCall == nil {
+		fake.getStringReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getStringReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetStringMap(arg1 string) (map[string]string, error) {
+	fake.getStringMapMutex.Lock()
+	ret, specificReturn := fake.getStringMapReturnsOnCall[len(fake.getStringMapArgsForCall)]
+	fake.getStringMapArgsForCall = append(fake.getStringMapArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetStringMapStub
+	fakeReturns := fake.getStringMapReturns
+	fake.recordInvocation("GetStringMap", []interface{}{arg1})
+	fake.getStringMapMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetStringMapCallCount() int {
+	fake.getStringMapMutex.RLock()
+	defer fake.getStringMapMutex.RUnlock()
+	return len(fake.getStringMapArgsForCall)
+}
+
+func (fake *FakeResource) GetStringMapCalls(stub func(string) (map[string]string, error)) {
+	fake.getStringMapMutex.Lock()
+	defer fake.getStringMapMutex.Unlock()
+	fake.GetStringMapStub = stub
+}
+
+func (fake *FakeResource) GetStringMapArgsForCall(i int) string {
+	fake.getStringMapMutex.RLock()
+	defer fake.getStringMapMutex.RUnlock()
+	argsForCall := fake.getStringMapArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) GetStringMapReturns(result1 map[string]string, result2 error) {
+	fake.getStringMapMutex.Lock()
+	defer fake.getStringMapMutex.Unlock()
+	fake.GetStringMapStub = nil
+	fake.getStringMapReturns = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetStringMapReturnsOnCall(i int, result1 map[string]string, result2 error) {
+	fake.getStringMapMutex.Lock()
+	defer fake.getStringMapMutex.Unlock()
+	fake.GetStringMapStub = nil
+	if fake.getStringMapReturnsOnCall == nil {
+		fake.getStringMapReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+			result2 error
+		})
+	}
+	fake.getStringMapReturnsOnCall[i] = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetStringMapOrDefault(arg1 string, arg2 map[string]string) (map[string]string, error) {
+	fake.getStringMapOrDefaultMutex.Lock()
+	ret, specificReturn := fake.getStringMapOrDefaultReturnsOnCall[len(fake.getStringMapOrDefaultArgsForCall)]
+	fake.getStringMapOrDefaultArgsForCall = append(fake.getStringMapOrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 map[string]string
+	}{arg1, arg2})
+	stub := fake.GetStringMapOrDefaultStub
+	fakeReturns := fake.getStringMapOrDefaultReturns
+	fake.recordInvocation("GetStringMapOrDefault", []interface{}{arg1, arg2})
+	fake.getStringMapOrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetStringMapOrDefaultCallCount() int {
+	fake.getStringMapOrDefaultMutex.RLock()
+	defer fake.getStringMapOrDefaultMutex.RUnlock()
+	return len(fake.getStringMapOrDefaultArgsForCall)
+}
+
+func (fake *FakeResource) GetStringMapOrDefaultCalls(stub func(string, map[string]string) (map[string]string, error)) {
+	fake.getStringMapOrDefaultMutex.Lock()
+	defer fake.getStringMapOrDefaultMutex.Unlock()
+	fake.GetStringMapOrDefaultStub = stub
+}
+
+func (fake *FakeResource) GetStringMapOrDefaultArgsForCall(i int) (string, map[string]string) {
+	fake.getStringMapOrDefaultMutex.RLock()
+	defer fake.getStringMapOrDefaultMutex.RUnlock()
+	argsForCall := fake.getStringMapOrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) GetStringMapOrDefaultReturns(result1 map[string]string, result2 error) {
+	fake.getStringMapOrDefaultMutex.Lock()
+	defer fake.getStringMapOrDefaultMutex.Unlock()
+	fake.GetStringMapOrDefaultStub = nil
+	fake.getStringMapOrDefaultReturns = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetStringMapOrDefaultReturnsOnCall(i int, result1 map[string]string, result2 error) {
+	fake.getStringMapOrDefaultMutex.Lock()
+	defer fake.getStringMapOrDefaultMutex.Unlock()
+	fake.GetStringMapOrDefaultStub = nil
+	if fake.getStringMapOrDefaultReturnsOnCall == nil {
+		fake.getStringMapOrDefaultReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+			result2 error
+		})
+	}
+	fake.getStringMapOrDefaultReturnsOnCall[i] = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetStringOrDefault(arg1 string, arg2 string) (string, error) {
+	fake.getStringOrDefaultMutex.Lock()
+	ret, specificReturn := fake.getStringOrDefaultReturnsOnCall[len(fake.getStringOrDefaultArgsForCall)]
+	fake.getStringOrDefaultArgsForCall = append(fake.getStringOrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetStringOrDefaultStub
+	fakeReturns := fake.getStringOrDefaultReturns
+	fake.recordInvocation("GetStringOrDefault", []interface{}{arg1, arg2})
+	fake.getStringOrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetStringOrDefaultCallCount() int {
+	fake.getStringOrDefaultMutex.RLock()
+	defer fake.getStringOrDefaultMutex.RUnlock()
+	return len(fake.getStringOrDefaultArgsForCall)
+}
+
+func (fake *FakeResource) GetStringOrDefaultCalls(stub func(string, string) (string, error)) {
+	fake.getStringOrDefaultMutex.Lock()
+	defer fake.getStringOrDefaultMutex.Unlock()
+	fake.GetStringOrDefaultStub = stub
+}
+
+func (fake *FakeResource) GetStringOrDefaultArgsForCall(i int) (string, string) {
+	fake.getStringOrDefaultMutex.RLock()
+	defer fake.getStringOrDefaultMutex.RUnlock()
+	argsForCall := fake.getStringOrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) GetStringOrDefaultReturns(result1 string, result2 error) {
+	fake.getStringOrDefaultMutex.Lock()
+	defer fake.getStringOrDefaultMutex.Unlock()
+	fake.GetStringOrDefaultStub = nil
+	fake.getStringOrDefaultReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetStringOrDefaultReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getStringOrDefaultMutex.Lock()
+	defer fake.getStringOrDefaultMutex.Unlock()
+	fake.GetStringOrDefaultStub = nil
+	if fake.getStringOrDefaultReturnsOnCall == nil {
+		fake.getStringOrDefaultReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getStringOrDefaultReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetStringSlice(arg1 string) ([]string, error) {
+	fake.getStringSliceMutex.Lock()
+	ret, specificReturn := fake.getStringSliceReturnsOnCall[len(fake.getStringSliceArgsForCall)]
+	fake.getStringSliceArgsForCall = append(fake.getStringSliceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetStringSliceStub
+	fakeReturns := fake.getStringSliceReturns
+	fake.recordInvocation("GetStringSlice", []interface{}{arg1})
+	fake.getStringSliceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetStringSliceCallCount() int {
+	fake.getStringSliceMutex.RLock()
+	defer fake.getStringSliceMutex.RUnlock()
+	return len(fake.getStringSliceArgsForCall)
+}
+
+func (fake *FakeResource) GetStringSliceCalls(stub func(string) ([]string, error)) {
+	fake.getStringSliceMutex.Lock()
+	defer fake.getStringSliceMutex.Unlock()
+	fake.GetStringSliceStub = stub
+}
+
+func (fake *FakeResource) GetStringSliceArgsForCall(i int) string {
+	fake.getStringSliceMutex.RLock()
+	defer fake.getStringSliceMutex.RUnlock()
+	argsForCall := fake.getStringSliceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) GetStringSliceReturns(result1 []string, result2 error) {
+	fake.getStringSliceMutex.Lock()
+	defer fake.getStringSliceMutex.Unlock()
+	fake.GetStringSliceStub = nil
+	fake.getStringSliceReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetStringSliceReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.getStringSliceMutex.Lock()
+	defer fake.getStringSliceMutex.Unlock()
+	fake.GetStringSliceStub = nil
+	if fake.getStringSliceReturnsOnCall == nil {
+		fake.getStringSliceReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.getStringSliceReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetStringSliceOrDefault(arg1 string, arg2 []string) ([]string, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.getStringSliceOrDefaultMutex.Lock()
+	ret, specificReturn := fake.getStringSliceOrDefaultReturnsOnCall[len(fake.getStringSliceOrDefaultArgsForCall)]
+	fake.getStringSliceOrDefaultArgsForCall = append(fake.getStringSliceOrDefaultArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.GetStringSliceOrDefaultStub
+	fakeReturns := fake.getStringSliceOrDefaultReturns
+	fake.recordInvocation("GetStringSliceOrDefault", []interface{}{arg1, arg2Copy})
+	fake.getStringSliceOrDefaultMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetStringSliceOrDefaultCallCount() int {
+	fake.getStringSliceOrDefaultMutex.RLock()
+	defer fake.getStringSliceOrDefaultMutex.RUnlock()
+	return len(fake.getStringSliceOrDefaultArgsForCall)
+}
+
+func (fake *FakeResource) GetStringSliceOrDefaultCalls(stub func(string, []string) ([]string, error)) {
+	fake.getStringSliceOrDefaultMutex.Lock()
+	defer fake.getStringSliceOrDefaultMutex.Unlock()
+	fake.GetStringSliceOrDefaultStub = stub
+}
+
+func (fake *FakeResource) GetStringSliceOrDefaultArgsForCall(i int) (string, []string) {
+	fake.getStringSliceOrDefaultMutex.RLock()
+	defer fake.getStringSliceOrDefaultMutex.RUnlock()
+	argsForCall := fake.getStringSliceOrDefaultArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) GetStringSliceOrDefaultReturns(result1 []string, result2 error) {
+	fake.getStringSliceOrDefaultMutex.Lock()
+	defer fake.getStringSliceOrDefaultMutex.Unlock()
+	fake.GetStringSliceOrDefaultStub = nil
+	fake.getStringSliceOrDefaultReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetStringSliceOrDefaultReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.getStringSliceOrDefaultMutex.Lock()
+	defer fake.getStringSliceOrDefaultMutex.Unlock()
+	fake.GetStringSliceOrDefaultStub = nil
+	if fake.getStringSliceOrDefaultReturnsOnCall == nil {
+		fake.getStringSliceOrDefaultReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.getStringSliceOrDefaultReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetUID() types.UID {
+	fake.getUIDMutex.Lock()
+	ret, specificReturn := fake.getUIDReturnsOnCall[len(fake.getUIDArgsForCall)]
+	fake.getUIDArgsForCall = append(fake.getUIDArgsForCall, struct {
+	}{})
+	stub := fake.GetUIDStub
+	fakeReturns := fake.getUIDReturns
+	fake.recordInvocation("GetUID", []interface{}{})
+	fake.getUIDMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) GetUIDCallCount() int {
+	fake.getUIDMutex.RLock()
+	defer fake.getUIDMutex.RUnlock()
+	return len(fake.getUIDArgsForCall)
+}
+
+func (fake *FakeResource) GetUIDCalls(stub func() types.UID) {
+	fake.getUIDMutex.Lock()
+	defer fake.getUIDMutex.Unlock()
+	fake.GetUIDStub = stub
+}
+
+func (fake *FakeResource) GetUIDReturns(result1 types.UID) {
+	fake.getUIDMutex.Lock()
+	defer fake.getUIDMutex.Unlock()
+	fake.GetUIDStub = nil
+	fake.getUIDReturns = struct {
+		result1 types.UID
+	}{result1}
+}
+
+func (fake *FakeResource) GetUIDReturnsOnCall(i int, result1 types.UID) {
+	fake.getUIDMutex.Lock()
+	defer fake.getUIDMutex.Unlock()
+	fake.GetUIDStub = nil
+	if fake.getUIDReturnsOnCall == nil {
+		fake.getUIDReturnsOnCall = make(map[int]struct {
+			result1 types.UID
+		})
+	}
+	fake.getUIDReturnsOnCall[i] = struct {
+		result1 types.UID
+	}{result1}
+}
+
+func (fake *FakeResource) GetValue(arg1 string) (any, error) {
+	fake.getValueMutex.Lock()
+	ret, specificReturn := fake.getValueReturnsOnCall[len(fake.getValueArgsForCall)]
+	fake.getValueArgsForCall = append(fake.getValueArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetValueStub
+	fakeReturns := fake.getValueReturns
+	fake.recordInvocation("GetValue", []interface{}{arg1})
+	fake.getValueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetValueCallCount() int {
+	fake.getValueMutex.RLock()
+	defer fake.getValueMutex.RUnlock()
+	return len(fake.getValueArgsForCall)
+}
+
+func (fake *FakeResource) GetValueCalls(stub func(string) (any, error)) {
+	fake.getValueMutex.Lock()
+	defer fake.getValueMutex.Unlock()
+	fake.GetValueStub = stub
+}
+
+func (fake *FakeResource) GetValueArgsForCall(i int) string {
+	fake.getValueMutex.RLock()
+	defer fake.getValueMutex.RUnlock()
+	argsForCall := fake.getValueArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) GetValueReturns(result1 any, result2 error) {
+	fake.getValueMutex.Lock()
+	defer fake.getValueMutex.Unlock()
+	fake.GetValueStub = nil
+	fake.getValueReturns = struct {
+		result1 any
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetValueReturnsOnCall(i int, result1 any, result2 error) {
+	fake.getValueMutex.Lock()
+	defer fake.getValueMutex.Unlock()
+	fake.GetValueStub = nil
+	if fake.getValueReturnsOnCall == nil {
+		fake.getValueReturnsOnCall = make(map[int]struct {
+			result1 any
+			result2 error
+		})
+	}
+	fake.getValueReturnsOnCall[i] = struct {
+		result1 any
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetValues(arg1 string) ([]any, error) {
+	fake.getValuesMutex.Lock()
+	ret, specificReturn := fake.getValuesReturnsOnCall[len(fake.getValuesArgsForCall)]
+	fake.getValuesArgsForCall = append(fake.getValuesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetValuesStub
+	fakeReturns := fake.getValuesReturns
+	fake.recordInvocation("GetValues", []interface{}{arg1})
+	fake.getValuesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) GetValuesCallCount() int {
+	fake.getValuesMutex.RLock()
+	defer fake.getValuesMutex.RUnlock()
+	return len(fake.getValuesArgsForCall)
+}
+
+func (fake *FakeResource) GetValuesCalls(stub func(string) ([]any, error)) {
+	fake.getValuesMutex.Lock()
+	defer fake.getValuesMutex.Unlock()
+	fake.GetValuesStub = stub
+}
+
+func (fake *FakeResource) GetValuesArgsForCall(i int) string {
+	fake.getValuesMutex.RLock()
+	defer fake.getValuesMutex.RUnlock()
+	argsForCall := fake.getValuesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) GetValuesReturns(result1 []any, result2 error) {
+	fake.getValuesMutex.Lock()
+	defer fake.getValuesMutex.Unlock()
+	fake.GetValuesStub = nil
+	fake.getValuesReturns = struct {
+		result1 []any
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) GetValuesReturnsOnCall(i int, result1 []any, result2 error) {
+	fake.getValuesMutex.Lock()
+	defer fake.getValuesMutex.Unlock()
+	fake.GetValuesStub = nil
+	if fake.getValuesReturnsOnCall == nil {
+		fake.getValuesReturnsOnCall = make(map[int]struct {
+			result1 []any
+			result2 error
+		})
+	}
+	fake.getValuesReturnsOnCall[i] = struct {
+		result1 []any
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) SetAnnotation(arg1 string, arg2 string) {
+	fake.setAnnotationMutex.Lock()
+	fake.setAnnotationArgsForCall = append(fake.setAnnotationArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SetAnnotationStub
+	fake.recordInvocation("SetAnnotation", []interface{}{arg1, arg2})
+	fake.setAnnotationMutex.Unlock()
+	if stub != nil {
+		fake.SetAnnotationStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeResource) SetAnnotationCallCount() int {
+	fake.setAnnotationMutex.RLock()
+	defer fake.setAnnotationMutex.RUnlock()
+	return len(fake.setAnnotationArgsForCall)
+}
+
+func (fake *FakeResource) SetAnnotationCalls(stub func(string, string)) {
+	fake.setAnnotationMutex.Lock()
+	defer fake.setAnnotationMutex.Unlock()
+	fake.SetAnnotationStub = stub
+}
+
+func (fake *FakeResource) SetAnnotationArgsForCall(i int) (string, string) {
+	fake.setAnnotationMutex.RLock()
+	defer fake.setAnnotationMutex.RUnlock()
+	argsForCall := fake.setAnnotationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) SetLabel(arg1 string, arg2 string) {
+	fake.setLabelMutex.Lock()
+	fake.setLabelArgsForCall = append(fake.setLabelArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SetLabelStub
+	fake.recordInvocation("SetLabel", []interface{}{arg1, arg2})
+	fake.setLabelMutex.Unlock()
+	if stub != nil {
+		fake.SetLabelStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeResource) SetLabelCallCount() int {
+	fake.setLabelMutex.RLock()
+	defer fake.setLabelMutex.RUnlock()
+	return len(fake.setLabelArgsForCall)
+}
+
+func (fake *FakeResource) SetLabelCalls(stub func(string, string)) {
+	fake.setLabelMutex.Lock()
+	defer fake.setLabelMutex.Unlock()
+	fake.SetLabelStub = stub
+}
+
+func (fake *FakeResource) SetLabelArgsForCall(i int) (string, string) {
+	fake.setLabelMutex.RLock()
+	defer fake.setLabelMutex.RUnlock()
+	argsForCall := fake.setLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) SetValue(arg1 string, arg2 any) error {
+	fake.setValueMutex.Lock()
+	ret, specificReturn := fake.setValueReturnsOnCall[len(fake.setValueArgsForCall)]
+	fake.setValueArgsForCall = append(fake.setValueArgsForCall, struct {
+		arg1 string
+		arg2 any
+	}{arg1, arg2})
+	stub := fake.SetValueStub
+	fakeReturns := fake.setValueReturns
+	fake.recordInvocation("SetValue", []interface{}{arg1, arg2})
+	fake.setValueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) SetValueCallCount() int {
+	fake.setValueMutex.RLock()
+	defer fake.setValueMutex.RUnlock()
+	return len(fake.setValueArgsForCall)
+}
+
+func (fake *FakeResource) SetValueCalls(stub func(string, any) error) {
+	fake.setValueMutex.Lock()
+	defer fake.setValueMutex.Unlock()
+	fake.SetValueStub = stub
+}
+
+func (fake *FakeResource) SetValueArgsForCall(i int) (string, any) {
+	fake.setValueMutex.RLock()
+	defer fake.setValueMutex.RUnlock()
+	argsForCall := fake.setValueArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) SetValueReturns(result1 error) {
+	fake.setValueMutex.Lock()
+	defer fake.setValueMutex.Unlock()
+	fake.SetValueStub = nil
+	fake.setValueReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) SetValueReturnsOnCall(i int, result1 error) {
+	fake.setValueMutex.Lock()
+	defer fake.setValueMutex.Unlock()
+	fake.SetValueStub = nil
+	if fake.setValueReturnsOnCall == nil {
+		fake.setValueReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setValueReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) ToOwnerReference() v1.OwnerReference {
+	fake.toOwnerReferenceMutex.Lock()
+	ret, specificReturn := fake.toOwnerReferenceReturnsOnCall[len(fake.toOwnerReferenceArgsForCall)]
+	fake.toOwnerReferenceArgsForCall = append(fake.toOwnerReferenceArgsForCall, struct {
+	}{})
+	stub := fake.ToOwnerReferenceStub
+	fakeReturns := fake.toOwnerReferenceReturns
+	fake.recordInvocation("ToOwnerReference", []interface{}{})
+	fake.toOwnerReferenceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) ToOwnerReferenceCallCount() int {
+	fake.toOwnerReferenceMutex.RLock()
+	defer fake.toOwnerReferenceMutex.RUnlock()
+	return len(fake.toOwnerReferenceArgsForCall)
+}
+
+func (fake *FakeResource) ToOwnerReferenceCalls(stub func() v1.OwnerReference) {
+	fake.toOwnerReferenceMutex.Lock()
+	defer fake.toOwnerReferenceMutex.Unlock()
+	fake.ToOwnerReferenceStub = stub
+}
+
+func (fake *FakeResource) ToOwnerReferenceReturns(result1 v1.OwnerReference) {
+	fake.toOwnerReferenceMutex.Lock()
+	defer fake.toOwnerReferenceMutex.Unlock()
+	fake.ToOwnerReferenceStub = nil
+	fake.toOwnerReferenceReturns = struct {
+		result1 v1.OwnerReference
+	}{result1}
+}
+
+func (fake *FakeResource) ToOwnerReferenceReturnsOnCall(i int, result1 v1.OwnerReference) {
+	fake.toOwnerReferenceMutex.Lock()
+	defer fake.toOwnerReferenceMutex.Unlock()
+	fake.ToOwnerReferenceStub = nil
+	if fake.toOwnerReferenceReturnsOnCall == nil {
+		fake.toOwnerReferenceReturnsOnCall = make(map[int]struct {
+			result1 v1.OwnerReference
+		})
+	}
+	fake.toOwnerReferenceReturnsOnCall[i] = struct {
+		result1 v1.OwnerReference
+	}{result1}
+}
+
+func (fake *FakeResource) ToUnstructured() unstructured.Unstructured {
+	fake.toUnstructuredMutex.Lock()
+	ret, specificReturn := fake.toUnstructuredReturnsOnCall[len(fake.toUnstructuredArgsForCall)]
+	fake.toUnstructuredArgsForCall = append(fake.toUnstructuredArgsForCall, struct {
+	}{})
+	stub := fake.ToUnstructuredStub
+	fakeReturns := fake.toUnstructuredReturns
+	fake.recordInvocation("ToUnstructured", []interface{}{})
+	fake.toUnstructuredMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) ToUnstructuredCallCount() int {
+	fake.toUnstructuredMutex.RLock()
+	defer fake.toUnstructuredMutex.RUnlock()
+	return len(fake.toUnstructuredArgsForCall)
+}
+
+func (fake *FakeResource) ToUnstructuredCalls(stub func() unstructured.Unstructured) {
+	fake.toUnstructuredMutex.Lock()
+	defer fake.toUnstructuredMutex.Unlock()
+	fake.ToUnstructuredStub = stub
+}
+
+func (fake *FakeResource) ToUnstructuredReturns(result1 unstructured.Unstructured) {
+	fake.toUnstructuredMutex.Lock()
+	defer fake.toUnstructuredMutex.Unlock()
+	fake.ToUnstructuredStub = nil
+	fake.toUnstructuredReturns = struct {
+		result1 unstructured.Unstructured
+	}{result1}
+}
+
+func (fake *FakeResource) ToUnstructuredReturnsOnCall(i int, result1 unstructured.Unstructured) {
+	fake.toUnstructuredMutex.Lock()
+	defer fake.toUnstructuredMutex.Unlock()
+	fake.ToUnstructuredStub = nil
+	if fake.toUnstructuredReturnsOnCall == nil {
+		fake.toUnstructuredReturnsOnCall = make(map[int]struct {
+			result1 unstructured.Unstructured
+		})
+	}
+	fake.toUnstructuredReturnsOnCall[i] = struct {
+		result1 unstructured.Unstructured
+	}{result1}
+}
+
+func (fake *FakeResource) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeResource) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ kratix.Resource = new(FakeResource)
@@ -0,0 +1,2029 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package kratixgofakes
+
+import (
+	"sync"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type FakeSDKInvoker struct {
+	AggregateChildStatusStub        func(kratix.Resource, ...string) (kratix.ChildrenStatus, error)
+	aggregateChildStatusMutex       sync.RWMutex
+	aggregateChildStatusArgsForCall []struct {
+		arg1 kratix.Resource
+		arg2 []string
+	}
+	aggregateChildStatusReturns struct {
+		result1 kratix.ChildrenStatus
+		result2 error
+	}
+	aggregateChildStatusReturnsOnCall map[int]struct {
+		result1 kratix.ChildrenStatus
+		result2 error
+	}
+	BuildSubResourceRequestStub        func(kratix.Resource, string, string, map[string]any) (*unstructured.Unstructured, error)
+	buildSubResourceRequestMutex       sync.RWMutex
+	buildSubResourceRequestArgsForCall []struct {
+		arg1 kratix.Resource
+		arg2 string
+		arg3 string
+		arg4 map[string]any
+	}
+	buildSubResourceRequestReturns struct {
+		result1 *unstructured.Unstructured
+		result2 error
+	}
+	buildSubResourceRequestReturnsOnCall map[int]struct {
+		result1 *unstructured.Unstructured
+		result2 error
+	}
+	CheckRequiredPromisesStub        func(kratix.Promise) (kratix.RequiredPromisesResult, error)
+	checkRequiredPromisesMutex       sync.RWMutex
+	checkRequiredPromisesArgsForCall []struct {
+		arg1 kratix.Promise
+	}
+	checkRequiredPromisesReturns struct {
+		result1 kratix.RequiredPromisesResult
+		result2 error
+	}
+	checkRequiredPromisesReturnsOnCall map[int]struct {
+		result1 kratix.RequiredPromisesResult
+		result2 error
+	}
+	ChildSelectorStub        func(kratix.Resource) labels.Selector
+	childSelectorMutex       sync.RWMutex
+	childSelectorArgsForCall []struct {
+		arg1 kratix.Resource
+	}
+	childSelectorReturns struct {
+		result1 labels.Selector
+	}
+	childSelectorReturnsOnCall map[int]struct {
+		result1 labels.Selector
+	}
+	CurrentPipelineStub        func(kratix.Promise) (*v1alpha1.Pipeline, error)
+	currentPipelineMutex       sync.RWMutex
+	currentPipelineArgsForCall []struct {
+		arg1 kratix.Promise
+	}
+	currentPipelineReturns struct {
+		result1 *v1alpha1.Pipeline
+		result2 error
+	}
+	currentPipelineReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Pipeline
+		result2 error
+	}
+	FetchPromiseStub        func() (kratix.Promise, error)
+	fetchPromiseMutex       sync.RWMutex
+	fetchPromiseArgsForCall []struct {
+	}
+	fetchPromiseReturns struct {
+		result1 kratix.Promise
+		result2 error
+	}
+	fetchPromiseReturnsOnCall map[int]struct {
+		result1 kratix.Promise
+		result2 error
+	}
+	IsConfigureActionStub        func() bool
+	isConfigureActionMutex       sync.RWMutex
+	isConfigureActionArgsForCall []struct {
+	}
+	isConfigureActionReturns struct {
+		result1 bool
+	}
+	isConfigureActionReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	IsDeleteActionStub        func() bool
+	isDeleteActionMutex       sync.RWMutex
+	isDeleteActionArgsForCall []struct {
+	}
+	isDeleteActionReturns struct {
+		result1 bool
+	}
+	isDeleteActionReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	IsPromiseWorkflowStub        func() bool
+	isPromiseWorkflowMutex       sync.RWMutex
+	isPromiseWorkflowArgsForCall []struct {
+	}
+	isPromiseWorkflowReturns struct {
+		result1 bool
+	}
+	isPromiseWorkflowReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	IsResourceWorkflowStub        func() bool
+	isResourceWorkflowMutex       sync.RWMutex
+	isResourceWorkflowArgsForCall []struct {
+	}
+	isResourceWorkflowReturns struct {
+		result1 bool
+	}
+	isResourceWorkflowReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	IsStaleStub        func(kratix.Resource) (bool, error)
+	isStaleMutex       sync.RWMutex
+	isStaleArgsForCall []struct {
+		arg1 kratix.Resource
+	}
+	isStaleReturns struct {
+		result1 bool
+		result2 error
+	}
+	isStaleReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	PipelineNameStub        func() string
+	pipelineNameMutex       sync.RWMutex
+	pipelineNameArgsForCall []struct {
+	}
+	pipelineNameReturns struct {
+		result1 string
+	}
+	pipelineNameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PromiseNameStub        func() string
+	promiseNameMutex       sync.RWMutex
+	promiseNameArgsForCall []struct {
+	}
+	promiseNameReturns struct {
+		result1 string
+	}
+	promiseNameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PublishResourceStub        func(kratix.Resource) error
+	publishResourceMutex       sync.RWMutex
+	publishResourceArgsForCall []struct {
+		arg1 kratix.Resource
+	}
+	publishResourceReturns struct {
+		result1 error
+	}
+	publishResourceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PublishStatusStub        func(kratix.Resource, kratix.Status) error
+	publishStatusMutex       sync.RWMutex
+	publishStatusArgsForCall []struct {
+		arg1 kratix.Resource
+		arg2 kratix.Status
+	}
+	publishStatusReturns struct {
+		result1 error
+	}
+	publishStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReadDestinationSelectorsStub        func() ([]kratix.DestinationSelector, error)
+	readDestinationSelectorsMutex       sync.RWMutex
+	readDestinationSelectorsArgsForCall []struct {
+	}
+	readDestinationSelectorsReturns struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}
+	readDestinationSelectorsReturnsOnCall map[int]struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}
+	ReadEffectiveDestinationSelectorsStub        func(kratix.Promise) ([]kratix.DestinationSelector, error)
+	readEffectiveDestinationSelectorsMutex       sync.RWMutex
+	readEffectiveDestinationSelectorsArgsForCall []struct {
+		arg1 kratix.Promise
+	}
+	readEffectiveDestinationSelectorsReturns struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}
+	readEffectiveDestinationSelectorsReturnsOnCall map[int]struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}
+	ReadPromiseInputStub        func() (kratix.Promise, error)
+	readPromiseInputMutex       sync.RWMutex
+	readPromiseInputArgsForCall []struct {
+	}
+	readPromiseInputReturns struct {
+		result1 kratix.Promise
+		result2 error
+	}
+	readPromiseInputReturnsOnCall map[int]struct {
+		result1 kratix.Promise
+		result2 error
+	}
+	ReadResourceInputStub        func() (kratix.Resource, error)
+	readResourceInputMutex       sync.RWMutex
+	readResourceInputArgsForCall []struct {
+	}
+	readResourceInputReturns struct {
+		result1 kratix.Resource
+		result2 error
+	}
+	readResourceInputReturnsOnCall map[int]struct {
+		result1 kratix.Resource
+		result2 error
+	}
+	ReadStatusStub        func() (kratix.Status, error)
+	readStatusMutex       sync.RWMutex
+	readStatusArgsForCall []struct {
+	}
+	readStatusReturns struct {
+		result1 kratix.Status
+		result2 error
+	}
+	readStatusReturnsOnCall map[int]struct {
+		result1 kratix.Status
+		result2 error
+	}
+	WorkflowActionStub        func() string
+	workflowActionMutex       sync.RWMutex
+	workflowActionArgsForCall []struct {
+	}
+	workflowActionReturns struct {
+		result1 string
+	}
+	workflowActionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	WorkflowTypeStub        func() string
+	workflowTypeMutex       sync.RWMutex
+	workflowTypeArgsForCall []struct {
+	}
+	workflowTypeReturns struct {
+		result1 string
+	}
+	workflowTypeReturnsOnCall map[int]struct {
+		result1 string
+	}
+	WriteDependenciesStub        func(kratix.Promise, string, ...kratix.ObjectTransformer) error
+	writeDependenciesMutex       sync.RWMutex
+	writeDependenciesArgsForCall []struct {
+		arg1 kratix.Promise
+		arg2 string
+		arg3 []kratix.ObjectTransformer
+	}
+	writeDependenciesReturns struct {
+		result1 error
+	}
+	writeDependenciesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteDestinationSelectorsStub        func([]kratix.DestinationSelector) error
+	writeDestinationSelectorsMutex       sync.RWMutex
+	writeDestinationSelectorsArgsForCall []struct {
+		arg1 []kratix.DestinationSelector
+	}
+	writeDestinationSelectorsReturns struct {
+		result1 error
+	}
+	writeDestinationSelectorsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteObjectsStub        func(string, []*unstructured.Unstructured, ...kratix.ObjectTransformer) error
+	writeObjectsMutex       sync.RWMutex
+	writeObjectsArgsForCall []struct {
+		arg1 string
+		arg2 []*unstructured.Unstructured
+		arg3 []kratix.ObjectTransformer
+	}
+	writeObjectsReturns struct {
+		result1 error
+	}
+	writeObjectsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteOutputStub        func(string, []byte) error
+	writeOutputMutex       sync.RWMutex
+	writeOutputArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+	}
+	writeOutputReturns struct {
+		result1 error
+	}
+	writeOutputReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteStatusStub        func(kratix.Status) error
+	writeStatusMutex       sync.RWMutex
+	writeStatusArgsForCall []struct {
+		arg1 kratix.Status
+	}
+	writeStatusReturns struct {
+		result1 error
+	}
+	writeStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WriteSubResourceRequestsStub        func(...*unstructured.Unstructured) error
+	writeSubResourceRequestsMutex       sync.RWMutex
+	writeSubResourceRequestsArgsForCall []struct {
+		arg1 []*unstructured.Unstructured
+	}
+	writeSubResourceRequestsReturns struct {
+		result1 error
+	}
+	writeSubResourceRequestsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSDKInvoker) AggregateChildStatus(arg1 kratix.Resource, arg2 ...string) (kratix.ChildrenStatus, error) {
+	fake.aggregateChildStatusMutex.Lock()
+	ret, specificReturn := fake.aggregateChildStatusReturnsOnCall[len(fake.aggregateChildStatusArgsForCall)]
+	fake.aggregateChildStatusArgsForCall = append(fake.aggregateChildStatusArgsForCall, struct {
+		arg1 kratix.Resource
+		arg2 []string
+	}{arg1, arg2})
+	stub := fake.AggregateChildStatusStub
+	fakeReturns := fake.aggregateChildStatusReturns
+	fake.recordInvocation("AggregateChildStatus", []interface{}{arg1, arg2})
+	fake.aggregateChildStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSDKInvoker) AggregateChildStatusCallCount() int {
+	fake.aggregateChildStatusMutex.RLock()
+	defer fake.aggregateChildStatusMutex.RUnlock()
+	return len(fake.aggregateChildStatusArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) AggregateChildStatusCalls(stub func(kratix.Resource, ...string) (kratix.ChildrenStatus, error)) {
+	fake.aggregateChildStatusMutex.Lock()
+	defer fake.aggregateChildStatusMutex.Unlock()
+	fake.AggregateChildStatusStub = stub
+}
+
+func (fake *FakeSDKInvoker) AggregateChildStatusArgsForCall(i int) (kratix.Resource, []string) {
+	fake.aggregateChildStatusMutex.RLock()
+	defer fake.aggregateChildStatusMutex.RUnlock()
+	argsForCall := fake.aggregateChildStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSDKInvoker) AggregateChildStatusReturns(result1 kratix.ChildrenStatus, result2 error) {
+	fake.aggregateChildStatusMutex.Lock()
+	defer fake.aggregateChildStatusMutex.Unlock()
+	fake.AggregateChildStatusStub = nil
+	fake.aggregateChildStatusReturns = struct {
+		result1 kratix.ChildrenStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) AggregateChildStatusReturnsOnCall(i int, result1 kratix.ChildrenStatus, result2 error) {
+	fake.aggregateChildStatusMutex.Lock()
+	defer fake.aggregateChildStatusMutex.Unlock()
+	fake.AggregateChildStatusStub = nil
+	if fake.aggregateChildStatusReturnsOnCall == nil {
+		fake.aggregateChildStatusReturnsOnCall = make(map[int]struct {
+			result1 kratix.ChildrenStatus
+			result2 error
+		})
+	}
+	fake.aggregateChildStatusReturnsOnCall[i] = struct {
+		result1 kratix.ChildrenStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) BuildSubResourceRequest(arg1 kratix.Resource, arg2 string, arg3 string, arg4 map[string]any) (*unstructured.Unstructured, error) {
+	fake.buildSubResourceRequestMutex.Lock()
+	ret, specificReturn := fake.buildSubResourceRequestReturnsOnCall[len(fake.buildSubResourceRequestArgsForCall)]
+	fake.buildSubResourceRequestArgsForCall = append(fake.buildSubResourceRequestArgsForCall, struct {
+		arg1 kratix.Resource
+		arg2 string
+		arg3 string
+		arg4 map[string]any
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.BuildSubResourceRequestStub
+	fakeReturns := fake.buildSubResourceRequestReturns
+	fake.recordInvocation("BuildSubResourceRequest", []interface{}{arg1, arg2, arg3, arg4})
+	fake.buildSubResourceRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSDKInvoker) BuildSubResourceRequestCallCount() int {
+	fake.buildSubResourceRequestMutex.RLock()
+	defer fake.buildSubResourceRequestMutex.RUnlock()
+	return len(fake.buildSubResourceRequestArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) BuildSubResourceRequestCalls(stub func(kratix.Resource, string, string, map[string]any) (*unstructured.Unstructured, error)) {
+	fake.buildSubResourceRequestMutex.Lock()
+	defer fake.buildSubResourceRequestMutex.Unlock()
+	fake.BuildSubResourceRequestStub = stub
+}
+
+func (fake *FakeSDKInvoker) BuildSubResourceRequestArgsForCall(i int) (kratix.Resource, string, string, map[string]any) {
+	fake.buildSubResourceRequestMutex.RLock()
+	defer fake.buildSubResourceRequestMutex.RUnlock()
+	argsForCall := fake.buildSubResourceRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSDKInvoker) BuildSubResourceRequestReturns(result1 *unstructured.Unstructured, result2 error) {
+	fake.buildSubResourceRequestMutex.Lock()
+	defer fake.buildSubResourceRequestMutex.Unlock()
+	fake.BuildSubResourceRequestStub = nil
+	fake.buildSubResourceRequestReturns = struct {
+		result1 *unstructured.Unstructured
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) BuildSubResourceRequestReturnsOnCall(i int, result1 *unstructured.Unstructured, result2 error) {
+	fake.buildSubResourceRequestMutex.Lock()
+	defer fake.buildSubResourceRequestMutex.Unlock()
+	fake.BuildSubResourceRequestStub = nil
+	if fake.buildSubResourceRequestReturnsOnCall == nil {
+		fake.buildSubResourceRequestReturnsOnCall = make(map[int]struct {
+			result1 *unstructured.Unstructured
+			result2 error
+		})
+	}
+	fake.buildSubResourceRequestReturnsOnCall[i] = struct {
+		result1 *unstructured.Unstructured
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) CheckRequiredPromises(arg1 kratix.Promise) (kratix.RequiredPromisesResult, error) {
+	fake.checkRequiredPromisesMutex.Lock()
+	ret, specificReturn := fake.checkRequiredPromisesReturnsOnCall[len(fake.checkRequiredPromisesArgsForCall)]
+	fake.checkRequiredPromisesArgsForCall = append(fake.checkRequiredPromisesArgsForCall, struct {
+		arg1 kratix.Promise
+	}{arg1})
+	stub := fake.CheckRequiredPromisesStub
+	fakeReturns := fake.checkRequiredPromisesReturns
+	fake.recordInvocation("CheckRequiredPromises", []interface{}{arg1})
+	fake.checkRequiredPromisesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSDKInvoker) CheckRequiredPromisesCallCount() int {
+	fake.checkRequiredPromisesMutex.RLock()
+	defer fake.checkRequiredPromisesMutex.RUnlock()
+	return len(fake.checkRequiredPromisesArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) CheckRequiredPromisesCalls(stub func(kratix.Promise) (kratix.RequiredPromisesResult, error)) {
+	fake.checkRequiredPromisesMutex.Lock()
+	defer fake.checkRequiredPromisesMutex.Unlock()
+	fake.CheckRequiredPromisesStub = stub
+}
+
+func (fake *FakeSDKInvoker) CheckRequiredPromisesArgsForCall(i int) kratix.Promise {
+	fake.checkRequiredPromisesMutex.RLock()
+	defer fake.checkRequiredPromisesMutex.RUnlock()
+	argsForCall := fake.checkRequiredPromisesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSDKInvoker) CheckRequiredPromisesReturns(result1 kratix.RequiredPromisesResult, result2 error) {
+	fake.checkRequiredPromisesMutex.Lock()
+	defer fake.checkRequiredPromisesMutex.Unlock()
+	fake.CheckRequiredPromisesStub = nil
+	fake.checkRequiredPromisesReturns = struct {
+		result1 kratix.RequiredPromisesResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) CheckRequiredPromisesReturnsOnCall(i int, result1 kratix.RequiredPromisesResult, result2 error) {
+	fake.checkRequiredPromisesMutex.Lock()
+	defer fake.checkRequiredPromisesMutex.Unlock()
+	fake.CheckRequiredPromisesStub = nil
+	if fake.checkRequiredPromisesReturnsOnCall == nil {
+		fake.checkRequiredPromisesReturnsOnCall = make(map[int]struct {
+			result1 kratix.RequiredPromisesResult
+			result2 error
+		})
+	}
+	fake.checkRequiredPromisesReturnsOnCall[i] = struct {
+		result1 kratix.RequiredPromisesResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) ChildSelector(arg1 kratix.Resource) labels.Selector {
+	fake.childSelectorMutex.Lock()
+	ret, specificReturn := fake.childSelectorReturnsOnCall[len(fake.childSelectorArgsForCall)]
+	fake.childSelectorArgsForCall = append(fake.childSelectorArgsForCall, struct {
+		arg1 kratix.Resource
+	}{arg1})
+	stub := fake.ChildSelectorStub
+	fakeReturns := fake.childSelectorReturns
+	fake.recordInvocation("ChildSelector", []interface{}{arg1})
+	fake.childSelectorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) ChildSelectorCallCount() int {
+	fake.childSelectorMutex.RLock()
+	defer fake.childSelectorMutex.RUnlock()
+	return len(fake.childSelectorArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) ChildSelectorCalls(stub func(kratix.Resource) labels.Selector) {
+	fake.childSelectorMutex.Lock()
+	defer fake.childSelectorMutex.Unlock()
+	fake.ChildSelectorStub = stub
+}
+
+func (fake *FakeSDKInvoker) ChildSelectorArgsForCall(i int) kratix.Resource {
+	fake.childSelectorMutex.RLock()
+	defer fake.childSelectorMutex.RUnlock()
+	argsForCall := fake.childSelectorArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSDKInvoker) ChildSelectorReturns(result1 labels.Selector) {
+	fake.childSelectorMutex.Lock()
+	defer fake.childSelectorMutex.Unlock()
+	fake.ChildSelectorStub = nil
+	fake.childSelectorReturns = struct {
+		result1 labels.Selector
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) ChildSelectorReturnsOnCall(i int, result1 labels.Selector) {
+	fake.childSelectorMutex.Lock()
+	defer fake.childSelectorMutex.Unlock()
+	fake.ChildSelectorStub = nil
+	if fake.childSelectorReturnsOnCall == nil {
+		fake.childSelectorReturnsOnCall = make(map[int]struct {
+			result1 labels.Selector
+		})
+	}
+	fake.childSelectorReturnsOnCall[i] = struct {
+		result1 labels.Selector
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) CurrentPipeline(arg1 kratix.Promise) (*v1alpha1.Pipeline, error) {
+	fake.currentPipelineMutex.Lock()
+	ret, specificReturn := fake.currentPipelineReturnsOnCall[len(fake.currentPipelineArgsForCall)]
+	fake.currentPipelineArgsForCall = append(fake.currentPipelineArgsForCall, struct {
+		arg1 kratix.Promise
+	}{arg1})
+	stub := fake.CurrentPipelineStub
+	fakeReturns := fake.currentPipelineReturns
+	fake.recordInvocation("CurrentPipeline", []interface{}{arg1})
+	fake.currentPipelineMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSDKInvoker) CurrentPipelineCallCount() int {
+	fake.currentPipelineMutex.RLock()
+	defer fake.currentPipelineMutex.RUnlock()
+	return len(fake.currentPipelineArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) CurrentPipelineCalls(stub func(kratix.Promise) (*v1alpha1.Pipeline, error)) {
+	fake.currentPipelineMutex.Lock()
+	defer fake.currentPipelineMutex.Unlock()
+	fake.CurrentPipelineStub = stub
+}
+
+func (fake *FakeSDKInvoker) CurrentPipelineArgsForCall(i int) kratix.Promise {
+	fake.currentPipelineMutex.RLock()
+	defer fake.currentPipelineMutex.RUnlock()
+	argsForCall := fake.currentPipelineArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSDKInvoker) CurrentPipelineReturns(result1 *v1alpha1.Pipeline, result2 error) {
+	fake.currentPipelineMutex.Lock()
+	defer fake.currentPipelineMutex.Unlock()
+	fake.CurrentPipelineStub = nil
+	fake.currentPipelineReturns = struct {
+		result1 *v1alpha1.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) CurrentPipelineReturnsOnCall(i int, result1 *v1alpha1.Pipeline, result2 error) {
+	fake.currentPipelineMutex.Lock()
+	defer fake.currentPipelineMutex.Unlock()
+	fake.CurrentPipelineStub = nil
+	if fake.currentPipelineReturnsOnCall == nil {
+		fake.currentPipelineReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Pipeline
+			result2 error
+		})
+	}
+	fake.currentPipelineReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) FetchPromise() (kratix.Promise, error) {
+	fake.fetchPromiseMutex.Lock()
+	ret, specificReturn := fake.fetchPromiseReturnsOnCall[len(fake.fetchPromiseArgsForCall)]
+	fake.fetchPromiseArgsForCall = append(fake.fetchPromiseArgsForCall, struct {
+	}{})
+	stub := fake.FetchPromiseStub
+	fakeReturns := fake.fetchPromiseReturns
+	fake.recordInvocation("FetchPromise", []interface{}{})
+	fake.fetchPromiseMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSDKInvoker) FetchPromiseCallCount() int {
+	fake.fetchPromiseMutex.RLock()
+	defer fake.fetchPromiseMutex.RUnlock()
+	return len(fake.fetchPromiseArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) FetchPromiseCalls(stub func() (kratix.Promise, error)) {
+	fake.fetchPromiseMutex.Lock()
+	defer fake.fetchPromiseMutex.Unlock()
+	fake.FetchPromiseStub = stub
+}
+
+func (fake *FakeSDKInvoker) FetchPromiseReturns(result1 kratix.Promise, result2 error) {
+	fake.fetchPromiseMutex.Lock()
+	defer fake.fetchPromiseMutex.Unlock()
+	fake.FetchPromiseStub = nil
+	fake.fetchPromiseReturns = struct {
+		result1 kratix.Promise
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) FetchPromiseReturnsOnCall(i int, result1 kratix.Promise, result2 error) {
+	fake.fetchPromiseMutex.Lock()
+	defer fake.fetchPromiseMutex.Unlock()
+	fake.FetchPromiseStub = nil
+	if fake.fetchPromiseReturnsOnCall == nil {
+		fake.fetchPromiseReturnsOnCall = make(map[int]struct {
+			result1 kratix.Promise
+			result2 error
+		})
+	}
+	fake.fetchPromiseReturnsOnCall[i] = struct {
+		result1 kratix.Promise
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) IsConfigureAction() bool {
+	fake.isConfigureActionMutex.Lock()
+	ret, specificReturn := fake.isConfigureActionReturnsOnCall[len(fake.isConfigureActionArgsForCall)]
+	fake.isConfigureActionArgsForCall = append(fake.isConfigureActionArgsForCall, struct {
+	}{})
+	stub := fake.IsConfigureActionStub
+	fakeReturns := fake.isConfigureActionReturns
+	fake.recordInvocation("IsConfigureAction", []interface{}{})
+	fake.isConfigureActionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) IsConfigureActionCallCount() int {
+	fake.isConfigureActionMutex.RLock()
+	defer fake.isConfigureActionMutex.RUnlock()
+	return len(fake.isConfigureActionArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) IsConfigureActionCalls(stub func() bool) {
+	fake.isConfigureActionMutex.Lock()
+	defer fake.isConfigureActionMutex.Unlock()
+	fake.IsConfigureActionStub = stub
+}
+
+func (fake *FakeSDKInvoker) IsConfigureActionReturns(result1 bool) {
+	fake.isConfigureActionMutex.Lock()
+	defer fake.isConfigureActionMutex.Unlock()
+	fake.IsConfigureActionStub = nil
+	fake.isConfigureActionReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) IsConfigureActionReturnsOnCall(i int, result1 bool) {
+	fake.isConfigureActionMutex.Lock()
+	defer fake.isConfigureActionMutex.Unlock()
+	fake.IsConfigureActionStub = nil
+	if fake.isConfigureActionReturnsOnCall == nil {
+		fake.isConfigureActionReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isConfigureActionReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) IsDeleteAction() bool {
+	fake.isDeleteActionMutex.Lock()
+	ret, specificReturn := fake.isDeleteActionReturnsOnCall[len(fake.isDeleteActionArgsForCall)]
+	fake.isDeleteActionArgsForCall = append(fake.isDeleteActionArgsForCall, struct {
+	}{})
+	stub := fake.IsDeleteActionStub
+	fakeReturns := fake.isDeleteActionReturns
+	fake.recordInvocation("IsDeleteAction", []interface{}{})
+	fake.isDeleteActionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) IsDeleteActionCallCount() int {
+	fake.isDeleteActionMutex.RLock()
+	defer fake.isDeleteActionMutex.RUnlock()
+	return len(fake.isDeleteActionArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) IsDeleteActionCalls(stub func() bool) {
+	fake.isDeleteActionMutex.Lock()
+	defer fake.isDeleteActionMutex.Unlock()
+	fake.IsDeleteActionStub = stub
+}
+
+func (fake *FakeSDKInvoker) IsDeleteActionReturns(result1 bool) {
+	fake.isDeleteActionMutex.Lock()
+	defer fake.isDeleteActionMutex.Unlock()
+	fake.IsDeleteActionStub = nil
+	fake.isDeleteActionReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) IsDeleteActionReturnsOnCall(i int, result1 bool) {
+	fake.isDeleteActionMutex.Lock()
+	defer fake.isDeleteActionMutex.Unlock()
+	fake.IsDeleteActionStub = nil
+	if fake.isDeleteActionReturnsOnCall == nil {
+		fake.isDeleteActionReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isDeleteActionReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) IsPromiseWorkflow() bool {
+	fake.isPromiseWorkflowMutex.Lock()
+	ret, specificReturn := fake.isPromiseWorkflowReturnsOnCall[len(fake.isPromiseWorkflowArgsForCall)]
+	fake.isPromiseWorkflowArgsForCall = append(fake.isPromiseWorkflowArgsForCall, struct {
+	}{})
+	stub := fake.IsPromiseWorkflowStub
+	fakeReturns := fake.isPromiseWorkflowReturns
+	fake.recordInvocation("IsPromiseWorkflow", []interface{}{})
+	fake.isPromiseWorkflowMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) IsPromiseWorkflowCallCount() int {
+	fake.isPromiseWorkflowMutex.RLock()
+	defer fake.isPromiseWorkflowMutex.RUnlock()
+	return len(fake.isPromiseWorkflowArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) IsPromiseWorkflowCalls(stub func() bool) {
+	fake.isPromiseWorkflowMutex.Lock()
+	defer fake.isPromiseWorkflowMutex.Unlock()
+	fake.IsPromiseWorkflowStub = stub
+}
+
+func (fake *FakeSDKInvoker) IsPromiseWorkflowReturns(result1 bool) {
+	fake.isPromiseWorkflowMutex.Lock()
+	defer fake.isPromiseWorkflowMutex.Unlock()
+	fake.IsPromiseWorkflowStub = nil
+	fake.isPromiseWorkflowReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) IsPromiseWorkflowReturnsOnCall(i int, result1 bool) {
+	fake.isPromiseWorkflowMutex.Lock()
+	defer fake.isPromiseWorkflowMutex.Unlock()
+	fake.IsPromiseWorkflowStub = nil
+	if fake.isPromiseWorkflowReturnsOnCall == nil {
+		fake.isPromiseWorkflowReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isPromiseWorkflowReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) IsResourceWorkflow() bool {
+	fake.isResourceWorkflowMutex.Lock()
+	ret, specificReturn := fake.isResourceWorkflowReturnsOnCall[len(fake.isResourceWorkflowArgsForCall)]
+	fake.isResourceWorkflowArgsForCall = append(fake.isResourceWorkflowArgsForCall, struct {
+	}{})
+	stub := fake.IsResourceWorkflowStub
+	fakeReturns := fake.isResourceWorkflowReturns
+	fake.recordInvocation("IsResourceWorkflow", []interface{}{})
+	fake.isResourceWorkflowMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) IsResourceWorkflowCallCount() int {
+	fake.isResourceWorkflowMutex.RLock()
+	defer fake.isResourceWorkflowMutex.RUnlock()
+	return len(fake.isResourceWorkflowArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) IsResourceWorkflowCalls(stub func() bool) {
+	fake.isResourceWorkflowMutex.Lock()
+	defer fake.isResourceWorkflowMutex.Unlock()
+	fake.IsResourceWorkflowStub = stub
+}
+
+func (fake *FakeSDKInvoker) IsResourceWorkflowReturns(result1 bool) {
+	fake.isResourceWorkflowMutex.Lock()
+	defer fake.isResourceWorkflowMutex.Unlock()
+	fake.IsResourceWorkflowStub = nil
+	fake.isResourceWorkflowReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) IsResourceWorkflowReturnsOnCall(i int, result1 bool) {
+	fake.isResourceWorkflowMutex.Lock()
+	defer fake.isResourceWorkflowMutex.Unlock()
+	fake.IsResourceWorkflowStub = nil
+	if fake.isResourceWorkflowReturnsOnCall == nil {
+		fake.isResourceWorkflowReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isResourceWorkflowReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) IsStale(arg1 kratix.Resource) (bool, error) {
+	fake.isStaleMutex.Lock()
+	ret, specificReturn := fake.isStaleReturnsOnCall[len(fake.isStaleArgsForCall)]
+	fake.isStaleArgsForCall = append(fake.isStaleArgsForCall, struct {
+		arg1 kratix.Resource
+	}{arg1})
+	stub := fake.IsStaleStub
+	fakeReturns := fake.isStaleReturns
+	fake.recordInvocation("IsStale", []interface{}{arg1})
+	fake.isStaleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSDKInvoker) IsStaleCallCount() int {
+	fake.isStaleMutex.RLock()
+	defer fake.isStaleMutex.RUnlock()
+	return len(fake.isStaleArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) IsStaleCalls(stub func(kratix.Resource) (bool, error)) {
+	fake.isStaleMutex.Lock()
+	defer fake.isStaleMutex.Unlock()
+	fake.IsStaleStub = stub
+}
+
+func (fake *FakeSDKInvoker) IsStaleArgsForCall(i int) kratix.Resource {
+	fake.isStaleMutex.RLock()
+	defer fake.isStaleMutex.RUnlock()
+	argsForCall := fake.isStaleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSDKInvoker) IsStaleReturns(result1 bool, result2 error) {
+	fake.isStaleMutex.Lock()
+	defer fake.isStaleMutex.Unlock()
+	fake.IsStaleStub = nil
+	fake.isStaleReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) IsStaleReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isStaleMutex.Lock()
+	defer fake.isStaleMutex.Unlock()
+	fake.IsStaleStub = nil
+	if fake.isStaleReturnsOnCall == nil {
+		fake.isStaleReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isStaleReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) PipelineName() string {
+	fake.pipelineNameMutex.Lock()
+	ret, specificReturn := fake.pipelineNameReturnsOnCall[len(fake.pipelineNameArgsForCall)]
+	fake.pipelineNameArgsForCall = append(fake.pipelineNameArgsForCall, struct {
+	}{})
+	stub := fake.PipelineNameStub
+	fakeReturns := fake.pipelineNameReturns
+	fake.recordInvocation("PipelineName", []interface{}{})
+	fake.pipelineNameMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) PipelineNameCallCount() int {
+	fake.pipelineNameMutex.RLock()
+	defer fake.pipelineNameMutex.RUnlock()
+	return len(fake.pipelineNameArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) PipelineNameCalls(stub func() string) {
+	fake.pipelineNameMutex.Lock()
+	defer fake.pipelineNameMutex.Unlock()
+	fake.PipelineNameStub = stub
+}
+
+func (fake *FakeSDKInvoker) PipelineNameReturns(result1 string) {
+	fake.pipelineNameMutex.Lock()
+	defer fake.pipelineNameMutex.Unlock()
+	fake.PipelineNameStub = nil
+	fake.pipelineNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) PipelineNameReturnsOnCall(i int, result1 string) {
+	fake.pipelineNameMutex.Lock()
+	defer fake.pipelineNameMutex.Unlock()
+	fake.PipelineNameStub = nil
+	if fake.pipelineNameReturnsOnCall == nil {
+		fake.pipelineNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pipelineNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) PromiseName() string {
+	fake.promiseNameMutex.Lock()
+	ret, specificReturn := fake.promiseNameReturnsOnCall[len(fake.promiseNameArgsForCall)]
+	fake.promiseNameArgsForCall = append(fake.promiseNameArgsForCall, struct {
+	}{})
+	stub := fake.PromiseNameStub
+	fakeReturns := fake.promiseNameReturns
+	fake.recordInvocation("PromiseName", []interface{}{})
+	fake.promiseNameMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) PromiseNameCallCount() int {
+	fake.promiseNameMutex.RLock()
+	defer fake.promiseNameMutex.RUnlock()
+	return len(fake.promiseNameArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) PromiseNameCalls(stub func() string) {
+	fake.promiseNameMutex.Lock()
+	defer fake.promiseNameMutex.Unlock()
+	fake.PromiseNameStub = stub
+}
+
+func (fake *FakeSDKInvoker) PromiseNameReturns(result1 string) {
+	fake.promiseNameMutex.Lock()
+	defer fake.promiseNameMutex.Unlock()
+	fake.PromiseNameStub = nil
+	fake.promiseNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) PromiseNameReturnsOnCall(i int, result1 string) {
+	fake.promiseNameMutex.Lock()
+	defer fake.promiseNameMutex.Unlock()
+	fake.PromiseNameStub = nil
+	if fake.promiseNameReturnsOnCall == nil {
+		fake.promiseNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.promiseNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) PublishResource(arg1 kratix.Resource) error {
+	fake.publishResourceMutex.Lock()
+	ret, specificReturn := fake.publishResourceReturnsOnCall[len(fake.publishResourceArgsForCall)]
+	fake.publishResourceArgsForCall = append(fake.publishResourceArgsForCall, struct {
+		arg1 kratix.Resource
+	}{arg1})
+	stub := fake.PublishResourceStub
+	fakeReturns := fake.publishResourceReturns
+	fake.recordInvocation("PublishResource", []interface{}{arg1})
+	fake.publishResourceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) PublishResourceCallCount() int {
+	fake.publishResourceMutex.RLock()
+	defer fake.publishResourceMutex.RUnlock()
+	return len(fake.publishResourceArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) PublishResourceCalls(stub func(kratix.Resource) error) {
+	fake.publishResourceMutex.Lock()
+	defer fake.publishResourceMutex.Unlock()
+	fake.PublishResourceStub = stub
+}
+
+func (fake *FakeSDKInvoker) PublishResourceArgsForCall(i int) kratix.Resource {
+	fake.publishResourceMutex.RLock()
+	defer fake.publishResourceMutex.RUnlock()
+	argsForCall := fake.publishResourceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSDKInvoker) PublishResourceReturns(result1 error) {
+	fake.publishResourceMutex.Lock()
+	defer fake.publishResourceMutex.Unlock()
+	fake.PublishResourceStub = nil
+	fake.publishResourceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) PublishResourceReturnsOnCall(i int, result1 error) {
+	fake.publishResourceMutex.Lock()
+	defer fake.publishResourceMutex.Unlock()
+	fake.PublishResourceStub = nil
+	if fake.publishResourceReturnsOnCall == nil {
+		fake.publishResourceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.publishResourceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) PublishStatus(arg1 kratix.Resource, arg2 kratix.Status) error {
+	fake.publishStatusMutex.Lock()
+	ret, specificReturn := fake.publishStatusReturnsOnCall[len(fake.publishStatusArgsForCall)]
+	fake.publishStatusArgsForCall = append(fake.publishStatusArgsForCall, struct {
+		arg1 kratix.Resource
+		arg2 kratix.Status
+	}{arg1, arg2})
+	stub := fake.PublishStatusStub
+	fakeReturns := fake.publishStatusReturns
+	fake.recordInvocation("PublishStatus", []interface{}{arg1, arg2})
+	fake.publishStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) PublishStatusCallCount() int {
+	fake.publishStatusMutex.RLock()
+	defer fake.publishStatusMutex.RUnlock()
+	return len(fake.publishStatusArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) PublishStatusCalls(stub func(kratix.Resource, kratix.Status) error) {
+	fake.publishStatusMutex.Lock()
+	defer fake.publishStatusMutex.Unlock()
+	fake.PublishStatusStub = stub
+}
+
+func (fake *FakeSDKInvoker) PublishStatusArgsForCall(i int) (kratix.Resource, kratix.Status) {
+	fake.publishStatusMutex.RLock()
+	defer fake.publishStatusMutex.RUnlock()
+	argsForCall := fake.publishStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSDKInvoker) PublishStatusReturns(result1 error) {
+	fake.publishStatusMutex.Lock()
+	defer fake.publishStatusMutex.Unlock()
+	fake.PublishStatusStub = nil
+	fake.publishStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) PublishStatusReturnsOnCall(i int, result1 error) {
+	fake.publishStatusMutex.Lock()
+	defer fake.publishStatusMutex.Unlock()
+	fake.PublishStatusStub = nil
+	if fake.publishStatusReturnsOnCall == nil {
+		fake.publishStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.publishStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) ReadDestinationSelectors() ([]kratix.DestinationSelector, error) {
+	fake.readDestinationSelectorsMutex.Lock()
+	ret, specificReturn := fake.readDestinationSelectorsReturnsOnCall[len(fake.readDestinationSelectorsArgsForCall)]
+	fake.readDestinationSelectorsArgsForCall = append(fake.readDestinationSelectorsArgsForCall, struct {
+	}{})
+	stub := fake.ReadDestinationSelectorsStub
+	fakeReturns := fake.readDestinationSelectorsReturns
+	fake.recordInvocation("ReadDestinationSelectors", []interface{}{})
+	fake.readDestinationSelectorsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSDKInvoker) ReadDestinationSelectorsCallCount() int {
+	fake.readDestinationSelectorsMutex.RLock()
+	defer fake.readDestinationSelectorsMutex.RUnlock()
+	return len(fake.readDestinationSelectorsArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) ReadDestinationSelectorsCalls(stub func() ([]kratix.DestinationSelector, error)) {
+	fake.readDestinationSelectorsMutex.Lock()
+	defer fake.readDestinationSelectorsMutex.Unlock()
+	fake.ReadDestinationSelectorsStub = stub
+}
+
+func (fake *FakeSDKInvoker) ReadDestinationSelectorsReturns(result1 []kratix.DestinationSelector, result2 error) {
+	fake.readDestinationSelectorsMutex.Lock()
+	defer fake.readDestinationSelectorsMutex.Unlock()
+	fake.ReadDestinationSelectorsStub = nil
+	fake.readDestinationSelectorsReturns = struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) ReadDestinationSelectorsReturnsOnCall(i int, result1 []kratix.DestinationSelector, result2 error) {
+	fake.readDestinationSelectorsMutex.Lock()
+	defer fake.readDestinationSelectorsMutex.Unlock()
+	fake.ReadDestinationSelectorsStub = nil
+	if fake.readDestinationSelectorsReturnsOnCall == nil {
+		fake.readDestinationSelectorsReturnsOnCall = make(map[int]struct {
+			result1 []kratix.DestinationSelector
+			result2 error
+		})
+	}
+	fake.readDestinationSelectorsReturnsOnCall[i] = struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) ReadEffectiveDestinationSelectors(arg1 kratix.Promise) ([]kratix.DestinationSelector, error) {
+	fake.readEffectiveDestinationSelectorsMutex.Lock()
+	ret, specificReturn := fake.readEffectiveDestinationSelectorsReturnsOnCall[len(fake.readEffectiveDestinationSelectorsArgsForCall)]
+	fake.readEffectiveDestinationSelectorsArgsForCall = append(fake.readEffectiveDestinationSelectorsArgsForCall, struct {
+		arg1 kratix.Promise
+	}{arg1})
+	stub := fake.ReadEffectiveDestinationSelectorsStub
+	fakeReturns := fake.readEffectiveDestinationSelectorsReturns
+	fake.recordInvocation("ReadEffectiveDestinationSelectors", []interface{}{arg1})
+	fake.readEffectiveDestinationSelectorsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSDKInvoker) ReadEffectiveDestinationSelectorsCallCount() int {
+	fake.readEffectiveDestinationSelectorsMutex.RLock()
+	defer fake.readEffectiveDestinationSelectorsMutex.RUnlock()
+	return len(fake.readEffectiveDestinationSelectorsArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) ReadEffectiveDestinationSelectorsCalls(stub func(kratix.Promise) ([]kratix.DestinationSelector, error)) {
+	fake.readEffectiveDestinationSelectorsMutex.Lock()
+	defer fake.readEffectiveDestinationSelectorsMutex.Unlock()
+	fake.ReadEffectiveDestinationSelectorsStub = stub
+}
+
+func (fake *FakeSDKInvoker) ReadEffectiveDestinationSelectorsArgsForCall(i int) kratix.Promise {
+	fake.readEffectiveDestinationSelectorsMutex.RLock()
+	defer fake.readEffectiveDestinationSelectorsMutex.RUnlock()
+	argsForCall := fake.readEffectiveDestinationSelectorsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSDKInvoker) ReadEffectiveDestinationSelectorsReturns(result1 []kratix.DestinationSelector, result2 error) {
+	fake.readEffectiveDestinationSelectorsMutex.Lock()
+	defer fake.readEffectiveDestinationSelectorsMutex.Unlock()
+	fake.ReadEffectiveDestinationSelectorsStub = nil
+	fake.readEffectiveDestinationSelectorsReturns = struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) ReadEffectiveDestinationSelectorsReturnsOnCall(i int, result1 []kratix.DestinationSelector, result2 error) {
+	fake.readEffectiveDestinationSelectorsMutex.Lock()
+	defer fake.readEffectiveDestinationSelectorsMutex.Unlock()
+	fake.ReadEffectiveDestinationSelectorsStub = nil
+	if fake.readEffectiveDestinationSelectorsReturnsOnCall == nil {
+		fake.readEffectiveDestinationSelectorsReturnsOnCall = make(map[int]struct {
+			result1 []kratix.DestinationSelector
+			result2 error
+		})
+	}
+	fake.readEffectiveDestinationSelectorsReturnsOnCall[i] = struct {
+		result1 []kratix.DestinationSelector
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) ReadPromiseInput() (kratix.Promise, error) {
+	fake.readPromiseInputMutex.Lock()
+	ret, specificReturn := fake.readPromiseInputReturnsOnCall[len(fake.readPromiseInputArgsForCall)]
+	fake.readPromiseInputArgsForCall = append(fake.readPromiseInputArgsForCall, struct {
+	}{})
+	stub := fake.ReadPromiseInputStub
+	fakeReturns := fake.readPromiseInputReturns
+	fake.recordInvocation("ReadPromiseInput", []interface{}{})
+	fake.readPromiseInputMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSDKInvoker) ReadPromiseInputCallCount() int {
+	fake.readPromiseInputMutex.RLock()
+	defer fake.readPromiseInputMutex.RUnlock()
+	return len(fake.readPromiseInputArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) ReadPromiseInputCalls(stub func() (kratix.Promise, error)) {
+	fake.readPromiseInputMutex.Lock()
+	defer fake.readPromiseInputMutex.Unlock()
+	fake.ReadPromiseInputStub = stub
+}
+
+func (fake *FakeSDKInvoker) ReadPromiseInputReturns(result1 kratix.Promise, result2 error) {
+	fake.readPromiseInputMutex.Lock()
+	defer fake.readPromiseInputMutex.Unlock()
+	fake.ReadPromiseInputStub = nil
+	fake.readPromiseInputReturns = struct {
+		result1 kratix.Promise
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) ReadPromiseInputReturnsOnCall(i int, result1 kratix.Promise, result2 error) {
+	fake.readPromiseInputMutex.Lock()
+	defer fake.readPromiseInputMutex.Unlock()
+	fake.ReadPromiseInputStub = nil
+	if fake.readPromiseInputReturnsOnCall == nil {
+		fake.readPromiseInputReturnsOnCall = make(map[int]struct {
+			result1 kratix.Promise
+			result2 error
+		})
+	}
+	fake.readPromiseInputReturnsOnCall[i] = struct {
+		result1 kratix.Promise
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) ReadResourceInput() (kratix.Resource, error) {
+	fake.readResourceInputMutex.Lock()
+	ret, specificReturn := fake.readResourceInputReturnsOnCall[len(fake.readResourceInputArgsForCall)]
+	fake.readResourceInputArgsForCall = append(fake.readResourceInputArgsForCall, struct {
+	}{})
+	stub := fake.ReadResourceInputStub
+	fakeReturns := fake.readResourceInputReturns
+	fake.recordInvocation("ReadResourceInput", []interface{}{})
+	fake.readResourceInputMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSDKInvoker) ReadResourceInputCallCount() int {
+	fake.readResourceInputMutex.RLock()
+	defer fake.readResourceInputMutex.RUnlock()
+	return len(fake.readResourceInputArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) ReadResourceInputCalls(stub func() (kratix.Resource, error)) {
+	fake.readResourceInputMutex.Lock()
+	defer fake.readResourceInputMutex.Unlock()
+	fake.ReadResourceInputStub = stub
+}
+
+func (fake *FakeSDKInvoker) ReadResourceInputReturns(result1 kratix.Resource, result2 error) {
+	fake.readResourceInputMutex.Lock()
+	defer fake.readResourceInputMutex.Unlock()
+	fake.ReadResourceInputStub = nil
+	fake.readResourceInputReturns = struct {
+		result1 kratix.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) ReadResourceInputReturnsOnCall(i int, result1 kratix.Resource, result2 error) {
+	fake.readResourceInputMutex.Lock()
+	defer fake.readResourceInputMutex.Unlock()
+	fake.ReadResourceInputStub = nil
+	if fake.readResourceInputReturnsOnCall == nil {
+		fake.readResourceInputReturnsOnCall = make(map[int]struct {
+			result1 kratix.Resource
+			result2 error
+		})
+	}
+	fake.readResourceInputReturnsOnCall[i] = struct {
+		result1 kratix.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) ReadStatus() (kratix.Status, error) {
+	fake.readStatusMutex.Lock()
+	ret, specificReturn := fake.readStatusReturnsOnCall[len(fake.readStatusArgsForCall)]
+	fake.readStatusArgsForCall = append(fake.readStatusArgsForCall, struct {
+	}{})
+	stub := fake.ReadStatusStub
+	fakeReturns := fake.readStatusReturns
+	fake.recordInvocation("ReadStatus", []interface{}{})
+	fake.readStatusMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSDKInvoker) ReadStatusCallCount() int {
+	fake.readStatusMutex.RLock()
+	defer fake.readStatusMutex.RUnlock()
+	return len(fake.readStatusArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) ReadStatusCalls(stub func() (kratix.Status, error)) {
+	fake.readStatusMutex.Lock()
+	defer fake.readStatusMutex.Unlock()
+	fake.ReadStatusStub = stub
+}
+
+func (fake *FakeSDKInvoker) ReadStatusReturns(result1 kratix.Status, result2 error) {
+	fake.readStatusMutex.Lock()
+	defer fake.readStatusMutex.Unlock()
+	fake.ReadStatusStub = nil
+	fake.readStatusReturns = struct {
+		result1 kratix.Status
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) ReadStatusReturnsOnCall(i int, result1 kratix.Status, result2 error) {
+	fake.readStatusMutex.Lock()
+	defer fake.readStatusMutex.Unlock()
+	fake.ReadStatusStub = nil
+	if fake.readStatusReturnsOnCall == nil {
+		fake.readStatusReturnsOnCall = make(map[int]struct {
+			result1 kratix.Status
+			result2 error
+		})
+	}
+	fake.readStatusReturnsOnCall[i] = struct {
+		result1 kratix.Status
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSDKInvoker) WorkflowAction() string {
+	fake.workflowActionMutex.Lock()
+	ret, specificReturn := fake.workflowActionReturnsOnCall[len(fake.workflowActionArgsForCall)]
+	fake.workflowActionArgsForCall = append(fake.workflowActionArgsForCall, struct {
+	}{})
+	stub := fake.WorkflowActionStub
+	fakeReturns := fake.workflowActionReturns
+	fake.recordInvocation("WorkflowAction", []interface{}{})
+	fake.workflowActionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) WorkflowActionCallCount() int {
+	fake.workflowActionMutex.RLock()
+	defer fake.workflowActionMutex.RUnlock()
+	return len(fake.workflowActionArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) WorkflowActionCalls(stub func() string) {
+	fake.workflowActionMutex.Lock()
+	defer fake.workflowActionMutex.Unlock()
+	fake.WorkflowActionStub = stub
+}
+
+func (fake *FakeSDKInvoker) WorkflowActionReturns(result1 string) {
+	fake.workflowActionMutex.Lock()
+	defer fake.workflowActionMutex.Unlock()
+	fake.WorkflowActionStub = nil
+	fake.workflowActionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WorkflowActionReturnsOnCall(i int, result1 string) {
+	fake.workflowActionMutex.Lock()
+	defer fake.workflowActionMutex.Unlock()
+	fake.WorkflowActionStub = nil
+	if fake.workflowActionReturnsOnCall == nil {
+		fake.workflowActionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.workflowActionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WorkflowType() string {
+	fake.workflowTypeMutex.Lock()
+	ret, specificReturn := fake.workflowTypeReturnsOnCall[len(fake.workflowTypeArgsForCall)]
+	fake.workflowTypeArgsForCall = append(fake.workflowTypeArgsForCall, struct {
+	}{})
+	stub := fake.WorkflowTypeStub
+	fakeReturns := fake.workflowTypeReturns
+	fake.recordInvocation("WorkflowType", []interface{}{})
+	fake.workflowTypeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) WorkflowTypeCallCount() int {
+	fake.workflowTypeMutex.RLock()
+	defer fake.workflowTypeMutex.RUnlock()
+	return len(fake.workflowTypeArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) WorkflowTypeCalls(stub func() string) {
+	fake.workflowTypeMutex.Lock()
+	defer fake.workflowTypeMutex.Unlock()
+	fake.WorkflowTypeStub = stub
+}
+
+func (fake *FakeSDKInvoker) WorkflowTypeReturns(result1 string) {
+	fake.workflowTypeMutex.Lock()
+	defer fake.workflowTypeMutex.Unlock()
+	fake.WorkflowTypeStub = nil
+	fake.workflowTypeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WorkflowTypeReturnsOnCall(i int, result1 string) {
+	fake.workflowTypeMutex.Lock()
+	defer fake.workflowTypeMutex.Unlock()
+	fake.WorkflowTypeStub = nil
+	if fake.workflowTypeReturnsOnCall == nil {
+		fake.workflowTypeReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.workflowTypeReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WriteDependencies(arg1 kratix.Promise, arg2 string, arg3 ...kratix.ObjectTransformer) error {
+	fake.writeDependenciesMutex.Lock()
+	ret, specificReturn := fake.writeDependenciesReturnsOnCall[len(fake.writeDependenciesArgsForCall)]
+	fake.writeDependenciesArgsForCall = append(fake.writeDependenciesArgsForCall, struct {
+		arg1 kratix.Promise
+		arg2 string
+		arg3 []kratix.ObjectTransformer
+	}{arg1, arg2, arg3})
+	stub := fake.WriteDependenciesStub
+	fakeReturns := fake.writeDependenciesReturns
+	fake.recordInvocation("WriteDependencies", []interface{}{arg1, arg2, arg3})
+	fake.writeDependenciesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) WriteDependenciesCallCount() int {
+	fake.writeDependenciesMutex.RLock()
+	defer fake.writeDependenciesMutex.RUnlock()
+	return len(fake.writeDependenciesArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) WriteDependenciesCalls(stub func(kratix.Promise, string, ...kratix.ObjectTransformer) error) {
+	fake.writeDependenciesMutex.Lock()
+	defer fake.writeDependenciesMutex.Unlock()
+	fake.WriteDependenciesStub = stub
+}
+
+func (fake *FakeSDKInvoker) WriteDependenciesArgsForCall(i int) (kratix.Promise, string, []kratix.ObjectTransformer) {
+	fake.writeDependenciesMutex.RLock()
+	defer fake.writeDependenciesMutex.RUnlock()
+	argsForCall := fake.writeDependenciesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSDKInvoker) WriteDependenciesReturns(result1 error) {
+	fake.writeDependenciesMutex.Lock()
+	defer fake.writeDependenciesMutex.Unlock()
+	fake.WriteDependenciesStub = nil
+	fake.writeDependenciesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WriteDependenciesReturnsOnCall(i int, result1 error) {
+	fake.writeDependenciesMutex.Lock()
+	defer fake.writeDependenciesMutex.Unlock()
+	fake.WriteDependenciesStub = nil
+	if fake.writeDependenciesReturnsOnCall == nil {
+		fake.writeDependenciesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeDependenciesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WriteDestinationSelectors(arg1 []kratix.DestinationSelector) error {
+	var arg1Copy []kratix.DestinationSelector
+	if arg1 != nil {
+		arg1Copy = make([]kratix.DestinationSelector, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.writeDestinationSelectorsMutex.Lock()
+	ret, specificReturn := fake.writeDestinationSelectorsReturnsOnCall[len(fake.writeDestinationSelectorsArgsForCall)]
+	fake.writeDestinationSelectorsArgsForCall = append(fake.writeDestinationSelectorsArgsForCall, struct {
+		arg1 []kratix.DestinationSelector
+	}{arg1Copy})
+	stub := fake.WriteDestinationSelectorsStub
+	fakeReturns := fake.writeDestinationSelectorsReturns
+	fake.recordInvocation("WriteDestinationSelectors", []interface{}{arg1Copy})
+	fake.writeDestinationSelectorsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) WriteDestinationSelectorsCallCount() int {
+	fake.writeDestinationSelectorsMutex.RLock()
+	defer fake.writeDestinationSelectorsMutex.RUnlock()
+	return len(fake.writeDestinationSelectorsArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) WriteDestinationSelectorsCalls(stub func([]kratix.DestinationSelector) error) {
+	fake.writeDestinationSelectorsMutex.Lock()
+	defer fake.writeDestinationSelectorsMutex.Unlock()
+	fake.WriteDestinationSelectorsStub = stub
+}
+
+func (fake *FakeSDKInvoker) WriteDestinationSelectorsArgsForCall(i int) []kratix.DestinationSelector {
+	fake.writeDestinationSelectorsMutex.RLock()
+	defer fake.writeDestinationSelectorsMutex.RUnlock()
+	argsForCall := fake.writeDestinationSelectorsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSDKInvoker) WriteDestinationSelectorsReturns(result1 error) {
+	fake.writeDestinationSelectorsMutex.Lock()
+	defer fake.writeDestinationSelectorsMutex.Unlock()
+	fake.WriteDestinationSelectorsStub = nil
+	fake.writeDestinationSelectorsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WriteDestinationSelectorsReturnsOnCall(i int, result1 error) {
+	fake.writeDestinationSelectorsMutex.Lock()
+	defer fake.writeDestinationSelectorsMutex.Unlock()
+	fake.WriteDestinationSelectorsStub = nil
+	if fake.writeDestinationSelectorsReturnsOnCall == nil {
+		fake.writeDestinationSelectorsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeDestinationSelectorsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WriteObjects(arg1 string, arg2 []*unstructured.Unstructured, arg3 ...kratix.ObjectTransformer) error {
+	var arg2Copy []*unstructured.Unstructured
+	if arg2 != nil {
+		arg2Copy = make([]*unstructured.Unstructured, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.writeObjectsMutex.Lock()
+	ret, specificReturn := fake.writeObjectsReturnsOnCall[len(fake.writeObjectsArgsForCall)]
+	fake.writeObjectsArgsForCall = append(fake.writeObjectsArgsForCall, struct {
+		arg1 string
+		arg2 []*unstructured.Unstructured
+		arg3 []kratix.ObjectTransformer
+	}{arg1, arg2Copy, arg3})
+	stub := fake.WriteObjectsStub
+	fakeReturns := fake.writeObjectsReturns
+	fake.recordInvocation("WriteObjects", []interface{}{arg1, arg2Copy, arg3})
+	fake.writeObjectsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) WriteObjectsCallCount() int {
+	fake.writeObjectsMutex.RLock()
+	defer fake.writeObjectsMutex.RUnlock()
+	return len(fake.writeObjectsArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) WriteObjectsCalls(stub func(string, []*unstructured.Unstructured, ...kratix.ObjectTransformer) error) {
+	fake.writeObjectsMutex.Lock()
+	defer fake.writeObjectsMutex.Unlock()
+	fake.WriteObjectsStub = stub
+}
+
+func (fake *FakeSDKInvoker) WriteObjectsArgsForCall(i int) (string, []*unstructured.Unstructured, []kratix.ObjectTransformer) {
+	fake.writeObjectsMutex.RLock()
+	defer fake.writeObjectsMutex.RUnlock()
+	argsForCall := fake.writeObjectsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSDKInvoker) WriteObjectsReturns(result1 error) {
+	fake.writeObjectsMutex.Lock()
+	defer fake.writeObjectsMutex.Unlock()
+	fake.WriteObjectsStub = nil
+	fake.writeObjectsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WriteObjectsReturnsOnCall(i int, result1 error) {
+	fake.writeObjectsMutex.Lock()
+	defer fake.writeObjectsMutex.Unlock()
+	fake.WriteObjectsStub = nil
+	if fake.writeObjectsReturnsOnCall == nil {
+		fake.writeObjectsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeObjectsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WriteOutput(arg1 string, arg2 []byte) error {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.writeOutputMutex.Lock()
+	ret, specificReturn := fake.writeOutputReturnsOnCall[len(fake.writeOutputArgsForCall)]
+	fake.writeOutputArgsForCall = append(fake.writeOutputArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+	}{arg1, arg2Copy})
+	stub := fake.WriteOutputStub
+	fakeReturns := fake.writeOutputReturns
+	fake.recordInvocation("WriteOutput", []interface{}{arg1, arg2Copy})
+	fake.writeOutputMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) WriteOutputCallCount() int {
+	fake.writeOutputMutex.RLock()
+	defer fake.writeOutputMutex.RUnlock()
+	return len(fake.writeOutputArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) WriteOutputCalls(stub func(string, []byte) error) {
+	fake.writeOutputMutex.Lock()
+	defer fake.writeOutputMutex.Unlock()
+	fake.WriteOutputStub = stub
+}
+
+func (fake *FakeSDKInvoker) WriteOutputArgsForCall(i int) (string, []byte) {
+	fake.writeOutputMutex.RLock()
+	defer fake.writeOutputMutex.RUnlock()
+	argsForCall := fake.writeOutputArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSDKInvoker) WriteOutputReturns(result1 error) {
+	fake.writeOutputMutex.Lock()
+	defer fake.writeOutputMutex.Unlock()
+	fake.WriteOutputStub = nil
+	fake.writeOutputReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WriteOutputReturnsOnCall(i int, result1 error) {
+	fake.writeOutputMutex.Lock()
+	defer fake.writeOutputMutex.Unlock()
+	fake.WriteOutputStub = nil
+	if fake.writeOutputReturnsOnCall == nil {
+		fake.writeOutputReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeOutputReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WriteStatus(arg1 kratix.Status) error {
+	fake.writeStatusMutex.Lock()
+	ret, specificReturn := fake.writeStatusReturnsOnCall[len(fake.writeStatusArgsForCall)]
+	fake.writeStatusArgsForCall = append(fake.writeStatusArgsForCall, struct {
+		arg1 kratix.Status
+	}{arg1})
+	stub := fake.WriteStatusStub
+	fakeReturns := fake.writeStatusReturns
+	fake.recordInvocation("WriteStatus", []interface{}{arg1})
+	fake.writeStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) WriteStatusCallCount() int {
+	fake.writeStatusMutex.RLock()
+	defer fake.writeStatusMutex.RUnlock()
+	return len(fake.writeStatusArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) WriteStatusCalls(stub func(kratix.Status) error) {
+	fake.writeStatusMutex.Lock()
+	defer fake.writeStatusMutex.Unlock()
+	fake.WriteStatusStub = stub
+}
+
+func (fake *FakeSDKInvoker) WriteStatusArgsForCall(i int) kratix.Status {
+	fake.writeStatusMutex.RLock()
+	defer fake.writeStatusMutex.RUnlock()
+	argsForCall := fake.writeStatusArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSDKInvoker) WriteStatusReturns(result1 error) {
+	fake.writeStatusMutex.Lock()
+	defer fake.writeStatusMutex.Unlock()
+	fake.WriteStatusStub = nil
+	fake.writeStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WriteStatusReturnsOnCall(i int, result1 error) {
+	fake.writeStatusMutex.Lock()
+	defer fake.writeStatusMutex.Unlock()
+	fake.WriteStatusStub = nil
+	if fake.writeStatusReturnsOnCall == nil {
+		fake.writeStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WriteSubResourceRequests(arg1 ...*unstructured.Unstructured) error {
+	fake.writeSubResourceRequestsMutex.Lock()
+	ret, specificReturn := fake.writeSubResourceRequestsReturnsOnCall[len(fake.writeSubResourceRequestsArgsForCall)]
+	fake.writeSubResourceRequestsArgsForCall = append(fake.writeSubResourceRequestsArgsForCall, struct {
+		arg1 []*unstructured.Unstructured
+	}{arg1})
+	stub := fake.WriteSubResourceRequestsStub
+	fakeReturns := fake.writeSubResourceRequestsReturns
+	fake.recordInvocation("WriteSubResourceRequests", []interface{}{arg1})
+	fake.writeSubResourceRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSDKInvoker) WriteSubResourceRequestsCallCount() int {
+	fake.writeSubResourceRequestsMutex.RLock()
+	defer fake.writeSubResourceRequestsMutex.RUnlock()
+	return len(fake.writeSubResourceRequestsArgsForCall)
+}
+
+func (fake *FakeSDKInvoker) WriteSubResourceRequestsCalls(stub func(...*unstructured.Unstructured) error) {
+	fake.writeSubResourceRequestsMutex.Lock()
+	defer fake.writeSubResourceRequestsMutex.Unlock()
+	fake.WriteSubResourceRequestsStub = stub
+}
+
+func (fake *FakeSDKInvoker) WriteSubResourceRequestsArgsForCall(i int) []*unstructured.Unstructured {
+	fake.writeSubResourceRequestsMutex.RLock()
+	defer fake.writeSubResourceRequestsMutex.RUnlock()
+	argsForCall := fake.writeSubResourceRequestsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSDKInvoker) WriteSubResourceRequestsReturns(result1 error) {
+	fake.writeSubResourceRequestsMutex.Lock()
+	defer fake.writeSubResourceRequestsMutex.Unlock()
+	fake.WriteSubResourceRequestsStub = nil
+	fake.writeSubResourceRequestsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) WriteSubResourceRequestsReturnsOnCall(i int, result1 error) {
+	fake.writeSubResourceRequestsMutex.Lock()
+	defer fake.writeSubResourceRequestsMutex.Unlock()
+	fake.WriteSubResourceRequestsStub = nil
+	if fake.writeSubResourceRequestsReturnsOnCall == nil {
+		fake.writeSubResourceRequestsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeSubResourceRequestsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSDKInvoker) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSDKInvoker) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ kratix.SDKInvoker = new(FakeSDKInvoker)
@@ -0,0 +1,260 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package kratixgofakes
+
+import (
+	"sync"
+
+	kratix "github.com/syntasso/kratix-go"
+)
+
+type FakeStatusPublisher struct {
+	IsStaleStub        func(kratix.Resource) (bool, error)
+	isStaleMutex       sync.RWMutex
+	isStaleArgsForCall []struct {
+		arg1 kratix.Resource
+	}
+	isStaleReturns struct {
+		result1 bool
+		result2 error
+	}
+	isStaleReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	PublishResourceStub        func(kratix.Resource) error
+	publishResourceMutex       sync.RWMutex
+	publishResourceArgsForCall []struct {
+		arg1 kratix.Resource
+	}
+	publishResourceReturns struct {
+		result1 error
+	}
+	publishResourceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PublishStatusStub        func(kratix.Resource, kratix.Status) error
+	publishStatusMutex       sync.RWMutex
+	publishStatusArgsForCall []struct {
+		arg1 kratix.Resource
+		arg2 kratix.Status
+	}
+	publishStatusReturns struct {
+		result1 error
+	}
+	publishStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStatusPublisher) IsStale(arg1 kratix.Resource) (bool, error) {
+	fake.isStaleMutex.Lock()
+	ret, specificReturn := fake.isStaleReturnsOnCall[len(fake.isStaleArgsForCall)]
+	fake.isStaleArgsForCall = append(fake.isStaleArgsForCall, struct {
+		arg1 kratix.Resource
+	}{arg1})
+	stub := fake.IsStaleStub
+	fakeReturns := fake.isStaleReturns
+	fake.recordInvocation("IsStale", []interface{}{arg1})
+	fake.isStaleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStatusPublisher) IsStaleCallCount() int {
+	fake.isStaleMutex.RLock()
+	defer fake.isStaleMutex.RUnlock()
+	return len(fake.isStaleArgsForCall)
+}
+
+func (fake *FakeStatusPublisher) IsStaleCalls(stub func(kratix.Resource) (bool, error)) {
+	fake.isStaleMutex.Lock()
+	defer fake.isStaleMutex.Unlock()
+	fake.IsStaleStub = stub
+}
+
+func (fake *FakeStatusPublisher) IsStaleArgsForCall(i int) kratix.Resource {
+	fake.isStaleMutex.RLock()
+	defer fake.isStaleMutex.RUnlock()
+	argsForCall := fake.isStaleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStatusPublisher) IsStaleReturns(result1 bool, result2 error) {
+	fake.isStaleMutex.Lock()
+	defer fake.isStaleMutex.Unlock()
+	fake.IsStaleStub = nil
+	fake.isStaleReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStatusPublisher) IsStaleReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isStaleMutex.Lock()
+	defer fake.isStaleMutex.Unlock()
+	fake.IsStaleStub = nil
+	if fake.isStaleReturnsOnCall == nil {
+		fake.isStaleReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isStaleReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStatusPublisher) PublishResource(arg1 kratix.Resource) error {
+	fake.publishResourceMutex.Lock()
+	ret, specificReturn := fake.publishResourceReturnsOnCall[len(fake.publishResourceArgsForCall)]
+	fake.publishResourceArgsForCall = append(fake.publishResourceArgsForCall, struct {
+		arg1 kratix.Resource
+	}{arg1})
+	stub := fake.PublishResourceStub
+	fakeReturns := fake.publishResourceReturns
+	fake.recordInvocation("PublishResource", []interface{}{arg1})
+	fake.publishResourceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStatusPublisher) PublishResourceCallCount() int {
+	fake.publishResourceMutex.RLock()
+	defer fake.publishResourceMutex.RUnlock()
+	return len(fake.publishResourceArgsForCall)
+}
+
+func (fake *FakeStatusPublisher) PublishResourceCalls(stub func(kratix.Resource) error) {
+	fake.publishResourceMutex.Lock()
+	defer fake.publishResourceMutex.Unlock()
+	fake.PublishResourceStub = stub
+}
+
+func (fake *FakeStatusPublisher) PublishResourceArgsForCall(i int) kratix.Resource {
+	fake.publishResourceMutex.RLock()
+	defer fake.publishResourceMutex.RUnlock()
+	argsForCall := fake.publishResourceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStatusPublisher) PublishResourceReturns(result1 error) {
+	fake.publishResourceMutex.Lock()
+	defer fake.publishResourceMutex.Unlock()
+	fake.PublishResourceStub = nil
+	fake.publishResourceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStatusPublisher) PublishResourceReturnsOnCall(i int, result1 error) {
+	fake.publishResourceMutex.Lock()
+	defer fake.publishResourceMutex.Unlock()
+	fake.PublishResourceStub = nil
+	if fake.publishResourceReturnsOnCall == nil {
+		fake.publishResourceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.publishResourceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStatusPublisher) PublishStatus(arg1 kratix.Resource, arg2 kratix.Status) error {
+	fake.publishStatusMutex.Lock()
+	ret, specificReturn := fake.publishStatusReturnsOnCall[len(fake.publishStatusArgsForCall)]
+	fake.publishStatusArgsForCall = append(fake.publishStatusArgsForCall, struct {
+		arg1 kratix.Resource
+		arg2 kratix.Status
+	}{arg1, arg2})
+	stub := fake.PublishStatusStub
+	fakeReturns := fake.publishStatusReturns
+	fake.recordInvocation("PublishStatus", []interface{}{arg1, arg2})
+	fake.publishStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStatusPublisher) PublishStatusCallCount() int {
+	fake.publishStatusMutex.RLock()
+	defer fake.publishStatusMutex.RUnlock()
+	return len(fake.publishStatusArgsForCall)
+}
+
+func (fake *FakeStatusPublisher) PublishStatusCalls(stub func(kratix.Resource, kratix.Status) error) {
+	fake.publishStatusMutex.Lock()
+	defer fake.publishStatusMutex.Unlock()
+	fake.PublishStatusStub = stub
+}
+
+func (fake *FakeStatusPublisher) PublishStatusArgsForCall(i int) (kratix.Resource, kratix.Status) {
+	fake.publishStatusMutex.RLock()
+	defer fake.publishStatusMutex.RUnlock()
+	argsForCall := fake.publishStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStatusPublisher) PublishStatusReturns(result1 error) {
+	fake.publishStatusMutex.Lock()
+	defer fake.publishStatusMutex.Unlock()
+	fake.PublishStatusStub = nil
+	fake.publishStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStatusPublisher) PublishStatusReturnsOnCall(i int, result1 error) {
+	fake.publishStatusMutex.Lock()
+	defer fake.publishStatusMutex.Unlock()
+	fake.PublishStatusStub = nil
+	if fake.publishStatusReturnsOnCall == nil {
+		fake.publishStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.publishStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStatusPublisher) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStatusPublisher) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ kratix.StatusPublisher = new(FakeStatusPublisher)
@@ -0,0 +1,619 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package kratixgofakes
+
+import (
+	"sync"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix/api/v1alpha1"
+)
+
+type FakeWorkflowEnvironment struct {
+	CurrentPipelineStub        func(kratix.Promise) (*v1alpha1.Pipeline, error)
+	currentPipelineMutex       sync.RWMutex
+	currentPipelineArgsForCall []struct {
+		arg1 kratix.Promise
+	}
+	currentPipelineReturns struct {
+		result1 *v1alpha1.Pipeline
+		result2 error
+	}
+	currentPipelineReturnsOnCall map[int]struct {
+		result1 *v1alpha1.Pipeline
+		result2 error
+	}
+	IsConfigureActionStub        func() bool
+	isConfigureActionMutex       sync.RWMutex
+	isConfigureActionArgsForCall []struct {
+	}
+	isConfigureActionReturns struct {
+		result1 bool
+	}
+	isConfigureActionReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	IsDeleteActionStub        func() bool
+	isDeleteActionMutex       sync.RWMutex
+	isDeleteActionArgsForCall []struct {
+	}
+	isDeleteActionReturns struct {
+		result1 bool
+	}
+	isDeleteActionReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	IsPromiseWorkflowStub        func() bool
+	isPromiseWorkflowMutex       sync.RWMutex
+	isPromiseWorkflowArgsForCall []struct {
+	}
+	isPromiseWorkflowReturns struct {
+		result1 bool
+	}
+	isPromiseWorkflowReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	IsResourceWorkflowStub        func() bool
+	isResourceWorkflowMutex       sync.RWMutex
+	isResourceWorkflowArgsForCall []struct {
+	}
+	isResourceWorkflowReturns struct {
+		result1 bool
+	}
+	isResourceWorkflowReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	PipelineNameStub        func() string
+	pipelineNameMutex       sync.RWMutex
+	pipelineNameArgsForCall []struct {
+	}
+	pipelineNameReturns struct {
+		result1 string
+	}
+	pipelineNameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PromiseNameStub        func() string
+	promiseNameMutex       sync.RWMutex
+	promiseNameArgsForCall []struct {
+	}
+	promiseNameReturns struct {
+		result1 string
+	}
+	promiseNameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	WorkflowActionStub        func() string
+	workflowActionMutex       sync.RWMutex
+	workflowActionArgsForCall []struct {
+	}
+	workflowActionReturns struct {
+		result1 string
+	}
+	workflowActionReturnsOnCall map[int]struct {
+		result1 string
+	}
+	WorkflowTypeStub        func() string
+	workflowTypeMutex       sync.RWMutex
+	workflowTypeArgsForCall []struct {
+	}
+	workflowTypeReturns struct {
+		result1 string
+	}
+	workflowTypeReturnsOnCall map[int]struct {
+		result1 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWorkflowEnvironment) CurrentPipeline(arg1 kratix.Promise) (*v1alpha1.Pipeline, error) {
+	fake.currentPipelineMutex.Lock()
+	ret, specificReturn := fake.currentPipelineReturnsOnCall[len(fake.currentPipelineArgsForCall)]
+	fake.currentPipelineArgsForCall = append(fake.currentPipelineArgsForCall, struct {
+		arg1 kratix.Promise
+	}{arg1})
+	stub := fake.CurrentPipelineStub
+	fakeReturns := fake.currentPipelineReturns
+	fake.recordInvocation("CurrentPipeline", []interface{}{arg1})
+	fake.currentPipelineMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkflowEnvironment) CurrentPipelineCallCount() int {
+	fake.currentPipelineMutex.RLock()
+	defer fake.currentPipelineMutex.RUnlock()
+	return len(fake.currentPipelineArgsForCall)
+}
+
+func (fake *FakeWorkflowEnvironment) CurrentPipelineCalls(stub func(kratix.Promise) (*v1alpha1.Pipeline, error)) {
+	fake.currentPipelineMutex.Lock()
+	defer fake.currentPipelineMutex.Unlock()
+	fake.CurrentPipelineStub = stub
+}
+
+func (fake *FakeWorkflowEnvironment) CurrentPipelineArgsForCall(i int) kratix.Promise {
+	fake.currentPipelineMutex.RLock()
+	defer fake.currentPipelineMutex.RUnlock()
+	argsForCall := fake.currentPipelineArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorkflowEnvironment) CurrentPipelineReturns(result1 *v1alpha1.Pipeline, result2 error) {
+	fake.currentPipelineMutex.Lock()
+	defer fake.currentPipelineMutex.Unlock()
+	fake.CurrentPipelineStub = nil
+	fake.currentPipelineReturns = struct {
+		result1 *v1alpha1.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkflowEnvironment) CurrentPipelineReturnsOnCall(i int, result1 *v1alpha1.Pipeline, result2 error) {
+	fake.currentPipelineMutex.Lock()
+	defer fake.currentPipelineMutex.Unlock()
+	fake.CurrentPipelineStub = nil
+	if fake.currentPipelineReturnsOnCall == nil {
+		fake.currentPipelineReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha1.Pipeline
+			result2 error
+		})
+	}
+	fake.currentPipelineReturnsOnCall[i] = struct {
+		result1 *v1alpha1.Pipeline
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkflowEnvironment) IsConfigureAction() bool {
+	fake.isConfigureActionMutex.Lock()
+	ret, specificReturn := fake.isConfigureActionReturnsOnCall[len(fake.isConfigureActionArgsForCall)]
+	fake.isConfigureActionArgsForCall = append(fake.isConfigureActionArgsForCall, struct {
+	}{})
+	stub := fake.IsConfigureActionStub
+	fakeReturns := fake.isConfigureActionReturns
+	fake.recordInvocation("IsConfigureAction", []interface{}{})
+	fake.isConfigureActionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkflowEnvironment) IsConfigureActionCallCount() int {
+	fake.isConfigureActionMutex.RLock()
+	defer fake.isConfigureActionMutex.RUnlock()
+	return len(fake.isConfigureActionArgsForCall)
+}
+
+func (fake *FakeWorkflowEnvironment) IsConfigureActionCalls(stub func() bool) {
+	fake.isConfigureActionMutex.Lock()
+	defer fake.isConfigureActionMutex.Unlock()
+	fake.IsConfigureActionStub = stub
+}
+
+func (fake *FakeWorkflowEnvironment) IsConfigureActionReturns(result1 bool) {
+	fake.isConfigureActionMutex.Lock()
+	defer fake.isConfigureActionMutex.Unlock()
+	fake.IsConfigureActionStub = nil
+	fake.isConfigureActionReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) IsConfigureActionReturnsOnCall(i int, result1 bool) {
+	fake.isConfigureActionMutex.Lock()
+	defer fake.isConfigureActionMutex.Unlock()
+	fake.IsConfigureActionStub = nil
+	if fake.isConfigureActionReturnsOnCall == nil {
+		fake.isConfigureActionReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isConfigureActionReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) IsDeleteAction() bool {
+	fake.isDeleteActionMutex.Lock()
+	ret, specificReturn := fake.isDeleteActionReturnsOnCall[len(fake.isDeleteActionArgsForCall)]
+	fake.isDeleteActionArgsForCall = append(fake.isDeleteActionArgsForCall, struct {
+	}{})
+	stub := fake.IsDeleteActionStub
+	fakeReturns := fake.isDeleteActionReturns
+	fake.recordInvocation("IsDeleteAction", []interface{}{})
+	fake.isDeleteActionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkflowEnvironment) IsDeleteActionCallCount() int {
+	fake.isDeleteActionMutex.RLock()
+	defer fake.isDeleteActionMutex.RUnlock()
+	return len(fake.isDeleteActionArgsForCall)
+}
+
+func (fake *FakeWorkflowEnvironment) IsDeleteActionCalls(stub func() bool) {
+	fake.isDeleteActionMutex.Lock()
+	defer fake.isDeleteActionMutex.Unlock()
+	fake.IsDeleteActionStub = stub
+}
+
+func (fake *FakeWorkflowEnvironment) IsDeleteActionReturns(result1 bool) {
+	fake.isDeleteActionMutex.Lock()
+	defer fake.isDeleteActionMutex.Unlock()
+	fake.IsDeleteActionStub = nil
+	fake.isDeleteActionReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) IsDeleteActionReturnsOnCall(i int, result1 bool) {
+	fake.isDeleteActionMutex.Lock()
+	defer fake.isDeleteActionMutex.Unlock()
+	fake.IsDeleteActionStub = nil
+	if fake.isDeleteActionReturnsOnCall == nil {
+		fake.isDeleteActionReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isDeleteActionReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) IsPromiseWorkflow() bool {
+	fake.isPromiseWorkflowMutex.Lock()
+	ret, specificReturn := fake.isPromiseWorkflowReturnsOnCall[len(fake.isPromiseWorkflowArgsForCall)]
+	fake.isPromiseWorkflowArgsForCall = append(fake.isPromiseWorkflowArgsForCall, struct {
+	}{})
+	stub := fake.IsPromiseWorkflowStub
+	fakeReturns := fake.isPromiseWorkflowReturns
+	fake.recordInvocation("IsPromiseWorkflow", []interface{}{})
+	fake.isPromiseWorkflowMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkflowEnvironment) IsPromiseWorkflowCallCount() int {
+	fake.isPromiseWorkflowMutex.RLock()
+	defer fake.isPromiseWorkflowMutex.RUnlock()
+	return len(fake.isPromiseWorkflowArgsForCall)
+}
+
+func (fake *FakeWorkflowEnvironment) IsPromiseWorkflowCalls(stub func() bool) {
+	fake.isPromiseWorkflowMutex.Lock()
+	defer fake.isPromiseWorkflowMutex.Unlock()
+	fake.IsPromiseWorkflowStub = stub
+}
+
+func (fake *FakeWorkflowEnvironment) IsPromiseWorkflowReturns(result1 bool) {
+	fake.isPromiseWorkflowMutex.Lock()
+	defer fake.isPromiseWorkflowMutex.Unlock()
+	fake.IsPromiseWorkflowStub = nil
+	fake.isPromiseWorkflowReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) IsPromiseWorkflowReturnsOnCall(i int, result1 bool) {
+	fake.isPromiseWorkflowMutex.Lock()
+	defer fake.isPromiseWorkflowMutex.Unlock()
+	fake.IsPromiseWorkflowStub = nil
+	if fake.isPromiseWorkflowReturnsOnCall == nil {
+		fake.isPromiseWorkflowReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isPromiseWorkflowReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) IsResourceWorkflow() bool {
+	fake.isResourceWorkflowMutex.Lock()
+	ret, specificReturn := fake.isResourceWorkflowReturnsOnCall[len(fake.isResourceWorkflowArgsForCall)]
+	fake.isResourceWorkflowArgsForCall = append(fake.isResourceWorkflowArgsForCall, struct {
+	}{})
+	stub := fake.IsResourceWorkflowStub
+	fakeReturns := fake.isResourceWorkflowReturns
+	fake.recordInvocation("IsResourceWorkflow", []interface{}{})
+	fake.isResourceWorkflowMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkflowEnvironment) IsResourceWorkflowCallCount() int {
+	fake.isResourceWorkflowMutex.RLock()
+	defer fake.isResourceWorkflowMutex.RUnlock()
+	return len(fake.isResourceWorkflowArgsForCall)
+}
+
+func (fake *FakeWorkflowEnvironment) IsResourceWorkflowCalls(stub func() bool) {
+	fake.isResourceWorkflowMutex.Lock()
+	defer fake.isResourceWorkflowMutex.Unlock()
+	fake.IsResourceWorkflowStub = stub
+}
+
+func (fake *FakeWorkflowEnvironment) IsResourceWorkflowReturns(result1 bool) {
+	fake.isResourceWorkflowMutex.Lock()
+	defer fake.isResourceWorkflowMutex.Unlock()
+	fake.IsResourceWorkflowStub = nil
+	fake.isResourceWorkflowReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) IsResourceWorkflowReturnsOnCall(i int, result1 bool) {
+	fake.isResourceWorkflowMutex.Lock()
+	defer fake.isResourceWorkflowMutex.Unlock()
+	fake.IsResourceWorkflowStub = nil
+	if fake.isResourceWorkflowReturnsOnCall == nil {
+		fake.isResourceWorkflowReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isResourceWorkflowReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) PipelineName() string {
+	fake.pipelineNameMutex.Lock()
+	ret, specificReturn := fake.pipelineNameReturnsOnCall[len(fake.pipelineNameArgsForCall)]
+	fake.pipelineNameArgsForCall = append(fake.pipelineNameArgsForCall, struct {
+	}{})
+	stub := fake.PipelineNameStub
+	fakeReturns := fake.pipelineNameReturns
+	fake.recordInvocation("PipelineName", []interface{}{})
+	fake.pipelineNameMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkflowEnvironment) PipelineNameCallCount() int {
+	fake.pipelineNameMutex.RLock()
+	defer fake.pipelineNameMutex.RUnlock()
+	return len(fake.pipelineNameArgsForCall)
+}
+
+func (fake *FakeWorkflowEnvironment) PipelineNameCalls(stub func() string) {
+	fake.pipelineNameMutex.Lock()
+	defer fake.pipelineNameMutex.Unlock()
+	fake.PipelineNameStub = stub
+}
+
+func (fake *FakeWorkflowEnvironment) PipelineNameReturns(result1 string) {
+	fake.pipelineNameMutex.Lock()
+	defer fake.pipelineNameMutex.Unlock()
+	fake.PipelineNameStub = nil
+	fake.pipelineNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) PipelineNameReturnsOnCall(i int, result1 string) {
+	fake.pipelineNameMutex.Lock()
+	defer fake.pipelineNameMutex.Unlock()
+	fake.PipelineNameStub = nil
+	if fake.pipelineNameReturnsOnCall == nil {
+		fake.pipelineNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.pipelineNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) PromiseName() string {
+	fake.promiseNameMutex.Lock()
+	ret, specificReturn := fake.promiseNameReturnsOnCall[len(fake.promiseNameArgsForCall)]
+	fake.promiseNameArgsForCall = append(fake.promiseNameArgsForCall, struct {
+	}{})
+	stub := fake.PromiseNameStub
+	fakeReturns := fake.promiseNameReturns
+	fake.recordInvocation("PromiseName", []interface{}{})
+	fake.promiseNameMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkflowEnvironment) PromiseNameCallCount() int {
+	fake.promiseNameMutex.RLock()
+	defer fake.promiseNameMutex.RUnlock()
+	return len(fake.promiseNameArgsForCall)
+}
+
+func (fake *FakeWorkflowEnvironment) PromiseNameCalls(stub func() string) {
+	fake.promiseNameMutex.Lock()
+	defer fake.promiseNameMutex.Unlock()
+	fake.PromiseNameStub = stub
+}
+
+func (fake *FakeWorkflowEnvironment) PromiseNameReturns(result1 string) {
+	fake.promiseNameMutex.Lock()
+	defer fake.promiseNameMutex.Unlock()
+	fake.PromiseNameStub = nil
+	fake.promiseNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) PromiseNameReturnsOnCall(i int, result1 string) {
+	fake.promiseNameMutex.Lock()
+	defer fake.promiseNameMutex.Unlock()
+	fake.PromiseNameStub = nil
+	if fake.promiseNameReturnsOnCall == nil {
+		fake.promiseNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.promiseNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) WorkflowAction() string {
+	fake.workflowActionMutex.Lock()
+	ret, specificReturn := fake.workflowActionReturnsOnCall[len(fake.workflowActionArgsForCall)]
+	fake.workflowActionArgsForCall = append(fake.workflowActionArgsForCall, struct {
+	}{})
+	stub := fake.WorkflowActionStub
+	fakeReturns := fake.workflowActionReturns
+	fake.recordInvocation("WorkflowAction", []interface{}{})
+	fake.workflowActionMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkflowEnvironment) WorkflowActionCallCount() int {
+	fake.workflowActionMutex.RLock()
+	defer fake.workflowActionMutex.RUnlock()
+	return len(fake.workflowActionArgsForCall)
+}
+
+func (fake *FakeWorkflowEnvironment) WorkflowActionCalls(stub func() string) {
+	fake.workflowActionMutex.Lock()
+	defer fake.workflowActionMutex.Unlock()
+	fake.WorkflowActionStub = stub
+}
+
+func (fake *FakeWorkflowEnvironment) WorkflowActionReturns(result1 string) {
+	fake.workflowActionMutex.Lock()
+	defer fake.workflowActionMutex.Unlock()
+	fake.WorkflowActionStub = nil
+	fake.workflowActionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) WorkflowActionReturnsOnCall(i int, result1 string) {
+	fake.workflowActionMutex.Lock()
+	defer fake.workflowActionMutex.Unlock()
+	fake.WorkflowActionStub = nil
+	if fake.workflowActionReturnsOnCall == nil {
+		fake.workflowActionReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.workflowActionReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) WorkflowType() string {
+	fake.workflowTypeMutex.Lock()
+	ret, specificReturn := fake.workflowTypeReturnsOnCall[len(fake.workflowTypeArgsForCall)]
+	fake.workflowTypeArgsForCall = append(fake.workflowTypeArgsForCall, struct {
+	}{})
+	stub := fake.WorkflowTypeStub
+	fakeReturns := fake.workflowTypeReturns
+	fake.recordInvocation("WorkflowType", []interface{}{})
+	fake.workflowTypeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkflowEnvironment) WorkflowTypeCallCount() int {
+	fake.workflowTypeMutex.RLock()
+	defer fake.workflowTypeMutex.RUnlock()
+	return len(fake.workflowTypeArgsForCall)
+}
+
+func (fake *FakeWorkflowEnvironment) WorkflowTypeCalls(stub func() string) {
+	fake.workflowTypeMutex.Lock()
+	defer fake.workflowTypeMutex.Unlock()
+	fake.WorkflowTypeStub = stub
+}
+
+func (fake *FakeWorkflowEnvironment) WorkflowTypeReturns(result1 string) {
+	fake.workflowTypeMutex.Lock()
+	defer fake.workflowTypeMutex.Unlock()
+	fake.WorkflowTypeStub = nil
+	fake.workflowTypeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) WorkflowTypeReturnsOnCall(i int, result1 string) {
+	fake.workflowTypeMutex.Lock()
+	defer fake.workflowTypeMutex.Unlock()
+	fake.WorkflowTypeStub = nil
+	if fake.workflowTypeReturnsOnCall == nil {
+		fake.workflowTypeReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.workflowTypeReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorkflowEnvironment) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeWorkflowEnvironment) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ kratix.WorkflowEnvironment = new(FakeWorkflowEnvironment)
@@ -0,0 +1,420 @@
+// Package kratixfuzz tests workflow handlers with random resource requests
+// generated from the schema of the Promise API, using Go's native fuzzing or a
+// seeded run of many inputs. Each input runs the handler in an isolated
+// /kratix layout in a temporary directory, and the run fails if the handler
+// errors or panics, writes invalid YAML, or writes outside the output and
+// metadata directories. Failing inputs are saved to reproduce them with
+// RunFile.
+package kratixfuzz
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"slices"
+	"strings"
+	"testing"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix-go/internal/objutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+// Handler is the workflow under test, run with an SDK reading and writing the
+// isolated /kratix layout.
+type Handler func(sdk *kratix.KratixSDK) error
+
+// FailureKind is the kind of problem found running a Handler.
+type FailureKind string
+
+// The kinds of failures.
+const (
+	FailureError         FailureKind = "error"
+	FailurePanic         FailureKind = "panic"
+	FailureInvalidOutput FailureKind = "invalid output"
+	FailurePathEscape    FailureKind = "path escape"
+)
+
+// Failure is a problem found running a Handler with an input.
+type Failure struct {
+	Kind    FailureKind
+	Message string
+	// Path is the file the problem was found in, relative to the /kratix
+	// layout, for invalid outputs and path escapes.
+	Path  string
+	Input *unstructured.Unstructured
+	// InputFile is where the input was saved, to reproduce the failure with
+	// RunFile.
+	InputFile string
+}
+
+func (f *Failure) Error() string {
+	msg := fmt.Sprintf("%s: %s", f.Kind, f.Message)
+	if f.Path != "" {
+		msg = fmt.Sprintf("%s: %s: %s", f.Kind, f.Path, f.Message)
+	}
+	if f.InputFile != "" {
+		msg += fmt.Sprintf(" (input saved to %s)", f.InputFile)
+	}
+	return msg
+}
+
+// Fuzzer runs a Handler with inputs generated from the API of a Promise.
+type Fuzzer struct {
+	// Promise whose API the inputs are generated from. The handler can fetch
+	// it with FetchPromise.
+	Promise kratix.Promise
+	// Version of the API. The storage version is used by default.
+	Version string
+	// Handler is the workflow under test.
+	Handler Handler
+	// Env sets environment variables for the handler, on top of the resource
+	// configure workflow variables set for the Promise.
+	Env map[string]string
+	// Options are applied to the SDK after the directories of the layout,
+	// e.g. WithObjectClient.
+	Options []kratix.Option
+	// FailuresDir is where failing inputs are saved. Defaults to
+	// testdata/kratixfuzz, next to the Go fuzzer corpus.
+	FailuresDir string
+}
+
+// Fuzz runs the Handler from a Go fuzz test, with inputs generated from the
+// fuzzer data and the minimal input as the seed:
+//
+//	func FuzzPipeline(f *testing.F) {
+//		fuzzer := &kratixfuzz.Fuzzer{Promise: promise, Handler: pipeline.Run}
+//		fuzzer.Fuzz(f)
+//	}
+//
+// Inputs that do not match the schema are skipped.
+func (f *Fuzzer) Fuzz(tf *testing.F) {
+	tf.Add([]byte{})
+	tf.Add(bytes.Repeat([]byte{0xff}, 64))
+	tf.Fuzz(func(t *testing.T, data []byte) {
+		input, err := f.Generate(data)
+		if errors.Is(err, ErrInvalidInput) {
+			t.Skip(err)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := f.Run(input); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// Check runs the Handler with inputs generated from a pseudo-random source
+// with the seed, returning the first Failure. Use it for property-based tests
+// that run without -fuzz.
+func (f *Fuzzer) Check(iterations int, seed uint64) error {
+	rng := rand.New(rand.NewPCG(seed, seed))
+	data := make([]byte, 256)
+	for i := range iterations {
+		for j := range data {
+			data[j] = byte(rng.UintN(256))
+		}
+		input, err := f.Generate(data)
+		if errors.Is(err, ErrInvalidInput) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := f.Run(input); err != nil {
+			return fmt.Errorf("iteration %d with seed %d: %w", i, seed, err)
+		}
+	}
+	return nil
+}
+
+// RunFile runs the Handler with the input saved in the file, e.g. by a
+// previous failure.
+func (f *Fuzzer) RunFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read input: %w", err)
+	}
+	obj := map[string]any{}
+	if err := yaml.Unmarshal(data, &obj); err != nil {
+		return fmt.Errorf("unmarshal input: %w", err)
+	}
+	return f.Run(&unstructured.Unstructured{Object: obj})
+}
+
+// Run runs the Handler with the input in a new /kratix layout, and returns a
+// *Failure if a problem was found, after saving the input.
+func (f *Fuzzer) Run(input *unstructured.Unstructured) error {
+	failure, err := f.run(input)
+	if err != nil {
+		return err
+	}
+	if failure == nil {
+		return nil
+	}
+	failure.Input = input
+	if failure.InputFile, err = f.saveInput(input); err != nil {
+		return errors.Join(failure, err)
+	}
+	return failure
+}
+
+func (f *Fuzzer) run(input *unstructured.Unstructured) (*Failure, error) {
+	// The SDK rejects writes resolving outside the output and metadata
+	// directories, the layout is nested in the temporary directory so that
+	// files the handler writes a few levels up without the SDK are checked.
+	root, err := os.MkdirTemp("", "kratixfuzz-")
+	if err != nil {
+		return nil, fmt.Errorf("create layout: %w", err)
+	}
+	defer os.RemoveAll(root)
+
+	kratixDir := filepath.Join(root, "sandbox", "kratix")
+	for _, dir := range []string{"input", "output", "metadata"} {
+		if err := os.MkdirAll(filepath.Join(kratixDir, dir), 0o755); err != nil {
+			return nil, fmt.Errorf("create layout: %w", err)
+		}
+	}
+	if err := objutil.WriteYAML(filepath.Join(kratixDir, "input", "object.yaml"), input.Object); err != nil {
+		return nil, err
+	}
+	promiseFile := filepath.Join(root, "promise.yaml")
+	if err := objutil.WriteYAML(promiseFile, f.Promise.GetPromise()); err != nil {
+		return nil, err
+	}
+
+	before, err := snapshot(root)
+	if err != nil {
+		return nil, err
+	}
+
+	restore := setenv(f.env())
+	opts := append([]kratix.Option{
+		kratix.WithInputDir(filepath.Join(kratixDir, "input")),
+		kratix.WithOutputDir(filepath.Join(kratixDir, "output")),
+		kratix.WithMetadataDir(filepath.Join(kratixDir, "metadata")),
+		kratix.WithPromiseFile(promiseFile),
+	}, f.Options...)
+	failure := runHandler(f.Handler, kratix.New(opts...), kratixDir)
+	restore()
+	if failure != nil {
+		return failure, nil
+	}
+
+	after, err := snapshot(root)
+	if err != nil {
+		return nil, err
+	}
+	if failure := checkEscapes(root, kratixDir, before, after); failure != nil {
+		return failure, nil
+	}
+	return checkOutputs(kratixDir)
+}
+
+func (f *Fuzzer) env() map[string]string {
+	env := map[string]string{
+		"KRATIX_WORKFLOW_TYPE":   "resource",
+		"KRATIX_WORKFLOW_ACTION": "configure",
+		"KRATIX_PROMISE_NAME":    f.Promise.GetName(),
+	}
+	for k, v := range f.Env {
+		env[k] = v
+	}
+	return env
+}
+
+func (f *Fuzzer) saveInput(input *unstructured.Unstructured) (string, error) {
+	data, err := yaml.Marshal(input.Object)
+	if err != nil {
+		return "", fmt.Errorf("marshal input: %w", err)
+	}
+	dir := f.FailuresDir
+	if dir == "" {
+		dir = filepath.Join("testdata", "kratixfuzz")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("save input: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.yaml", strings.ToLower(input.GetKind()), hex.EncodeToString(sum[:])[:12]))
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return "", fmt.Errorf("save input: %w", err)
+	}
+	return path, nil
+}
+
+// runHandler runs the handler, reporting the writes the SDK rejected as
+// escaping the layout relative to the kratixDir.
+func runHandler(handler Handler, sdk *kratix.KratixSDK, kratixDir string) (failure *Failure) {
+	defer func() {
+		if r := recover(); r != nil {
+			failure = &Failure{Kind: FailurePanic, Message: fmt.Sprintf("%v\n%s", r, debug.Stack())}
+		}
+	}()
+	err := handler(sdk)
+	var escape *kratix.PathEscapeError
+	if errors.As(err, &escape) {
+		layoutPath, _ := filepath.Rel(kratixDir, escape.Path)
+		return &Failure{Kind: FailurePathEscape, Path: layoutPath, Message: "written outside the output and metadata directories"}
+	}
+	if err != nil {
+		return &Failure{Kind: FailureError, Message: err.Error()}
+	}
+	return nil
+}
+
+// setenv sets the environment variables and returns a function restoring them.
+func setenv(env map[string]string) func() {
+	previous := map[string]*string{}
+	for k, v := range env {
+		if old, ok := os.LookupEnv(k); ok {
+			previous[k] = &old
+		} else {
+			previous[k] = nil
+		}
+		os.Setenv(k, v)
+	}
+	return func() {
+		for k, old := range previous {
+			if old == nil {
+				os.Unsetenv(k)
+			} else {
+				os.Setenv(k, *old)
+			}
+		}
+	}
+}
+
+type fileState struct {
+	mode fs.FileMode
+	data string
+}
+
+// snapshot records the files under the root, with symlinks recorded by their
+// target.
+func snapshot(root string) (map[string]fileState, error) {
+	files := map[string]fileState{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(root, path)
+		switch {
+		case d.Type()&fs.ModeSymlink != 0:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			files[rel] = fileState{mode: fs.ModeSymlink, data: target}
+		case d.Type().IsRegular():
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			files[rel] = fileState{data: string(data)}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("inspect layout: %w", err)
+	}
+	return files, nil
+}
+
+// checkEscapes reports files the handler wrote outside the output and
+// metadata directories, and symlinks pointing outside the layout.
+func checkEscapes(root, kratixDir string, before, after map[string]fileState) *Failure {
+	kratixRel, _ := filepath.Rel(root, kratixDir)
+	writable := []string{filepath.Join(kratixRel, "output"), filepath.Join(kratixRel, "metadata")}
+	for _, path := range slices.Sorted(maps.Keys(after)) {
+		state := after[path]
+		inWritable := false
+		for _, dir := range writable {
+			if strings.HasPrefix(path, dir+string(filepath.Separator)) {
+				inWritable = true
+			}
+		}
+		layoutPath, _ := filepath.Rel(kratixRel, path)
+
+		if !inWritable {
+			if previous, ok := before[path]; !ok || previous != state {
+				return &Failure{Kind: FailurePathEscape, Path: layoutPath, Message: "written outside the output and metadata directories"}
+			}
+			continue
+		}
+		if state.mode == fs.ModeSymlink {
+			target := state.data
+			if !filepath.IsAbs(target) {
+				target = filepath.Join(filepath.Dir(filepath.Join(root, path)), target)
+			}
+			if rel, err := filepath.Rel(kratixDir, target); err != nil || strings.HasPrefix(rel, "..") {
+				return &Failure{Kind: FailurePathEscape, Path: layoutPath, Message: fmt.Sprintf("symlink to %s outside the layout", state.data)}
+			}
+		}
+	}
+	return nil
+}
+
+// checkOutputs reports files in the output and metadata directories that are
+// not valid YAML, and output documents that are not Kubernetes objects.
+func checkOutputs(kratixDir string) (*Failure, error) {
+	for _, dir := range []string{"output", "metadata"} {
+		err := filepath.WalkDir(filepath.Join(kratixDir, dir), func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !objutil.IsYAMLFile(path) {
+				return err
+			}
+			rel, _ := filepath.Rel(kratixDir, path)
+			if msg := checkYAML(path, dir == "output"); msg != "" {
+				return &Failure{Kind: FailureInvalidOutput, Path: rel, Message: msg}
+			}
+			return nil
+		})
+		var failure *Failure
+		if errors.As(err, &failure) {
+			return failure, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("inspect outputs: %w", err)
+		}
+	}
+	return nil, nil
+}
+
+func checkYAML(path string, requireObjects bool) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return err.Error()
+	}
+	defer file.Close()
+
+	decoder := utilyaml.NewYAMLOrJSONDecoder(file, 4096)
+	for i := 0; ; i++ {
+		var doc any
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return ""
+		}
+		if err != nil {
+			return fmt.Sprintf("document %d: %s", i, err)
+		}
+		if doc == nil || !requireObjects {
+			continue
+		}
+		obj, ok := doc.(map[string]any)
+		if !ok || obj["apiVersion"] == nil || obj["kind"] == nil {
+			return fmt.Sprintf("document %d is not a Kubernetes object", i)
+		}
+	}
+}
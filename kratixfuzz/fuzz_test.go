@@ -0,0 +1,235 @@
+package kratixfuzz_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix-go/kratixfuzz"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// configMapWorkflow writes a ConfigMap per user of the database request.
+func configMapWorkflow(sdk *kratix.KratixSDK) error {
+	resource, err := sdk.ReadResourceInput()
+	if err != nil {
+		return err
+	}
+	users, _ := resource.GetValues("spec.users[].name")
+	for i, user := range users {
+		configMap := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": fmt.Sprintf("user-%d", i)},
+			"data":       map[string]any{"user": user},
+		}}
+		if err := sdk.WriteObjects(fmt.Sprintf("users/user-%d.yaml", i), []*unstructured.Unstructured{configMap}); err != nil {
+			return err
+		}
+	}
+	return sdk.WriteStatus(kratix.NewStatusFromMap(map[string]any{"users": int64(len(users))}))
+}
+
+const promiseFile = "../codegen/testdata/promise.yaml"
+
+func FuzzConfigMapWorkflow(f *testing.F) {
+	promise, err := kratix.ReadPromiseFile(promiseFile)
+	if err != nil {
+		f.Fatal(err)
+	}
+	fuzzer := &kratixfuzz.Fuzzer{
+		Promise:     promise,
+		Handler:     configMapWorkflow,
+		FailuresDir: f.TempDir(),
+	}
+	fuzzer.Fuzz(f)
+}
+
+var _ = Describe("Fuzzer", func() {
+	var fuzzer *kratixfuzz.Fuzzer
+
+	BeforeEach(func() {
+		promise, err := kratix.ReadPromiseFile(promiseFile)
+		Expect(err).ToNot(HaveOccurred())
+		fuzzer = &kratixfuzz.Fuzzer{
+			Promise:     promise,
+			Handler:     configMapWorkflow,
+			FailuresDir: GinkgoT().TempDir(),
+		}
+	})
+
+	Describe("Generate", func() {
+		It("generates the smallest input from empty data", func() {
+			input, err := fuzzer.Generate(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(input.GetAPIVersion()).To(Equal("marketplace.kratix.io/v1"))
+			Expect(input.GetKind()).To(Equal("database"))
+			Expect(input.GetNamespace()).To(Equal("default"))
+			Expect(input.Object["spec"]).To(Equal(map[string]any{"size": "small"}))
+		})
+
+		It("generates the same schema-valid input from the same data", func() {
+			data := []byte("some fuzzer data that sets optional fields and picks values")
+			input, err := fuzzer.Generate(data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kratix.ValidateAgainstPromise(fuzzer.Promise, input)).To(Succeed())
+
+			again, err := fuzzer.Generate(data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(again).To(Equal(input))
+		})
+
+		It("draws integers across wide ranges", func() {
+			promise, err := kratix.UnmarshalPromise([]byte(`
+apiVersion: platform.kratix.io/v1alpha1
+kind: Promise
+metadata:
+  name: counter
+spec:
+  api:
+    apiVersion: apiextensions.k8s.io/v1
+    kind: CustomResourceDefinition
+    metadata:
+      name: counters.example.com
+    spec:
+      group: example.com
+      names: {kind: Counter, plural: counters, singular: counter}
+      scope: Namespaced
+      versions:
+      - name: v1
+        served: true
+        storage: true
+        schema:
+          openAPIV3Schema:
+            type: object
+            properties:
+              spec:
+                type: object
+                required: [count]
+                properties:
+                  count: {type: integer, minimum: 0, maximum: 1000000000000}
+`))
+			Expect(err).ToNot(HaveOccurred())
+			fuzzer.Promise = promise
+
+			input, err := fuzzer.Generate([]byte{0xff, 0xff, 0xff, 0xff, 0xff})
+			Expect(err).ToNot(HaveOccurred())
+			count, _, err := unstructured.NestedInt64(input.Object, "spec", "count")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(BeNumerically(">", math.MaxUint16))
+		})
+
+		It("generates inputs of the configured version", func() {
+			fuzzer.Version = "v1alpha1"
+			input, err := fuzzer.Generate([]byte{1, 2, 3})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(input.GetAPIVersion()).To(Equal("marketplace.kratix.io/v1alpha1"))
+		})
+	})
+
+	Describe("Check", func() {
+		It("succeeds when the handler handles every input", func() {
+			Expect(fuzzer.Check(50, 1)).To(Succeed())
+		})
+
+		It("sets the workflow environment for the handler", func() {
+			fuzzer.Env = map[string]string{"KRATIX_WORKFLOW_ACTION": "delete"}
+			fuzzer.Handler = func(sdk *kratix.KratixSDK) error {
+				promise, err := sdk.FetchPromise()
+				if err != nil {
+					return err
+				}
+				if promise.GetName() != "database" || !sdk.IsResourceWorkflow() || !sdk.IsDeleteAction() {
+					return errors.New("unexpected environment")
+				}
+				return nil
+			}
+			Expect(fuzzer.Check(1, 1)).To(Succeed())
+		})
+	})
+
+	Describe("failures", func() {
+		expectFailure := func(kind kratixfuzz.FailureKind) *kratixfuzz.Failure {
+			err := fuzzer.Check(20, 7)
+			var failure *kratixfuzz.Failure
+			Expect(errors.As(err, &failure)).To(BeTrue(), fmt.Sprint(err))
+			Expect(failure.Kind).To(Equal(kind))
+			Expect(failure.Input).ToNot(BeNil())
+			Expect(failure.InputFile).To(BeAnExistingFile())
+			return failure
+		}
+
+		It("reports handler errors", func() {
+			fuzzer.Handler = func(*kratix.KratixSDK) error { return errors.New("boom") }
+			failure := expectFailure(kratixfuzz.FailureError)
+			Expect(failure.Message).To(Equal("boom"))
+			Expect(failure.Error()).To(HavePrefix("error: boom (input saved to " + fuzzer.FailuresDir))
+		})
+
+		It("reports panics", func() {
+			fuzzer.Handler = func(*kratix.KratixSDK) error { panic("oops") }
+			failure := expectFailure(kratixfuzz.FailurePanic)
+			Expect(failure.Message).To(HavePrefix("oops\n"))
+		})
+
+		It("reports invalid output YAML", func() {
+			fuzzer.Handler = func(sdk *kratix.KratixSDK) error {
+				return sdk.WriteOutput("broken.yaml", []byte("key: [unclosed"))
+			}
+			failure := expectFailure(kratixfuzz.FailureInvalidOutput)
+			Expect(failure.Path).To(Equal("output/broken.yaml"))
+		})
+
+		It("reports output documents that are not Kubernetes objects", func() {
+			fuzzer.Handler = func(sdk *kratix.KratixSDK) error {
+				return sdk.WriteOutput("list.yaml", []byte("- a\n- b\n"))
+			}
+			failure := expectFailure(kratixfuzz.FailureInvalidOutput)
+			Expect(failure.Message).To(Equal("document 0 is not a Kubernetes object"))
+		})
+
+		It("reports writes escaping the output directory", func() {
+			fuzzer.Handler = func(sdk *kratix.KratixSDK) error {
+				return sdk.WriteOutput("../input/object.yaml", []byte("apiVersion: v1\nkind: Overwritten\n"))
+			}
+			failure := expectFailure(kratixfuzz.FailurePathEscape)
+			Expect(failure.Path).To(Equal("input/object.yaml"))
+		})
+
+		It("reports writes escaping the temporary directory", func() {
+			fuzzer.Handler = func(sdk *kratix.KratixSDK) error {
+				return sdk.WriteOutput("../../../../kratixfuzz-escaped.yaml", []byte("apiVersion: v1\nkind: Escaped\n"))
+			}
+			failure := expectFailure(kratixfuzz.FailurePathEscape)
+			Expect(failure.Path).To(Equal("../../../kratixfuzz-escaped.yaml"))
+			Expect(filepath.Join(os.TempDir(), "kratixfuzz-escaped.yaml")).ToNot(BeAnExistingFile())
+		})
+	})
+
+	Describe("RunFile", func() {
+		It("reproduces a failure from the saved input", func() {
+			fuzzer.Handler = func(sdk *kratix.KratixSDK) error {
+				resource, err := sdk.ReadResourceInput()
+				if err != nil {
+					return err
+				}
+				if size, _ := resource.GetValue("spec.size"); size == "large" {
+					return errors.New("large databases are not supported")
+				}
+				return nil
+			}
+			err := fuzzer.Check(100, 3)
+			var failure *kratixfuzz.Failure
+			Expect(errors.As(err, &failure)).To(BeTrue())
+
+			Expect(fuzzer.RunFile(failure.InputFile)).To(MatchError(ContainSubstring("large databases are not supported")))
+		})
+	})
+})
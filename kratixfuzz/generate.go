@@ -0,0 +1,160 @@
+package kratixfuzz
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix-go/internal/schemagen"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ErrInvalidInput is returned by Generate when the generated input does not
+// match the schema, e.g. because a pattern could not be satisfied. Such inputs
+// are skipped rather than reported.
+var ErrInvalidInput = errors.New("generated input does not match the schema")
+
+// stringChars are the characters random strings are made of. They include
+// path separators and dots to catch handlers building paths from inputs.
+const stringChars = "abcdefghijklmnopqrstuvwxyz0123456789-_./ "
+
+// Generate builds a resource request of the Promise API from the entropy in
+// data, e.g. provided by the Go fuzzer. The same data always generates the
+// same input, and empty data generates the smallest input. Optional fields,
+// enum values, lengths and numbers are all drawn from data within the bounds
+// of the schema, and the input is validated against it.
+func (f *Fuzzer) Generate(data []byte) (*unstructured.Unstructured, error) {
+	input, err := schemagen.Resource(f.Promise, f.Version, "fuzz", "default", &entropy{data: data})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := kratix.ValidateAgainstPromise(f.Promise, input); err != nil {
+		var validationErr *kratix.SchemaValidationError
+		if errors.As(err, &validationErr) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, err)
+		}
+		return nil, err
+	}
+	return input, nil
+}
+
+// entropy draws values from the fuzzer data, returning zeros once exhausted.
+type entropy struct {
+	data []byte
+}
+
+var _ schemagen.Source = (*entropy)(nil)
+
+func (e *entropy) byte() byte {
+	if len(e.data) == 0 {
+		return 0
+	}
+	b := e.data[0]
+	e.data = e.data[1:]
+	return b
+}
+
+// intn returns a number in [0, n).
+func (e *entropy) intn(n int) int {
+	if n <= 1 {
+		return 0
+	}
+	return int(e.uint64n(uint64(n)))
+}
+
+// uint64n returns a number in [0, n), n of 0 meaning the whole uint64 range.
+// It draws two bytes, and as many more as n needs, so wide ranges are
+// explored in full.
+func (e *entropy) uint64n(n uint64) uint64 {
+	var v uint64
+	for i := 0; i < 2 || i < 8 && (n-1)>>(8*i) != 0; i++ {
+		v = v<<8 | uint64(e.byte())
+	}
+	if n == 0 {
+		return v
+	}
+	return v % n
+}
+
+// fraction returns a number in [0, 1].
+func (e *entropy) fraction() float64 {
+	return float64(e.intn(math.MaxUint16+1)) / math.MaxUint16
+}
+
+func (e *entropy) Preset(*apiextensionsv1.JSONSchemaProps) (any, bool) {
+	return nil, false
+}
+
+func (e *entropy) Choose(n int) int {
+	return e.intn(n)
+}
+
+func (e *entropy) Bool() bool {
+	return e.byte()&1 == 1
+}
+
+func (e *entropy) Count(lo, hi int64) int64 {
+	if hi < lo {
+		return lo
+	}
+	return lo + int64(e.intn(int(hi-lo+1)))
+}
+
+func (e *entropy) Key(int64) string {
+	return e.String(&apiextensionsv1.JSONSchemaProps{})
+}
+
+// String returns a random string within the length bounds of the schema.
+// Patterns cannot be generated, so the example or default of the Promise is
+// used for strings with one.
+func (e *entropy) String(schema *apiextensionsv1.JSONSchemaProps) string {
+	if schema.Pattern != "" {
+		if v, ok := schemagen.RawValue(schema.Example); ok {
+			return fmt.Sprint(v)
+		}
+		if v, ok := schemagen.RawValue(schema.Default); ok {
+			return fmt.Sprint(v)
+		}
+	}
+
+	minLength := int64(0)
+	if schema.MinLength != nil {
+		minLength = *schema.MinLength
+	}
+	maxLength := minLength + 16
+	if schema.MaxLength != nil {
+		maxLength = min(maxLength, *schema.MaxLength)
+	}
+	s := make([]byte, e.Count(minLength, maxLength))
+	for i := range s {
+		s[i] = stringChars[e.intn(len(stringChars))]
+	}
+	return string(s)
+}
+
+func (e *entropy) Integer(_ *apiextensionsv1.JSONSchemaProps, lo, hi int64) int64 {
+	// the span overflows to 0 for the whole int64 range, which uint64n handles
+	return lo + int64(e.uint64n(uint64(hi-lo)+1))
+}
+
+func (e *entropy) Number(schema *apiextensionsv1.JSONSchemaProps) float64 {
+	lo, hi := float64(-schemagen.UnboundedRange), float64(schemagen.UnboundedRange)
+	switch {
+	case schema.Minimum != nil && schema.Maximum != nil:
+		lo, hi = *schema.Minimum, *schema.Maximum
+	case schema.Minimum != nil:
+		lo, hi = *schema.Minimum, *schema.Minimum+schemagen.UnboundedRange
+	case schema.Maximum != nil:
+		lo, hi = *schema.Maximum-schemagen.UnboundedRange, *schema.Maximum
+	}
+
+	v := lo + e.fraction()*(hi-lo)
+	if schema.Minimum != nil && schema.ExclusiveMinimum && v <= lo ||
+		schema.Maximum != nil && schema.ExclusiveMaximum && v >= hi {
+		v = (lo + hi) / 2
+	}
+	return v
+}
@@ -0,0 +1,13 @@
+package kratixfuzz_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestKratixfuzz(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Kratixfuzz Suite")
+}
@@ -0,0 +1,521 @@
+package kratixtest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix-go/internal/objutil"
+	"github.com/syntasso/kratix/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/yaml"
+)
+
+var destinationGVK = v1alpha1.GroupVersion.WithKind("Destination")
+
+// conflictMessage is the message of the Conflict errors returned by the
+// Kubernetes API when a write carries a stale resourceVersion.
+const conflictMessage = "the object has been modified; please apply your changes to the latest version and try again"
+
+// Cluster is an in-memory Kubernetes API for tests of the SDK features that
+// talk to the platform cluster, such as PublishStatus, PublishResource,
+// IsStale, FetchPromise, CheckRequiredPromises and AggregateChildStatus. It
+// is served through fake dynamic clients and behaves like the API server for
+// custom resources with a status subresource:
+//   - merge, JSON and apply patches are applied to the stored object
+//   - writes to the status subresource only change .status, and writes to the
+//     object itself keep the stored .status
+//   - every write bumps the resourceVersion, and changes outside metadata and
+//     status bump the generation
+//   - writes carrying a resourceVersion other than the stored one fail with a
+//     Conflict error
+//
+// Apply patches are merged into the object without tracking field ownership.
+type Cluster struct {
+	t      T
+	mapper *meta.DefaultRESTMapper
+
+	mu              sync.Mutex
+	objects         map[objectKey]*unstructured.Unstructured
+	fakes           map[schema.GroupVersionResource]*dynamicfake.FakeDynamicClient
+	actions         []clienttesting.Action
+	resourceVersion int64
+}
+
+type objectKey struct {
+	resource  schema.GroupVersionResource
+	namespace string
+	name      string
+}
+
+// NewCluster returns an empty Cluster that knows about the Promise and
+// Destination kinds.
+func NewCluster(t T) *Cluster {
+	c := &Cluster{
+		t:       t,
+		mapper:  meta.NewDefaultRESTMapper([]schema.GroupVersion{v1alpha1.GroupVersion}),
+		objects: map[objectKey]*unstructured.Unstructured{},
+		fakes:   map[schema.GroupVersionResource]*dynamicfake.FakeDynamicClient{},
+	}
+	c.mapper.AddSpecific(
+		v1alpha1.GroupVersion.WithKind("Promise"),
+		v1alpha1.GroupVersion.WithResource("promises"),
+		v1alpha1.GroupVersion.WithResource("promise"),
+		meta.RESTScopeRoot,
+	)
+	c.mapper.AddSpecific(
+		destinationGVK,
+		v1alpha1.GroupVersion.WithResource("destinations"),
+		v1alpha1.GroupVersion.WithResource("destination"),
+		meta.RESTScopeRoot,
+	)
+	return c
+}
+
+// WithResource preloads the objects into the cluster, keeping their status.
+// Kinds not declared by a Promise added with WithPromise are mapped to their
+// guessed plural, namespaced when the object has a namespace.
+func (c *Cluster) WithResource(objs ...*unstructured.Unstructured) *Cluster {
+	c.t.Helper()
+	for _, obj := range objs {
+		c.must(c.add(obj, false))
+	}
+	return c
+}
+
+// WithPromise preloads the Promise into the cluster and registers the kinds
+// of its API, so resources of the Promise can be added and listed.
+func (c *Cluster) WithPromise(promise kratix.Promise) *Cluster {
+	c.t.Helper()
+	c.must(c.addPromise(promise, false))
+	return c
+}
+
+// WithPromiseFile preloads the Promise in the YAML file into the cluster.
+func (c *Cluster) WithPromiseFile(path string) *Cluster {
+	c.t.Helper()
+	promise, err := kratix.ReadPromiseFile(path)
+	c.must(err)
+	return c.WithPromise(promise)
+}
+
+// WithDestination preloads the Destination into the cluster.
+func (c *Cluster) WithDestination(destination *v1alpha1.Destination) *Cluster {
+	c.t.Helper()
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(destination)
+	c.must(err)
+	u := &unstructured.Unstructured{Object: obj}
+	u.SetGroupVersionKind(destinationGVK)
+	c.must(c.add(u, false))
+	return c
+}
+
+// Options returns the SDK options pointing the SDK at the cluster.
+func (c *Cluster) Options() []kratix.Option {
+	return []kratix.Option{
+		kratix.WithDynamicClient(c.Client()),
+		kratix.WithRESTMapper(c.mapper),
+	}
+}
+
+// Client returns a dynamic client for the cluster.
+func (c *Cluster) Client() dynamic.Interface { return clusterClient{c} }
+
+// RESTMapper returns the RESTMapper resolving the kinds known to the cluster.
+func (c *Cluster) RESTMapper() meta.RESTMapper { return c.mapper }
+
+// Get returns a copy of the object stored in the cluster, or nil if there is
+// none. The namespace is ignored for cluster-scoped kinds.
+func (c *Cluster) Get(gvk schema.GroupVersionKind, namespace, name string) *unstructured.Unstructured {
+	c.t.Helper()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key, err := c.keyFor(gvk, namespace, name)
+	c.must(err)
+	obj, ok := c.objects[key]
+	if !ok {
+		return nil
+	}
+	return obj.DeepCopy()
+}
+
+// Update replaces the object stored in the cluster, as another client would,
+// and returns the stored result. Changing the spec bumps the generation, e.g.
+// to make IsStale report the workflow's input as stale.
+func (c *Cluster) Update(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	c.t.Helper()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key, err := c.keyFor(obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName())
+	c.must(err)
+	updated, err := c.update(key, obj.Object, "")
+	c.must(err)
+	return updated.DeepCopy()
+}
+
+// Actions returns the requests made to the cluster through its clients, in
+// order.
+func (c *Cluster) Actions() []clienttesting.Action {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.actions)
+}
+
+func (c *Cluster) must(err error) {
+	c.t.Helper()
+	if err != nil {
+		c.t.Fatalf("kratixtest: %v", err)
+	}
+}
+
+// addPromise stores the Promise and registers the kinds of its API. When
+// ifMissing is set, a Promise already in the cluster is kept.
+func (c *Cluster) addPromise(promise kratix.Promise, ifMissing bool) error {
+	obj := promise.ToUnstructured()
+	if crd, err := promise.GetCRD(); err == nil {
+		mapper, err := kratix.NewPromiseRESTMapper(promise)
+		if err != nil {
+			return err
+		}
+		gk := schema.GroupKind{Group: crd.Spec.Group, Kind: crd.Spec.Names.Kind}
+		mappings, err := mapper.RESTMappings(gk)
+		if err != nil {
+			return fmt.Errorf("map promise %s api: %w", promise.GetName(), err)
+		}
+		c.mu.Lock()
+		for _, mapping := range mappings {
+			singular := mapping.Resource.GroupVersion().WithResource(strings.ToLower(gk.Kind))
+			c.mapper.AddSpecific(mapping.GroupVersionKind, mapping.Resource, singular, mapping.Scope)
+		}
+		c.mu.Unlock()
+	}
+	return c.add(&obj, ifMissing)
+}
+
+// add stores a copy of the object as it is, apart from the server-managed
+// metadata it lacks. When ifMissing is set, an object already in the cluster
+// is kept.
+func (c *Cluster) add(obj *unstructured.Unstructured, ifMissing bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key, err := c.keyFor(obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		return err
+	}
+	if _, ok := c.objects[key]; ok {
+		if ifMissing {
+			return nil
+		}
+		return fmt.Errorf("add %s %s: already exists", obj.GetKind(), obj.GetName())
+	}
+	content, err := objutil.ToJSONObject(obj.Object)
+	if err != nil {
+		return fmt.Errorf("add %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	c.store(key, c.initialize(key, content))
+	return nil
+}
+
+// keyFor resolves where objects of the kind are stored, mapping unknown
+// kinds to their guessed plural. It is called with the lock held.
+func (c *Cluster) keyFor(gvk schema.GroupVersionKind, namespace, name string) (objectKey, error) {
+	mapping, err := c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		plural, singular := meta.UnsafeGuessKindToResource(gvk)
+		scope := meta.RESTScopeRoot
+		if namespace != "" {
+			scope = meta.RESTScopeNamespace
+		}
+		c.mapper.AddSpecific(gvk, plural, singular, scope)
+		mapping, err = c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+	if err != nil {
+		return objectKey{}, fmt.Errorf("resolve resource for %s: %w", gvk, err)
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		namespace = ""
+	}
+	return objectKey{resource: mapping.Resource, namespace: namespace, name: name}, nil
+}
+
+// fakeFor returns the fake dynamic client serving the resource. Each resource
+// has its own fake, as the list kind of a fake is fixed when it is created,
+// and all of them are served by the same reactor.
+func (c *Cluster) fakeFor(gvr schema.GroupVersionResource) *dynamicfake.FakeDynamicClient {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if fake, ok := c.fakes[gvr]; ok {
+		return fake
+	}
+
+	listKind := gvr.Resource + "List"
+	if gvk, err := c.mapper.KindFor(gvr); err == nil {
+		listKind = gvk.Kind + "List"
+	}
+	fake := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{gvr: listKind})
+	fake.PrependReactor("*", "*", c.react)
+	c.fakes[gvr] = fake
+	return fake
+}
+
+// react serves the requests of the fake dynamic clients from the store.
+func (c *Cluster) react(action clienttesting.Action) (bool, runtime.Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.actions = append(c.actions, action.DeepCopy())
+
+	gvr, namespace, subresource := action.GetResource(), action.GetNamespace(), action.GetSubresource()
+	switch action := action.(type) {
+	case clienttesting.GetActionImpl:
+		obj, err := c.get(objectKey{gvr, namespace, action.GetName()})
+		return true, obj, err
+	case clienttesting.ListActionImpl:
+		return true, c.list(gvr, namespace, action.GetKind().Kind+"List"), nil
+	case clienttesting.CreateActionImpl:
+		if subresource != "" {
+			return false, nil, nil
+		}
+		obj := action.GetObject().(*unstructured.Unstructured)
+		created, err := c.create(objectKey{gvr, namespace, obj.GetName()}, obj.Object)
+		return true, created, err
+	case clienttesting.UpdateActionImpl:
+		obj := action.GetObject().(*unstructured.Unstructured)
+		updated, err := c.update(objectKey{gvr, namespace, obj.GetName()}, obj.Object, subresource)
+		return true, updated, err
+	case clienttesting.PatchActionImpl:
+		patched, err := c.patch(objectKey{gvr, namespace, action.GetName()}, action.GetPatchType(), action.GetPatch(), subresource)
+		return true, patched, err
+	case clienttesting.DeleteActionImpl:
+		return true, nil, c.delete(objectKey{gvr, namespace, action.GetName()})
+	}
+	return false, nil, nil
+}
+
+func (c *Cluster) get(key objectKey) (*unstructured.Unstructured, error) {
+	obj, ok := c.objects[key]
+	if !ok {
+		return nil, apierrors.NewNotFound(key.resource.GroupResource(), key.name)
+	}
+	return obj.DeepCopy(), nil
+}
+
+// list returns the objects of the resource in the namespace, or in every
+// namespace when it is empty, sorted by namespace and name. The fake client
+// filters them by label.
+func (c *Cluster) list(gvr schema.GroupVersionResource, namespace, listKind string) *unstructured.UnstructuredList {
+	list := &unstructured.UnstructuredList{}
+	list.SetAPIVersion(gvr.GroupVersion().String())
+	list.SetKind(listKind)
+	list.SetResourceVersion(strconv.FormatInt(c.resourceVersion, 10))
+
+	var keys []objectKey
+	for key := range c.objects {
+		if key.resource == gvr && (namespace == "" || key.namespace == namespace) {
+			keys = append(keys, key)
+		}
+	}
+	slices.SortFunc(keys, func(a, b objectKey) int {
+		return strings.Compare(a.namespace+"/"+a.name, b.namespace+"/"+b.name)
+	})
+	for _, key := range keys {
+		list.Items = append(list.Items, *c.objects[key].DeepCopy())
+	}
+	return list
+}
+
+// create stores a new object. Like the API server for custom resources with
+// a status subresource, it drops the status of the object.
+func (c *Cluster) create(key objectKey, content map[string]any) (*unstructured.Unstructured, error) {
+	if _, ok := c.objects[key]; ok {
+		return nil, apierrors.NewAlreadyExists(key.resource.GroupResource(), key.name)
+	}
+	content, err := objutil.ToJSONObject(content)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	delete(content, "status")
+	obj := c.initialize(key, content)
+	obj.SetGeneration(1)
+	c.store(key, obj)
+	return obj.DeepCopy(), nil
+}
+
+// update replaces the stored object with the content, either the status
+// subresource or the object itself.
+func (c *Cluster) update(key objectKey, content map[string]any, subresource string) (*unstructured.Unstructured, error) {
+	existing, ok := c.objects[key]
+	if !ok {
+		return nil, apierrors.NewNotFound(key.resource.GroupResource(), key.name)
+	}
+	content, err := objutil.ToJSONObject(content)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	if err := checkResourceVersion(key, existing, content); err != nil {
+		return nil, err
+	}
+
+	var updated *unstructured.Unstructured
+	switch subresource {
+	case "status":
+		updated = existing.DeepCopy()
+		if status, ok := content["status"]; ok {
+			updated.Object["status"] = status
+		} else {
+			delete(updated.Object, "status")
+		}
+	case "":
+		updated = &unstructured.Unstructured{Object: content}
+		existingMetadata, _ := existing.Object["metadata"].(map[string]any)
+		for _, field := range []string{"name", "namespace", "uid", "creationTimestamp", "generation", "deletionTimestamp"} {
+			if value, ok := existingMetadata[field]; ok {
+				_ = unstructured.SetNestedField(updated.Object, value, "metadata", field)
+			} else {
+				unstructured.RemoveNestedField(updated.Object, "metadata", field)
+			}
+		}
+		if status, ok := existing.Object["status"]; ok {
+			updated.Object["status"] = status
+		} else {
+			delete(updated.Object, "status")
+		}
+		if !reflect.DeepEqual(withoutMetadataAndStatus(existing.Object), withoutMetadataAndStatus(updated.Object)) {
+			updated.SetGeneration(existing.GetGeneration() + 1)
+		}
+	default:
+		return nil, apierrors.NewNotFound(key.resource.GroupResource(), key.name+"/"+subresource)
+	}
+
+	c.store(key, updated)
+	return updated.DeepCopy(), nil
+}
+
+// patch applies the patch to the stored object. Apply patches create the
+// object when it does not exist, as server-side apply does.
+func (c *Cluster) patch(key objectKey, patchType types.PatchType, data []byte, subresource string) (*unstructured.Unstructured, error) {
+	existing, ok := c.objects[key]
+	if !ok {
+		if patchType != types.ApplyPatchType || subresource != "" {
+			return nil, apierrors.NewNotFound(key.resource.GroupResource(), key.name)
+		}
+		content, err := objutil.UnmarshalObject(data)
+		if err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid apply patch: %v", err))
+		}
+		return c.create(key, content)
+	}
+
+	current, err := json.Marshal(existing.Object)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	var patched []byte
+	switch patchType {
+	case types.JSONPatchType:
+		var p jsonpatch.Patch
+		p, err = jsonpatch.DecodePatch(data)
+		if err == nil {
+			patched, err = p.Apply(current)
+		}
+	case types.MergePatchType:
+		patched, err = jsonpatch.MergePatch(current, data)
+	case types.ApplyPatchType:
+		var jsonData []byte
+		jsonData, err = yaml.YAMLToJSON(data)
+		if err == nil {
+			patched, err = jsonpatch.MergePatch(current, jsonData)
+		}
+	default:
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("patch type %s is not supported for custom resources", patchType))
+	}
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid %s patch: %v", patchType, err))
+	}
+
+	var content map[string]any
+	if err := utiljson.Unmarshal(patched, &content); err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid %s patch: %v", patchType, err))
+	}
+	return c.update(key, content, subresource)
+}
+
+func (c *Cluster) delete(key objectKey) error {
+	if _, ok := c.objects[key]; !ok {
+		return apierrors.NewNotFound(key.resource.GroupResource(), key.name)
+	}
+	delete(c.objects, key)
+	return nil
+}
+
+// initialize sets the server-managed metadata the new object lacks.
+func (c *Cluster) initialize(key objectKey, content map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: content}
+	obj.SetName(key.name)
+	obj.SetNamespace(key.namespace)
+	if obj.GetUID() == "" {
+		obj.SetUID(uuid.NewUUID())
+	}
+	if created := obj.GetCreationTimestamp(); created.IsZero() {
+		obj.SetCreationTimestamp(metav1.Now())
+	}
+	if obj.GetGeneration() == 0 {
+		obj.SetGeneration(1)
+	}
+	return obj
+}
+
+// store saves the object with the next resourceVersion.
+func (c *Cluster) store(key objectKey, obj *unstructured.Unstructured) {
+	c.resourceVersion++
+	obj.SetResourceVersion(strconv.FormatInt(c.resourceVersion, 10))
+	c.objects[key] = obj
+}
+
+// checkResourceVersion returns a Conflict error when the content carries a
+// resourceVersion other than the stored one. Writes without a
+// resourceVersion are unconditional.
+func checkResourceVersion(key objectKey, existing *unstructured.Unstructured, content map[string]any) error {
+	rv, _, _ := unstructured.NestedString(content, "metadata", "resourceVersion")
+	if rv == "" || rv == existing.GetResourceVersion() {
+		return nil
+	}
+	return apierrors.NewConflict(key.resource.GroupResource(), key.name, errors.New(conflictMessage))
+}
+
+func withoutMetadataAndStatus(content map[string]any) map[string]any {
+	rest := map[string]any{}
+	for k, v := range content {
+		if k != "metadata" && k != "status" {
+			rest[k] = v
+		}
+	}
+	return rest
+}
+
+// clusterClient is the dynamic client of a Cluster, routing each resource to
+// its fake dynamic client.
+type clusterClient struct {
+	c *Cluster
+}
+
+func (d clusterClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return d.c.fakeFor(gvr).Resource(gvr)
+}
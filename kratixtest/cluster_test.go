@@ -0,0 +1,293 @@
+package kratixtest_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix-go/kratixtest"
+	"github.com/syntasso/kratix/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+var (
+	redisGVK   = schema.GroupVersionKind{Group: "marketplace.kratix.io", Version: "v1alpha1", Kind: "redis"}
+	redisGVR   = schema.GroupVersionResource{Group: "marketplace.kratix.io", Version: "v1alpha1", Resource: "redis"}
+	promiseGVK = v1alpha1.GroupVersion.WithKind("Promise")
+)
+
+func redis(name string, labels map[string]string, readyStatus string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{"size": "small"},
+		"status": map[string]any{
+			"phase": "Pending",
+			"conditions": []any{
+				map[string]any{"type": "Ready", "status": readyStatus, "reason": "Reconciled"},
+			},
+		},
+	}}
+	obj.SetGroupVersionKind(redisGVK)
+	obj.SetName(name)
+	obj.SetNamespace("default")
+	obj.SetLabels(labels)
+	obj.SetGeneration(2)
+	return obj
+}
+
+func patch(client dynamic.ResourceInterface, name string, patchType types.PatchType, data string, subresources ...string) (*unstructured.Unstructured, error) {
+	return client.Patch(context.Background(), name, patchType, []byte(data), metav1.PatchOptions{}, subresources...)
+}
+
+func requiredPromise(version, availability string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"status": map[string]any{"version": version, "status": availability},
+	}}
+	obj.SetGroupVersionKind(promiseGVK)
+	obj.SetName("my-required-promise")
+	return obj
+}
+
+var _ = Describe("Cluster", func() {
+	var cluster *kratixtest.Cluster
+
+	BeforeEach(func() {
+		cluster = kratixtest.NewCluster(GinkgoT())
+	})
+
+	Describe("with the SDK", func() {
+		var (
+			env *kratixtest.Environment
+			sdk *kratix.KratixSDK
+		)
+
+		BeforeEach(func() {
+			env = kratixtest.New(GinkgoT()).
+				WithInput(redis("my-redis", nil, "False")).
+				WithPromiseFile("../assets/input/promise.yaml").
+				WithCluster(cluster).
+				Build()
+			sdk = env.SDK()
+		})
+
+		It("adds the input object and the Promise to the cluster", func() {
+			Expect(env.Cluster()).To(BeIdenticalTo(cluster))
+			Expect(env.Client()).To(BeNil())
+			Expect(cluster.Get(redisGVK, "default", "my-redis")).ToNot(BeNil())
+			Expect(cluster.Get(promiseGVK, "", "my-promise")).ToNot(BeNil())
+		})
+
+		It("merges published statuses into the stored status", func() {
+			before := cluster.Get(redisGVK, "default", "my-redis")
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			status := kratix.NewStatusFromMap(map[string]any{
+				"endpoint": "redis.default.svc",
+				"conditions": []any{
+					map[string]any{"type": "Configured", "status": "True"},
+				},
+			})
+			Expect(sdk.PublishStatus(resource, status)).To(Succeed())
+
+			after := cluster.Get(redisGVK, "default", "my-redis")
+			conditions, _, _ := unstructured.NestedSlice(after.Object, "status", "conditions")
+			Expect(conditions).To(HaveLen(2))
+			Expect(conditions[1]).To(HaveKeyWithValue("lastTransitionTime", Not(BeEmpty())))
+			delete(conditions[1].(map[string]any), "lastTransitionTime")
+			Expect(unstructured.SetNestedSlice(after.Object, conditions, "status", "conditions")).To(Succeed())
+			Expect(after.Object["status"]).To(Equal(map[string]any{
+				"phase":    "Pending",
+				"endpoint": "redis.default.svc",
+				"conditions": []any{
+					map[string]any{"type": "Ready", "status": "False", "reason": "Reconciled"},
+					map[string]any{"type": "Configured", "status": "True"},
+				},
+			}))
+			Expect(after.Object["spec"]).To(Equal(before.Object["spec"]))
+			Expect(after.GetGeneration()).To(Equal(int64(2)))
+			Expect(after.GetResourceVersion()).ToNot(Equal(before.GetResourceVersion()))
+		})
+
+		It("applies published resource changes and keeps the status", func() {
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+			resource.SetLabel("team", "data")
+			Expect(resource.SetValue("spec.size", "large")).To(Succeed())
+
+			Expect(sdk.PublishResource(resource)).To(Succeed())
+
+			after := cluster.Get(redisGVK, "default", "my-redis")
+			Expect(after.GetLabels()).To(Equal(map[string]string{"team": "data"}))
+			Expect(after.Object["spec"]).To(Equal(map[string]any{"size": "large"}))
+			Expect(after.Object["status"]).To(HaveKeyWithValue("phase", "Pending"))
+			Expect(after.GetGeneration()).To(Equal(int64(3)))
+		})
+
+		It("reports the input as stale once its spec changes", func() {
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sdk.IsStale(resource)).To(BeFalse())
+
+			live := cluster.Get(redisGVK, "default", "my-redis")
+			live.SetAnnotations(map[string]string{"note": "metadata only"})
+			Expect(cluster.Update(live).GetGeneration()).To(Equal(int64(2)))
+			Expect(sdk.IsStale(resource)).To(BeFalse())
+
+			live = cluster.Get(redisGVK, "default", "my-redis")
+			Expect(unstructured.SetNestedField(live.Object, "large", "spec", "size")).To(Succeed())
+			Expect(cluster.Update(live).GetGeneration()).To(Equal(int64(3)))
+			Expect(sdk.IsStale(resource)).To(BeTrue())
+		})
+
+		It("checks the required Promises stored in the cluster", func() {
+			promise, err := sdk.FetchPromise()
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := sdk.CheckRequiredPromises(promise)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Fulfilled).To(BeFalse())
+			Expect(result.RequiredPromises[0].State).To(Equal(kratix.RequirementStateNotInstalled))
+
+			cluster.WithResource(requiredPromise("v1.0.0", v1alpha1.PromiseStatusAvailable))
+			result, err = sdk.CheckRequiredPromises(promise)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Fulfilled).To(BeTrue())
+		})
+
+		It("aggregates the status of the children listed from the cluster", func() {
+			parent, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+			childLabels := map[string]string{
+				kratix.ParentPromiseLabel:   "my-promise",
+				kratix.ParentNameLabel:      "my-redis",
+				kratix.ParentNamespaceLabel: "default",
+			}
+			cluster.WithResource(
+				redis("child-a", childLabels, "True"),
+				redis("child-b", childLabels, "False"),
+				redis("unrelated", map[string]string{"app": "other"}, "True"),
+			)
+
+			children, err := sdk.AggregateChildStatus(parent, "my-promise")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(children.Children).To(HaveLen(2))
+			Expect(children.Children[0].Name).To(Equal("child-a"))
+			Expect(children.Children[0].Ready).To(BeTrue())
+			Expect(children.Children[1].Name).To(Equal("child-b"))
+			Expect(children.Ready()).To(BeFalse())
+		})
+	})
+
+	It("fetches the Promise named by the environment from the cluster", func() {
+		cluster.WithPromiseFile("../assets/input/promise.yaml")
+		sdk := kratixtest.New(GinkgoT()).
+			WithEnv(kratixtest.PromiseNameEnv, "my-promise").
+			WithCluster(cluster).
+			Build().
+			SDK()
+
+		promise, err := sdk.FetchPromise()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(promise.GetName()).To(Equal("my-promise"))
+		Expect(promise.GetLabels()).To(HaveKeyWithValue("kratix.io/promise-version", "v0.1.0"))
+	})
+
+	Describe("its client", func() {
+		client := func() dynamic.ResourceInterface {
+			return cluster.Client().Resource(redisGVR).Namespace("default")
+		}
+
+		BeforeEach(func() {
+			cluster.WithPromiseFile("../assets/input/promise.yaml").
+				WithResource(redis("my-redis", nil, "False"))
+		})
+
+		It("applies JSON patches", func() {
+			replaceSize := `[{"op": "replace", "path": "/spec/size", "value": "large"}]`
+			obj, err := patch(client(), "my-redis", types.JSONPatchType, replaceSize)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(obj.Object["spec"]).To(Equal(map[string]any{"size": "large"}))
+
+			_, err = patch(client(), "my-redis", types.JSONPatchType, `[{"op": "remove", "path": "/spec/missing"}]`)
+			Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+		})
+
+		It("only changes the status through the status subresource", func() {
+			obj, err := patch(client(), "my-redis", types.MergePatchType, `{"spec": {"size": "large"}, "status": {"phase": "Ignored"}}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(obj.Object["status"]).To(HaveKeyWithValue("phase", "Pending"))
+
+			obj, err = patch(client(), "my-redis", types.MergePatchType, `{"spec": {"size": "small"}, "status": {"phase": "Ready"}}`, "status")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(obj.Object["spec"]).To(Equal(map[string]any{"size": "large"}))
+			Expect(obj.Object["status"]).To(HaveKeyWithValue("phase", "Ready"))
+		})
+
+		It("applies apply patches, creating missing objects", func() {
+			obj, err := patch(client(), "my-redis", types.ApplyPatchType, "metadata:\n  labels:\n    team: data\n", "status")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(obj.GetLabels()).To(BeEmpty())
+
+			obj, err = patch(client(), "new-redis", types.ApplyPatchType, "apiVersion: marketplace.kratix.io/v1alpha1\nkind: redis\nspec:\n  size: large\nstatus:\n  phase: Ignored\n")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(obj.GetGeneration()).To(Equal(int64(1)))
+			Expect(obj.Object).ToNot(HaveKey("status"))
+			Expect(cluster.Get(redisGVK, "default", "new-redis")).ToNot(BeNil())
+
+			_, err = patch(client(), "missing", types.MergePatchType, `{"spec": {}}`)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("rejects writes with a stale resourceVersion", func() {
+			stale := cluster.Get(redisGVK, "default", "my-redis")
+			_, err := patch(client(), "my-redis", types.MergePatchType, `{"status": {"phase": "Ready"}}`, "status")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = patch(client(), "my-redis", types.MergePatchType, `{"metadata": {"resourceVersion": "`+stale.GetResourceVersion()+`"}, "spec": {"size": "large"}}`)
+			Expect(apierrors.IsConflict(err)).To(BeTrue())
+			_, err = client().Update(context.Background(), stale, metav1.UpdateOptions{})
+			Expect(apierrors.IsConflict(err)).To(BeTrue())
+
+			current := cluster.Get(redisGVK, "default", "my-redis")
+			Expect(current.Object["spec"]).To(Equal(map[string]any{"size": "small"}))
+			Expect(current.Object["status"]).To(HaveKeyWithValue("phase", "Ready"))
+			Expect(unstructured.SetNestedField(current.Object, "large", "spec", "size")).To(Succeed())
+			_, err = client().Update(context.Background(), current, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("lists preloaded Destinations by label", func() {
+			cluster.WithDestination(&v1alpha1.Destination{ObjectMeta: metav1.ObjectMeta{
+				Name: "dev", Labels: map[string]string{"environment": "dev"},
+			}}).WithDestination(&v1alpha1.Destination{ObjectMeta: metav1.ObjectMeta{
+				Name: "prod", Labels: map[string]string{"environment": "prod"},
+			}})
+
+			destinations, err := cluster.Client().Resource(v1alpha1.GroupVersion.WithResource("destinations")).
+				List(context.Background(), metav1.ListOptions{LabelSelector: "environment=dev"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(destinations.Items).To(HaveLen(1))
+			Expect(destinations.Items[0].GetName()).To(Equal("dev"))
+		})
+
+		It("records the requests made to it", func() {
+			_, err := client().Get(context.Background(), "my-redis", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = patch(client(), "my-redis", types.MergePatchType, `{"status": {"phase": "Ready"}}`, "status")
+			Expect(err).ToNot(HaveOccurred())
+
+			actions := cluster.Actions()
+			Expect(actions).To(HaveLen(2))
+			Expect(actions[0].GetVerb()).To(Equal("get"))
+			Expect(actions[1].GetVerb()).To(Equal("patch"))
+			Expect(actions[1].GetSubresource()).To(Equal("status"))
+		})
+	})
+})
@@ -0,0 +1,322 @@
+package kratixtest
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix-go/internal/objutil"
+	"sigs.k8s.io/yaml"
+)
+
+// UpdateFlag is the name of the boolean test flag that makes CompareGolden
+// write the golden files instead of comparing them, registered by the tests
+// with e.g. flag.Bool(kratixtest.UpdateFlag, false, "update golden files")
+// and set with go test -update.
+const UpdateFlag = "update"
+
+// UpdateEnv is the environment variable that, set to true or 1, makes
+// CompareGolden write the golden files when the -update flag is not set.
+const UpdateEnv = "KRATIXTEST_UPDATE"
+
+// goldenSubdirs are the subdirectories of a golden directory, the only ones
+// written when updating it.
+var goldenSubdirs = []string{"output", "metadata"}
+
+// Placeholders replacing the values normalised in golden files.
+const (
+	TimestampPlaceholder = "<timestamp>"
+	SecretPlaceholder    = "<secret>"
+	IgnoredPlaceholder   = "<ignored>"
+)
+
+// Normalizer rewrites a document of a YAML file before it is compared with
+// the golden file, e.g. to replace values that change on every run. The file
+// is relative to the golden directory, e.g. output/app/deployment.yaml.
+type Normalizer func(file string, doc any) any
+
+// GoldenOption configures CompareGolden.
+type GoldenOption func(*goldenOptions)
+
+type goldenOptions struct {
+	normalizers []Normalizer
+	update      bool
+}
+
+// WithNormalizer adds a Normalizer, applied after the default ones.
+func WithNormalizer(normalizer Normalizer) GoldenOption {
+	return func(o *goldenOptions) { o.normalizers = append(o.normalizers, normalizer) }
+}
+
+// IgnorePaths replaces the values at the dotted paths of every document,
+// e.g. metadata.annotations.checksum, with IgnoredPlaceholder. Paths go
+// through every item of lists.
+func IgnorePaths(paths ...string) GoldenOption {
+	return WithNormalizer(func(_ string, doc any) any {
+		for _, path := range paths {
+			ignorePath(doc, strings.Split(path, "."))
+		}
+		return doc
+	})
+}
+
+// UpdateGolden writes the golden files instead of comparing them, as the
+// -update flag and UpdateEnv do.
+func UpdateGolden() GoldenOption {
+	return func(o *goldenOptions) { o.update = true }
+}
+
+// RunGolden runs the handler in an Environment with the input fixture and
+// asserts the output and metadata directories match the golden directory.
+func RunGolden(t T, inputFile, goldenDir string, handler func(*kratix.KratixSDK) error, opts ...GoldenOption) *Environment {
+	t.Helper()
+	env := New(t).WithInputFile(inputFile).Build()
+	if err := handler(env.SDK()); err != nil {
+		t.Fatalf("kratixtest: run handler: %v", err)
+	}
+	env.AssertGolden(goldenDir, opts...)
+	return env
+}
+
+// AssertGolden fails the test if the output and metadata directories do not
+// match the golden directory. See CompareGolden.
+func (e *Environment) AssertGolden(goldenDir string, opts ...GoldenOption) {
+	e.t.Helper()
+	if err := e.CompareGolden(goldenDir, opts...); err != nil {
+		e.t.Fatalf("kratixtest: %v", err)
+	}
+}
+
+// CompareGolden compares the full contents of the output and metadata
+// directories with the output and metadata subdirectories of the golden
+// directory. YAML files are compared semantically, after sorting keys and
+// replacing timestamps and the data of Secrets with placeholders, and
+// mismatches are reported as diffs of the normalised YAML. With the -update
+// flag, UpdateEnv or UpdateGolden set, the output and metadata subdirectories of the golden
+// directory are rewritten instead.
+func (e *Environment) CompareGolden(goldenDir string, opts ...GoldenOption) error {
+	o := &goldenOptions{normalizers: []Normalizer{normalizeTimestamps, normalizeSecrets}}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	actual := map[string][]byte{}
+	for name, dir := range map[string]string{"output": e.outputDir, "metadata": e.metadataDir} {
+		files, err := readFiles(dir)
+		if err != nil {
+			return err
+		}
+		for file, data := range files {
+			file = name + "/" + file
+			if actual[file], err = normalizeFile(file, data, o.normalizers); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.update || updateRequested() {
+		return writeGolden(goldenDir, actual)
+	}
+
+	golden, err := readGolden(goldenDir)
+	if err != nil {
+		return fmt.Errorf("read golden files: %w (run the tests with -%s or %s=1 to create them)", err, UpdateFlag, UpdateEnv)
+	}
+	for file, data := range golden {
+		if golden[file], err = normalizeFile(file, data, o.normalizers); err != nil {
+			return fmt.Errorf("golden file %w", err)
+		}
+	}
+	var mismatches []string
+	for _, file := range slices.Sorted(maps.Keys(union(actual, golden))) {
+		want, inGolden := golden[file]
+		got, inActual := actual[file]
+		switch {
+		case !inActual:
+			mismatches = append(mismatches, fmt.Sprintf("%s: missing, expected by the golden file", file))
+		case !inGolden:
+			mismatches = append(mismatches, fmt.Sprintf("%s: unexpected file, not in the golden directory", file))
+		case !bytes.Equal(want, got):
+			mismatches = append(mismatches, fmt.Sprintf("%s:\n%s", file, diffLines(lines(want), lines(got))))
+		}
+	}
+	if len(mismatches) > 0 {
+		return fmt.Errorf("outputs do not match the golden directory %s (run the tests with -%s or %s=1 to regenerate it):\n%s",
+			goldenDir, UpdateFlag, UpdateEnv, strings.Join(mismatches, "\n"))
+	}
+	return nil
+}
+
+// updateRequested returns true when the -update flag registered by the tests
+// is set, falling back to UpdateEnv.
+func updateRequested() bool {
+	if f := flag.Lookup(UpdateFlag); f != nil {
+		if getter, ok := f.Value.(flag.Getter); ok {
+			if update, _ := getter.Get().(bool); update {
+				return true
+			}
+		}
+	}
+	update, _ := strconv.ParseBool(os.Getenv(UpdateEnv))
+	return update
+}
+
+// readFiles returns the content of the files under the directory, by path
+// relative to it.
+func readFiles(dir string) (map[string][]byte, error) {
+	files, err := outputFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	contents := map[string][]byte{}
+	for _, file := range files {
+		if contents[file], err = os.ReadFile(filepath.Join(dir, file)); err != nil {
+			return nil, err
+		}
+	}
+	return contents, nil
+}
+
+// readGolden returns the content of the files in the subdirectories of the
+// golden directory, by path relative to it. A missing subdirectory has no
+// files, but the golden directory must exist.
+func readGolden(goldenDir string) (map[string][]byte, error) {
+	if _, err := os.Stat(goldenDir); err != nil {
+		return nil, err
+	}
+	contents := map[string][]byte{}
+	for _, subdir := range goldenSubdirs {
+		dir := filepath.Join(goldenDir, subdir)
+		if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		files, err := readFiles(dir)
+		if err != nil {
+			return nil, err
+		}
+		for file, data := range files {
+			contents[subdir+"/"+file] = data
+		}
+	}
+	return contents, nil
+}
+
+// writeGolden replaces the subdirectories of the golden directory with the
+// files, leaving anything else in the golden directory alone.
+func writeGolden(goldenDir string, files map[string][]byte) error {
+	for _, subdir := range goldenSubdirs {
+		if err := os.RemoveAll(filepath.Join(goldenDir, subdir)); err != nil {
+			return fmt.Errorf("update golden files: %w", err)
+		}
+	}
+	for file, data := range files {
+		path := filepath.Join(goldenDir, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return fmt.Errorf("update golden files: %w", err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return fmt.Errorf("update golden files: %w", err)
+		}
+	}
+	return nil
+}
+
+// normalizeFile returns the canonical YAML of the normalised documents of
+// YAML files, and the content of other files as is.
+func normalizeFile(file string, data []byte, normalizers []Normalizer) ([]byte, error) {
+	if !objutil.IsYAMLFile(file) {
+		return data, nil
+	}
+	docs, err := decodeDocuments(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	var buf bytes.Buffer
+	for i, doc := range docs {
+		for _, normalize := range normalizers {
+			doc = normalize(file, doc)
+		}
+		out, err := yaml.Marshal(doc)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(out)
+	}
+	return buf.Bytes(), nil
+}
+
+// normalizeTimestamps replaces RFC 3339 timestamps with TimestampPlaceholder.
+func normalizeTimestamps(_ string, doc any) any {
+	switch v := doc.(type) {
+	case map[string]any:
+		for k, value := range v {
+			v[k] = normalizeTimestamps("", value)
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = normalizeTimestamps("", value)
+		}
+	case string:
+		if _, err := time.Parse(time.RFC3339, v); err == nil {
+			return TimestampPlaceholder
+		}
+	}
+	return doc
+}
+
+// normalizeSecrets replaces the values of the data of Secrets, often
+// generated, with SecretPlaceholder.
+func normalizeSecrets(_ string, doc any) any {
+	obj, ok := doc.(map[string]any)
+	if !ok || obj["kind"] != "Secret" || obj["apiVersion"] != "v1" {
+		return doc
+	}
+	for _, field := range []string{"data", "stringData"} {
+		data, _ := obj[field].(map[string]any)
+		for k := range data {
+			data[k] = SecretPlaceholder
+		}
+	}
+	return doc
+}
+
+func ignorePath(doc any, path []string) {
+	switch v := doc.(type) {
+	case []any:
+		for _, item := range v {
+			ignorePath(item, path)
+		}
+	case map[string]any:
+		value, ok := v[path[0]]
+		if !ok {
+			return
+		}
+		if len(path) == 1 {
+			v[path[0]] = IgnoredPlaceholder
+			return
+		}
+		ignorePath(value, path[1:])
+	}
+}
+
+func union(a, b map[string][]byte) map[string][]byte {
+	all := maps.Clone(a)
+	maps.Copy(all, b)
+	return all
+}
+
+func lines(data []byte) []string {
+	return strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+}
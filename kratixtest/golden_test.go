@@ -0,0 +1,152 @@
+package kratixtest_test
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix-go/kratixtest"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const goldenDir = "testdata/golden/database"
+
+var _ = flag.Bool(kratixtest.UpdateFlag, false, "update the golden files")
+
+// databaseWorkflow writes a ConfigMap and a Secret with a generated password,
+// and a status with the time it ran.
+func databaseWorkflow(sdk *kratix.KratixSDK) error {
+	resource, err := sdk.ReadResourceInput()
+	if err != nil {
+		return err
+	}
+	password := make([]byte, 16)
+	if _, err := rand.Read(password); err != nil {
+		return err
+	}
+	objs := []*unstructured.Unstructured{
+		{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": resource.GetName(), "annotations": map[string]any{"checksum": hex.EncodeToString(password)}},
+			"data":       map[string]any{"size": "small"},
+		}},
+		{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]any{"name": resource.GetName() + "-credentials"},
+			"stringData": map[string]any{"password": hex.EncodeToString(password)},
+		}},
+	}
+	if err := sdk.WriteObjects("database/resources.yaml", objs); err != nil {
+		return err
+	}
+	if err := sdk.WriteOutput("README.md", []byte("database for "+resource.GetName()+"\n")); err != nil {
+		return err
+	}
+	return sdk.WriteStatus(kratix.NewStatusFromMap(map[string]any{
+		"message": "provisioned",
+		"conditions": []any{map[string]any{
+			"type":               "Ready",
+			"status":             "True",
+			"lastTransitionTime": time.Now().UTC().Format(time.RFC3339),
+		}},
+	}))
+}
+
+var _ = Describe("Golden files", func() {
+	It("matches the outputs of a run with the golden directory", func() {
+		kratixtest.RunGolden(GinkgoT(), "../assets/input/resource.yaml", goldenDir, databaseWorkflow,
+			kratixtest.IgnorePaths("metadata.annotations.checksum"))
+	})
+
+	It("reports the differences with the golden directory", func() {
+		env := kratixtest.New(GinkgoT()).WithInputFile("../assets/input/resource.yaml").Build()
+		Expect(databaseWorkflow(env.SDK())).To(Succeed())
+		Expect(os.Remove(filepath.Join(env.OutputDir(), "README.md"))).To(Succeed())
+		Expect(env.SDK().WriteOutput("extra.txt", []byte("extra"))).To(Succeed())
+		Expect(env.SDK().WriteStatus(kratix.NewStatusFromMap(map[string]any{"message": "failed"}))).To(Succeed())
+
+		err := env.CompareGolden(goldenDir, kratixtest.IgnorePaths("metadata.annotations.checksum"))
+		Expect(err).To(MatchError(ContainSubstring("outputs do not match the golden directory " + goldenDir)))
+		Expect(err).To(MatchError(ContainSubstring("metadata/status.yaml:\n    (-expected +actual)\n    - conditions:\n")))
+		Expect(err).To(MatchError(ContainSubstring("    - message: provisioned\n    + message: failed")))
+		Expect(err).To(MatchError(ContainSubstring("output/README.md: missing, expected by the golden file")))
+		Expect(err).To(MatchError(ContainSubstring("output/extra.txt: unexpected file, not in the golden directory")))
+	})
+
+	It("normalises generated values and key ordering", func() {
+		golden := GinkgoT().TempDir()
+		Expect(os.MkdirAll(filepath.Join(golden, "output"), 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(golden, "output", "secret.yaml"), []byte(`kind: Secret
+apiVersion: v1
+data: {token: c2VjcmV0}
+metadata: {name: token, creationTimestamp: "2020-01-01T00:00:00Z"}
+`), 0o644)).To(Succeed())
+
+		env := kratixtest.New(GinkgoT()).Build()
+		Expect(env.SDK().WriteOutput("secret.yaml", []byte(`apiVersion: v1
+kind: Secret
+metadata:
+  creationTimestamp: "2025-06-01T12:00:00Z"
+  name: token
+data:
+  token: b3RoZXI=
+`))).To(Succeed())
+
+		Expect(env.CompareGolden(golden)).To(Succeed())
+	})
+
+	It("writes the golden directory when updating", func() {
+		golden := filepath.Join(GinkgoT().TempDir(), "golden")
+		env := kratixtest.New(GinkgoT()).WithInputFile("../assets/input/resource.yaml").Build()
+		Expect(databaseWorkflow(env.SDK())).To(Succeed())
+
+		Expect(env.CompareGolden(golden, kratixtest.UpdateGolden())).To(Succeed())
+		Expect(filepath.Join(golden, "output", "README.md")).To(BeAnExistingFile())
+		status, err := os.ReadFile(filepath.Join(golden, "metadata", "status.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(status)).To(ContainSubstring("lastTransitionTime: <timestamp>"))
+
+		Expect(env.CompareGolden(golden)).To(Succeed())
+	})
+
+	It("only rewrites the output and metadata subdirectories when updating", func() {
+		golden := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(golden, "input.yaml"), []byte("kept"), 0o644)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(golden, "output", "stale"), 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(golden, "output", "stale", "old.yaml"), []byte("{}"), 0o644)).To(Succeed())
+		env := kratixtest.New(GinkgoT()).WithInputFile("../assets/input/resource.yaml").Build()
+		Expect(databaseWorkflow(env.SDK())).To(Succeed())
+
+		GinkgoT().Setenv(kratixtest.UpdateEnv, "1")
+		Expect(env.CompareGolden(golden)).To(Succeed())
+
+		Expect(filepath.Join(golden, "input.yaml")).To(BeAnExistingFile())
+		Expect(filepath.Join(golden, "output", "stale")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(golden, "output", "README.md")).To(BeAnExistingFile())
+	})
+
+	It("errors when the golden directory does not exist", func() {
+		env := kratixtest.New(GinkgoT()).Build()
+		Expect(env.CompareGolden("testdata/golden/missing")).To(MatchError(ContainSubstring("run the tests with -update or KRATIXTEST_UPDATE=1 to create them")))
+	})
+
+	It("writes the golden directory when the -update flag is set", func() {
+		golden := filepath.Join(GinkgoT().TempDir(), "golden")
+		env := kratixtest.New(GinkgoT()).WithInputFile("../assets/input/resource.yaml").Build()
+		Expect(databaseWorkflow(env.SDK())).To(Succeed())
+
+		Expect(flag.Set(kratixtest.UpdateFlag, "true")).To(Succeed())
+		DeferCleanup(flag.Set, kratixtest.UpdateFlag, "false")
+		Expect(env.CompareGolden(golden)).To(Succeed())
+		Expect(filepath.Join(golden, "output", "README.md")).To(BeAnExistingFile())
+	})
+})
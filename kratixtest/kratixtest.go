@@ -0,0 +1,578 @@
+// Package kratixtest builds isolated workflow environments for unit tests of
+// Kratix workflows. A Builder lays out the input, metadata and output
+// directories in a temporary directory, sets the Kratix environment variables
+// and wires a fake Kubernetes client. The resulting Environment returns an SDK
+// pointing at it and exposes what the workflow wrote and published as parsed
+// structures for assertions. Everything is cleaned up with t.Cleanup.
+package kratixtest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix-go/internal/objutil"
+	kratixgofakes "github.com/syntasso/kratix-go/kratix-gofakes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+// T is the subset of testing.TB used by the harness, satisfied by *testing.T
+// and GinkgoT().
+type T interface {
+	Helper()
+	Cleanup(func())
+	Fatalf(format string, args ...any)
+	TempDir() string
+	Setenv(key, value string)
+}
+
+// The environment variables Kratix sets for workflows.
+const (
+	WorkflowTypeEnv   = "KRATIX_WORKFLOW_TYPE"
+	WorkflowActionEnv = "KRATIX_WORKFLOW_ACTION"
+	PromiseNameEnv    = "KRATIX_PROMISE_NAME"
+	PipelineNameEnv   = "KRATIX_PIPELINE_NAME"
+)
+
+const (
+	inputObjectFile          = "object.yaml"
+	statusFile               = "status.yaml"
+	destinationSelectorsFile = "destination-selectors.yaml"
+)
+
+// Builder configures a workflow Environment. By default it is a resource
+// configure workflow with no input object.
+type Builder struct {
+	t T
+
+	input        map[string]any
+	promise      kratix.Promise
+	env          map[string]string
+	metadata     map[string][]byte
+	objectClient kratix.ResourceInterface
+	cluster      *Cluster
+	errs         []error
+}
+
+// New returns a Builder for a workflow environment cleaned up at the end of
+// the test.
+func New(t T) *Builder {
+	return &Builder{
+		t: t,
+		env: map[string]string{
+			WorkflowTypeEnv:   "resource",
+			WorkflowActionEnv: "configure",
+			PromiseNameEnv:    "",
+			PipelineNameEnv:   "",
+		},
+		metadata: map[string][]byte{},
+	}
+}
+
+// WithInput sets the input object of the workflow.
+func (b *Builder) WithInput(obj *unstructured.Unstructured) *Builder {
+	b.input = obj.DeepCopy().Object
+	return b
+}
+
+// WithInputFile sets the input object of the workflow from a YAML file, e.g. a
+// fixture or an example generated with the codegen package.
+func (b *Builder) WithInputFile(path string) *Builder {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		b.errs = append(b.errs, fmt.Errorf("read input: %w", err))
+		return b
+	}
+	obj, err := objutil.UnmarshalObject(data)
+	if err != nil {
+		b.errs = append(b.errs, fmt.Errorf("unmarshal input %s: %w", path, err))
+		return b
+	}
+	b.input = obj
+	return b
+}
+
+// WithPromise sets the Promise of the workflow. FetchPromise returns it, the
+// Promise name environment variable is set from it, and it is the input
+// object of promise workflows unless another input is set.
+func (b *Builder) WithPromise(promise kratix.Promise) *Builder {
+	b.promise = promise
+	b.env[PromiseNameEnv] = promise.GetName()
+	return b
+}
+
+// WithPromiseFile sets the Promise of the workflow from a YAML file.
+func (b *Builder) WithPromiseFile(path string) *Builder {
+	promise, err := kratix.ReadPromiseFile(path)
+	if err != nil {
+		b.errs = append(b.errs, err)
+		return b
+	}
+	return b.WithPromise(promise)
+}
+
+// WithWorkflow sets the workflow type, promise or resource, and action,
+// configure or delete.
+func (b *Builder) WithWorkflow(workflowType, action string) *Builder {
+	b.env[WorkflowTypeEnv] = workflowType
+	b.env[WorkflowActionEnv] = action
+	return b
+}
+
+// WithPipelineName sets the name of the running pipeline.
+func (b *Builder) WithPipelineName(name string) *Builder {
+	b.env[PipelineNameEnv] = name
+	return b
+}
+
+// WithEnv sets an environment variable for the workflow.
+func (b *Builder) WithEnv(key, value string) *Builder {
+	b.env[key] = value
+	return b
+}
+
+// WithStatus sets the status.yaml in the metadata directory before the
+// workflow runs, e.g. written by a previous pipeline.
+func (b *Builder) WithStatus(status map[string]any) *Builder {
+	return b.withMetadataYAML(statusFile, status)
+}
+
+// WithDestinationSelectors sets the destination-selectors.yaml in the
+// metadata directory before the workflow runs.
+func (b *Builder) WithDestinationSelectors(selectors ...kratix.DestinationSelector) *Builder {
+	return b.withMetadataYAML(destinationSelectorsFile, selectors)
+}
+
+// WithMetadataFile sets a file in the metadata directory before the workflow
+// runs.
+func (b *Builder) WithMetadataFile(relPath string, content []byte) *Builder {
+	b.metadata[relPath] = content
+	return b
+}
+
+// WithObjectClient replaces the fake client of the Environment, e.g. with a
+// client backed by an in-memory cluster.
+func (b *Builder) WithObjectClient(client kratix.ResourceInterface) *Builder {
+	b.objectClient = client
+	return b
+}
+
+// WithCluster replaces the fake client of the Environment with the Cluster,
+// so the SDK reads and patches the objects it stores. The input object and
+// the Promise are added to the Cluster unless it already has them.
+func (b *Builder) WithCluster(cluster *Cluster) *Builder {
+	b.cluster = cluster
+	return b
+}
+
+func (b *Builder) withMetadataYAML(relPath string, v any) *Builder {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		b.errs = append(b.errs, fmt.Errorf("marshal %s: %w", relPath, err))
+		return b
+	}
+	return b.WithMetadataFile(relPath, data)
+}
+
+// Build lays out the environment and sets its environment variables, failing
+// the test if it cannot.
+func (b *Builder) Build() *Environment {
+	b.t.Helper()
+	if err := errors.Join(b.errs...); err != nil {
+		b.t.Fatalf("kratixtest: %v", err)
+	}
+
+	root := b.t.TempDir()
+	e := &Environment{
+		t:           b.t,
+		root:        root,
+		inputDir:    filepath.Join(root, "input"),
+		outputDir:   filepath.Join(root, "output"),
+		metadataDir: filepath.Join(root, "metadata"),
+		input:       b.input,
+	}
+	for _, dir := range []string{e.inputDir, e.outputDir, e.metadataDir} {
+		e.must(os.MkdirAll(dir, 0o755))
+	}
+
+	opts := []kratix.Option{
+		kratix.WithInputDir(e.inputDir),
+		kratix.WithOutputDir(e.outputDir),
+		kratix.WithMetadataDir(e.metadataDir),
+	}
+	if b.promise != nil {
+		promiseFile := filepath.Join(root, "promise.yaml")
+		e.must(objutil.WriteYAML(promiseFile, b.promise.GetPromise()))
+		opts = append(opts, kratix.WithPromiseFile(promiseFile))
+		if e.input == nil && b.env[WorkflowTypeEnv] == "promise" {
+			obj, err := b.promise.GetPromise().ToUnstructured()
+			e.must(err)
+			e.input = obj.Object
+		}
+	}
+	if e.input != nil {
+		e.must(objutil.WriteYAML(filepath.Join(e.inputDir, inputObjectFile), e.input))
+	}
+	for relPath, content := range b.metadata {
+		path := filepath.Join(e.metadataDir, relPath)
+		e.must(os.MkdirAll(filepath.Dir(path), 0o755))
+		e.must(os.WriteFile(path, content, 0o644))
+	}
+
+	switch {
+	case b.objectClient != nil:
+		opts = append(opts, kratix.WithObjectClient(b.objectClient))
+	case b.cluster != nil:
+		e.cluster = b.cluster
+		if b.promise != nil {
+			e.must(b.cluster.addPromise(b.promise, true))
+		}
+		if e.input != nil {
+			e.must(b.cluster.add(&unstructured.Unstructured{Object: e.input}, true))
+		}
+		opts = append(opts, b.cluster.Options()...)
+	default:
+		e.client = &kratixgofakes.FakeResourceInterface{}
+		e.client.GetStub = e.getInput
+		opts = append(opts, kratix.WithObjectClient(e.client))
+	}
+
+	for key, value := range b.env {
+		b.t.Setenv(key, value)
+	}
+	e.sdk = kratix.New(opts...)
+	return e
+}
+
+// Environment is an isolated workflow environment.
+type Environment struct {
+	t       T
+	sdk     *kratix.KratixSDK
+	client  *kratixgofakes.FakeResourceInterface
+	cluster *Cluster
+	input   map[string]any
+
+	root        string
+	inputDir    string
+	outputDir   string
+	metadataDir string
+}
+
+// PublishedStatus is a status published with PublishStatus.
+type PublishedStatus struct {
+	Name   string
+	Status map[string]any
+}
+
+// PublishedResource is a change published with PublishResource.
+type PublishedResource struct {
+	Name  string
+	Patch map[string]any
+}
+
+// SDK returns an SDK reading and writing the environment.
+func (e *Environment) SDK() *kratix.KratixSDK { return e.sdk }
+
+// Client returns the fake Kubernetes client of the environment, or nil when
+// it was replaced with WithObjectClient or WithCluster. Its Get returns the
+// input object.
+func (e *Environment) Client() *kratixgofakes.FakeResourceInterface { return e.client }
+
+// Cluster returns the Cluster set with WithCluster, or nil.
+func (e *Environment) Cluster() *Cluster { return e.cluster }
+
+// InputDir returns the path of the input directory.
+func (e *Environment) InputDir() string { return e.inputDir }
+
+// OutputDir returns the path of the output directory.
+func (e *Environment) OutputDir() string { return e.outputDir }
+
+// MetadataDir returns the path of the metadata directory.
+func (e *Environment) MetadataDir() string { return e.metadataDir }
+
+// OutputFiles returns the paths of the files written to the output
+// directory, relative to it and sorted.
+func (e *Environment) OutputFiles() []string {
+	e.t.Helper()
+	files, err := outputFiles(e.outputDir)
+	e.must(err)
+	return files
+}
+
+// Output returns the content of the file written to the output directory.
+func (e *Environment) Output(relPath string) []byte {
+	e.t.Helper()
+	data, err := os.ReadFile(filepath.Join(e.outputDir, relPath))
+	e.must(err)
+	return data
+}
+
+// OutputObjects returns the objects in the YAML file written to the output
+// directory.
+func (e *Environment) OutputObjects(relPath string) []*unstructured.Unstructured {
+	e.t.Helper()
+	objs, err := decodeObjects(e.Output(relPath))
+	if err != nil {
+		e.t.Fatalf("kratixtest: output %s: %v", relPath, err)
+	}
+	return objs
+}
+
+// AllOutputObjects returns the objects in every YAML file written to the
+// output directory, in file order.
+func (e *Environment) AllOutputObjects() []*unstructured.Unstructured {
+	e.t.Helper()
+	written, err := writtenObjects(e.outputDir)
+	e.must(err)
+	objs := make([]*unstructured.Unstructured, 0, len(written))
+	for _, w := range written {
+		objs = append(objs, w.obj)
+	}
+	return objs
+}
+
+// Status returns the status.yaml written to the metadata directory, or nil if
+// there is none.
+func (e *Environment) Status() map[string]any {
+	e.t.Helper()
+	status, err := readStatus(e.metadataDir)
+	e.must(err)
+	return status
+}
+
+// DestinationSelectors returns the destination selectors written to the
+// metadata directory, or nil if there are none.
+func (e *Environment) DestinationSelectors() []kratix.DestinationSelector {
+	e.t.Helper()
+	selectors, err := readDestinationSelectors(e.metadataDir)
+	e.must(err)
+	return selectors
+}
+
+// PublishedStatuses returns the statuses published with PublishStatus through
+// the fake client, in order.
+func (e *Environment) PublishedStatuses() []PublishedStatus {
+	e.t.Helper()
+	statuses, err := publishedStatuses(e.fakeClient())
+	e.must(err)
+	return statuses
+}
+
+// PublishedResources returns the changes published with PublishResource
+// through the fake client, in order.
+func (e *Environment) PublishedResources() []PublishedResource {
+	e.t.Helper()
+	resources, err := publishedResources(e.fakeClient())
+	e.must(err)
+	return resources
+}
+
+func (e *Environment) fakeClient() *kratixgofakes.FakeResourceInterface {
+	e.t.Helper()
+	if e.client == nil {
+		e.t.Fatalf("kratixtest: the fake client was replaced with WithObjectClient or WithCluster")
+	}
+	return e.client
+}
+
+// getInput is the Get of the fake client, returning the input object.
+func (e *Environment) getInput(_ context.Context, name string, _ metav1.GetOptions, _ ...string) (*unstructured.Unstructured, error) {
+	if e.input != nil {
+		obj := &unstructured.Unstructured{Object: e.input}
+		if obj.GetName() == name {
+			return obj.DeepCopy(), nil
+		}
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+}
+
+func (e *Environment) must(err error) {
+	e.t.Helper()
+	if err != nil {
+		e.t.Fatalf("kratixtest: %v", err)
+	}
+}
+
+// writtenObject is an object written to a file of the output directory.
+type writtenObject struct {
+	file string
+	obj  *unstructured.Unstructured
+}
+
+func outputFiles(outputDir string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(outputDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(outputDir, path)
+		files = append(files, filepath.ToSlash(rel))
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list outputs: %w", err)
+	}
+	return files, nil
+}
+
+// writtenObjects returns the objects in every YAML file of the output
+// directory, in file order.
+func writtenObjects(outputDir string) ([]writtenObject, error) {
+	files, err := outputFiles(outputDir)
+	if err != nil {
+		return nil, err
+	}
+	var written []writtenObject
+	for _, file := range files {
+		if !objutil.IsYAMLFile(file) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(outputDir, file))
+		if err != nil {
+			return nil, err
+		}
+		objs, err := decodeObjects(data)
+		if err != nil {
+			return nil, fmt.Errorf("output %s: %w", file, err)
+		}
+		for _, obj := range objs {
+			written = append(written, writtenObject{file: file, obj: obj})
+		}
+	}
+	return written, nil
+}
+
+// readStatus returns the status.yaml of the metadata directory, or nil if
+// there is none.
+func readStatus(metadataDir string) (map[string]any, error) {
+	data, err := os.ReadFile(filepath.Join(metadataDir, statusFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	status, err := objutil.UnmarshalObject(data)
+	if err != nil {
+		return nil, fmt.Errorf("status: %w", err)
+	}
+	return status, nil
+}
+
+// readDestinationSelectors returns the destination selectors of the metadata
+// directory, or nil if there are none.
+func readDestinationSelectors(metadataDir string) ([]kratix.DestinationSelector, error) {
+	selectors, err := kratix.New(kratix.WithMetadataDir(metadataDir)).ReadDestinationSelectors()
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return selectors, err
+}
+
+func publishedStatuses(client *kratixgofakes.FakeResourceInterface) ([]PublishedStatus, error) {
+	patches, err := mergePatches(client, true)
+	if err != nil {
+		return nil, err
+	}
+	var statuses []PublishedStatus
+	for _, patch := range patches {
+		status, _ := patch.data["status"].(map[string]any)
+		statuses = append(statuses, PublishedStatus{Name: patch.name, Status: status})
+	}
+	return statuses, nil
+}
+
+func publishedResources(client *kratixgofakes.FakeResourceInterface) ([]PublishedResource, error) {
+	patches, err := mergePatches(client, false)
+	if err != nil {
+		return nil, err
+	}
+	var resources []PublishedResource
+	for _, patch := range patches {
+		resources = append(resources, PublishedResource{Name: patch.name, Patch: patch.data})
+	}
+	return resources, nil
+}
+
+type patch struct {
+	name string
+	data map[string]any
+}
+
+// mergePatches returns the merge patches sent to the fake client, to the
+// status subresource or to the object itself.
+func mergePatches(client *kratixgofakes.FakeResourceInterface, status bool) ([]patch, error) {
+	var patches []patch
+	for i := range client.PatchCallCount() {
+		_, name, patchType, data, _, subresources := client.PatchArgsForCall(i)
+		if patchType != types.MergePatchType || slices.Contains(subresources, "status") != status {
+			continue
+		}
+		var decoded map[string]any
+		if err := utiljson.Unmarshal(data, &decoded); err != nil {
+			return nil, fmt.Errorf("patch %d: %w", i, err)
+		}
+		patches = append(patches, patch{name: name, data: decoded})
+	}
+	return patches, nil
+}
+
+// decodeObjects decodes the objects of a multi-document YAML, skipping empty
+// documents.
+func decodeObjects(data []byte) ([]*unstructured.Unstructured, error) {
+	docs, err := decodeDocuments(data)
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]*unstructured.Unstructured, 0, len(docs))
+	for i, doc := range docs {
+		obj, ok := doc.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("document %d is not an object", i)
+		}
+		objs = append(objs, &unstructured.Unstructured{Object: obj})
+	}
+	return objs, nil
+}
+
+// decodeDocuments decodes the documents of a multi-document YAML, skipping
+// empty documents and keeping integers as int64.
+func decodeDocuments(data []byte) ([]any, error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	var docs []any
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return docs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		jsonData, err := yaml.YAMLToJSON(doc)
+		if err != nil {
+			return nil, err
+		}
+		var decoded any
+		if err := utiljson.Unmarshal(jsonData, &decoded); err != nil {
+			return nil, err
+		}
+		if decoded != nil {
+			docs = append(docs, decoded)
+		}
+	}
+}
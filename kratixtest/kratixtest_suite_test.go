@@ -0,0 +1,13 @@
+package kratixtest_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestKratixtest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Kratixtest Suite")
+}
@@ -0,0 +1,137 @@
+package kratixtest_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix-go/kratixtest"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func configMap(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": name},
+		"data":       map[string]any{"replicas": "3"},
+	}}
+}
+
+var _ = Describe("kratixtest", func() {
+	It("runs a resource workflow against an isolated environment", func() {
+		env := kratixtest.New(GinkgoT()).
+			WithInputFile("../assets/input/resource.yaml").
+			WithPromiseFile("../assets/input/promise.yaml").
+			WithPipelineName("instance").
+			Build()
+		sdk := env.SDK()
+
+		Expect(sdk.IsResourceWorkflow()).To(BeTrue())
+		Expect(sdk.IsConfigureAction()).To(BeTrue())
+		Expect(sdk.PromiseName()).To(Equal("my-promise"))
+		Expect(sdk.PipelineName()).To(Equal("instance"))
+
+		resource, err := sdk.ReadResourceInput()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resource.GetName()).To(Equal("my-resource"))
+		promise, err := sdk.FetchPromise()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(promise.GetName()).To(Equal("my-promise"))
+
+		Expect(sdk.WriteObjects("app/config.yaml", []*unstructured.Unstructured{configMap("one"), configMap("two")})).To(Succeed())
+		Expect(sdk.WriteOutput("README.md", []byte("hello"))).To(Succeed())
+		Expect(sdk.WriteStatus(kratix.NewStatusFromMap(map[string]any{"replicas": int64(3)}))).To(Succeed())
+		Expect(sdk.WriteDestinationSelectors([]kratix.DestinationSelector{
+			{Directory: "app", MatchLabels: map[string]string{"env": "dev"}},
+		})).To(Succeed())
+
+		Expect(env.OutputFiles()).To(Equal([]string{"README.md", "app/config.yaml"}))
+		Expect(string(env.Output("README.md"))).To(Equal("hello"))
+		objs := env.OutputObjects("app/config.yaml")
+		Expect(objs).To(HaveLen(2))
+		Expect(objs[1].GetName()).To(Equal("two"))
+		Expect(env.AllOutputObjects()).To(HaveLen(2))
+		Expect(env.Status()).To(HaveKeyWithValue("replicas", int64(3)))
+		Expect(env.DestinationSelectors()).To(Equal([]kratix.DestinationSelector{
+			{Directory: "app", MatchLabels: map[string]string{"env": "dev"}},
+		}))
+	})
+
+	It("records the statuses and changes published through the fake client", func() {
+		env := kratixtest.New(GinkgoT()).WithInputFile("../assets/input/resource.yaml").Build()
+		sdk := env.SDK()
+		resource, err := sdk.ReadResourceInput()
+		Expect(err).ToNot(HaveOccurred())
+
+		status := kratix.NewStatus()
+		Expect(status.Set("message", "ready")).To(Succeed())
+		Expect(sdk.PublishStatus(resource, status)).To(Succeed())
+		resource.SetLabel("team", "a")
+		Expect(sdk.PublishResource(resource)).To(Succeed())
+
+		Expect(env.PublishedStatuses()).To(ConsistOf(kratixtest.PublishedStatus{
+			Name:   "my-resource",
+			Status: map[string]any{"message": "ready"},
+		}))
+		Expect(env.PublishedResources()).To(ConsistOf(kratixtest.PublishedResource{
+			Name:  "my-resource",
+			Patch: map[string]any{"metadata": map[string]any{"labels": map[string]any{"team": "a"}}},
+		}))
+	})
+
+	It("returns the input object from the fake client", func() {
+		input := configMap("settings")
+		input.SetGeneration(2)
+		env := kratixtest.New(GinkgoT()).WithInput(input).Build()
+
+		resource, err := env.SDK().ReadResourceInput()
+		Expect(err).ToNot(HaveOccurred())
+		stale, err := env.SDK().IsStale(resource)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stale).To(BeFalse())
+		Expect(env.Client().GetCallCount()).To(Equal(1))
+	})
+
+	It("sets up promise workflows with the Promise as input", func() {
+		env := kratixtest.New(GinkgoT()).
+			WithWorkflow("promise", "delete").
+			WithPromiseFile("../assets/input/promise.yaml").
+			Build()
+
+		Expect(env.SDK().IsPromiseWorkflow()).To(BeTrue())
+		Expect(env.SDK().IsDeleteAction()).To(BeTrue())
+		promise, err := env.SDK().ReadPromiseInput()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(promise.GetName()).To(Equal("my-promise"))
+	})
+
+	It("pre-populates the metadata directory", func() {
+		env := kratixtest.New(GinkgoT()).
+			WithStatus(map[string]any{"previous": "pipeline"}).
+			WithDestinationSelectors(kratix.DestinationSelector{Directory: "db", MatchLabels: map[string]string{"a": "b"}}).
+			WithMetadataFile("extra/notes.txt", []byte("notes")).
+			WithEnv("CUSTOM", "value").
+			Build()
+
+		status, err := env.SDK().ReadStatus()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(status.Get("previous")).To(Equal("pipeline"))
+		Expect(env.DestinationSelectors()).To(HaveLen(1))
+		Expect(os.ReadFile(env.MetadataDir() + "/extra/notes.txt")).To(Equal([]byte("notes")))
+		Expect(os.Getenv("CUSTOM")).To(Equal("value"))
+		Expect(env.Status()).To(HaveKeyWithValue("previous", "pipeline"))
+		Expect(env.OutputFiles()).To(BeEmpty())
+	})
+
+	It("isolates the Kratix environment variables from the process", func() {
+		GinkgoT().Setenv(kratixtest.PipelineNameEnv, "leaked")
+		env := kratixtest.New(GinkgoT()).Build()
+
+		Expect(env.SDK().PipelineName()).To(BeEmpty())
+		Expect(env.Status()).To(BeNil())
+		Expect(env.DestinationSelectors()).To(BeNil())
+	})
+})
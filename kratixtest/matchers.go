@@ -0,0 +1,435 @@
+package kratixtest
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/onsi/gomega/format"
+	"github.com/onsi/gomega/types"
+	kratix "github.com/syntasso/kratix-go"
+	"github.com/syntasso/kratix-go/internal/objutil"
+	kratixgofakes "github.com/syntasso/kratix-go/kratix-gofakes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// The matchers accept as actual value:
+//   - an *Environment
+//   - a directory: a /kratix layout, or its output or metadata directory
+//   - for the status matchers, a kratix.Status, a status map or the fake
+//     client, matching the last published status
+//   - for HavePublishedStatus, the fake client
+
+// HaveWrittenObject succeeds if an object of the kind and name was written to
+// the output directory, in any namespace.
+func HaveWrittenObject(gvk schema.GroupVersionKind, name string) types.GomegaMatcher {
+	return &writtenObjectMatcher{gvk: gvk, name: name}
+}
+
+// HaveStatusField succeeds if the status has the value at the path, e.g.
+// "spec.replicas" or ".pods[0].name". The value can be a matcher.
+func HaveStatusField(path string, value any) types.GomegaMatcher {
+	return &statusFieldMatcher{path: path, value: value}
+}
+
+// HaveCondition succeeds if the status has a condition of the type with the
+// status.
+func HaveCondition(conditionType string, status metav1.ConditionStatus) types.GomegaMatcher {
+	return &conditionMatcher{conditionType: conditionType, status: status}
+}
+
+// HaveDestinationSelector succeeds if a destination selector written to the
+// metadata directory matches exactly the labels.
+func HaveDestinationSelector(labels map[string]string) types.GomegaMatcher {
+	return &destinationSelectorMatcher{labels: labels}
+}
+
+// HavePublishedStatus succeeds if a status published with PublishStatus
+// contains the expected fields, or matches the expected matcher.
+func HavePublishedStatus(expected any) types.GomegaMatcher {
+	return &publishedStatusMatcher{expected: expected}
+}
+
+type writtenObjectMatcher struct {
+	gvk     schema.GroupVersionKind
+	name    string
+	written []writtenObject
+}
+
+func (m *writtenObjectMatcher) Match(actual any) (bool, error) {
+	outputDir, err := dirOf(actual, "output")
+	if err != nil {
+		return false, err
+	}
+	if m.written, err = writtenObjects(outputDir); err != nil {
+		return false, err
+	}
+	for _, w := range m.written {
+		if w.obj.GroupVersionKind() == m.gvk && w.obj.GetName() == m.name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *writtenObjectMatcher) FailureMessage(any) string {
+	return fmt.Sprintf("Expected a %s named %s to be written, written objects:\n%s", m.describeKind(), m.name, m.describeWritten())
+}
+
+func (m *writtenObjectMatcher) NegatedFailureMessage(any) string {
+	return fmt.Sprintf("Expected no %s named %s to be written, written objects:\n%s", m.describeKind(), m.name, m.describeWritten())
+}
+
+func (m *writtenObjectMatcher) describeKind() string {
+	return m.gvk.Kind + " " + m.gvk.GroupVersion().String()
+}
+
+func (m *writtenObjectMatcher) describeWritten() string {
+	if len(m.written) == 0 {
+		return "    <none>"
+	}
+	var lines []string
+	for _, w := range m.written {
+		ref := w.obj.GetName()
+		if w.obj.GetNamespace() != "" {
+			ref = w.obj.GetNamespace() + "/" + ref
+		}
+		lines = append(lines, fmt.Sprintf("    %s: %s %s %s", w.file, w.obj.GetKind(), w.obj.GetAPIVersion(), ref))
+	}
+	return strings.Join(lines, "\n")
+}
+
+type statusFieldMatcher struct {
+	path   string
+	value  any
+	status map[string]any
+	actual any
+}
+
+func (m *statusFieldMatcher) Match(actual any) (bool, error) {
+	var err error
+	if m.status, err = statusOf(actual); err != nil {
+		return false, err
+	}
+	m.actual = kratix.NewStatusFromMap(m.status).Get(m.path)
+	if matcher, ok := m.value.(types.GomegaMatcher); ok {
+		return matcher.Match(m.actual)
+	}
+	if m.actual == nil {
+		return false, nil
+	}
+	return equalJSON(m.value, m.actual)
+}
+
+func (m *statusFieldMatcher) FailureMessage(any) string {
+	if matcher, ok := m.value.(types.GomegaMatcher); ok {
+		return fmt.Sprintf("Status field %s:\n%s", m.path, matcher.FailureMessage(m.actual))
+	}
+	if m.actual == nil {
+		return fmt.Sprintf("Expected status field %s to be %s, but it is not set in status:\n%s",
+			m.path, format.Object(m.value, 1), indentYAML(m.status))
+	}
+	return fmt.Sprintf("Expected status field %s to be the expected value:\n%s", m.path, yamlDiff(m.value, m.actual))
+}
+
+func (m *statusFieldMatcher) NegatedFailureMessage(any) string {
+	if matcher, ok := m.value.(types.GomegaMatcher); ok {
+		return fmt.Sprintf("Status field %s:\n%s", m.path, matcher.NegatedFailureMessage(m.actual))
+	}
+	return fmt.Sprintf("Expected status field %s not to be\n%s", m.path, format.Object(m.value, 1))
+}
+
+type conditionMatcher struct {
+	conditionType string
+	status        metav1.ConditionStatus
+	conditions    []any
+}
+
+func (m *conditionMatcher) Match(actual any) (bool, error) {
+	status, err := statusOf(actual)
+	if err != nil {
+		return false, err
+	}
+	m.conditions, _ = status["conditions"].([]any)
+	for _, c := range m.conditions {
+		condition, ok := c.(map[string]any)
+		if ok && condition["type"] == m.conditionType {
+			return condition["status"] == string(m.status), nil
+		}
+	}
+	return false, nil
+}
+
+func (m *conditionMatcher) FailureMessage(any) string {
+	return fmt.Sprintf("Expected condition %s to be %s, conditions:\n%s", m.conditionType, m.status, indentYAML(m.conditions))
+}
+
+func (m *conditionMatcher) NegatedFailureMessage(any) string {
+	return fmt.Sprintf("Expected condition %s not to be %s, conditions:\n%s", m.conditionType, m.status, indentYAML(m.conditions))
+}
+
+type destinationSelectorMatcher struct {
+	labels    map[string]string
+	selectors []kratix.DestinationSelector
+}
+
+func (m *destinationSelectorMatcher) Match(actual any) (bool, error) {
+	metadataDir, err := dirOf(actual, "metadata")
+	if err != nil {
+		return false, err
+	}
+	if m.selectors, err = readDestinationSelectors(metadataDir); err != nil {
+		return false, err
+	}
+	return slices.ContainsFunc(m.selectors, func(s kratix.DestinationSelector) bool {
+		return maps.Equal(s.MatchLabels, m.labels)
+	}), nil
+}
+
+func (m *destinationSelectorMatcher) FailureMessage(any) string {
+	return fmt.Sprintf("Expected a destination selector matching labels %v, destination selectors:\n%s", m.labels, indentYAML(m.selectors))
+}
+
+func (m *destinationSelectorMatcher) NegatedFailureMessage(any) string {
+	return fmt.Sprintf("Expected no destination selector matching labels %v, destination selectors:\n%s", m.labels, indentYAML(m.selectors))
+}
+
+type publishedStatusMatcher struct {
+	expected  any
+	published []PublishedStatus
+}
+
+func (m *publishedStatusMatcher) Match(actual any) (bool, error) {
+	client, err := fakeClientOf(actual)
+	if err != nil {
+		return false, err
+	}
+	if m.published, err = publishedStatuses(client); err != nil {
+		return false, err
+	}
+	for _, published := range m.published {
+		var ok bool
+		if matcher, isMatcher := m.expected.(types.GomegaMatcher); isMatcher {
+			ok, err = matcher.Match(published.Status)
+		} else {
+			ok, err = containsJSON(m.expected, published.Status)
+		}
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+func (m *publishedStatusMatcher) FailureMessage(any) string {
+	if len(m.published) == 0 {
+		return fmt.Sprintf("Expected a status to be published with:\n%s\nbut no status was published", indentYAML(m.expected))
+	}
+	if _, ok := m.expected.(types.GomegaMatcher); ok {
+		return fmt.Sprintf("Expected a published status to match, published statuses:\n%s", indentYAML(m.published))
+	}
+	last := m.published[len(m.published)-1]
+	return fmt.Sprintf("Expected a published status to contain the expected fields, the last published status for %s differs:\n%s",
+		last.Name, yamlDiff(m.expected, subsetOf(m.expected, last.Status)))
+}
+
+func (m *publishedStatusMatcher) NegatedFailureMessage(any) string {
+	return fmt.Sprintf("Expected no published status to match\n%s\npublished statuses:\n%s", indentYAML(m.expected), indentYAML(m.published))
+}
+
+// dirOf returns the output or metadata directory of the actual value.
+func dirOf(actual any, name string) (string, error) {
+	switch a := actual.(type) {
+	case *Environment:
+		if name == "output" {
+			return a.outputDir, nil
+		}
+		return a.metadataDir, nil
+	case string:
+		if info, err := os.Stat(filepath.Join(a, name)); err == nil && info.IsDir() {
+			return filepath.Join(a, name), nil
+		}
+		if info, err := os.Stat(a); err != nil || !info.IsDir() {
+			return "", fmt.Errorf("expected a %s directory, got %s", name, a)
+		}
+		return a, nil
+	}
+	return "", fmt.Errorf("expected an *Environment or a %s directory, got\n%s", name, format.Object(actual, 1))
+}
+
+// statusOf returns the status of the actual value.
+func statusOf(actual any) (map[string]any, error) {
+	switch a := actual.(type) {
+	case kratix.Status:
+		return a.ToMap(), nil
+	case map[string]any:
+		return a, nil
+	case *kratixgofakes.FakeResourceInterface:
+		statuses, err := publishedStatuses(a)
+		if err != nil {
+			return nil, err
+		}
+		if len(statuses) == 0 {
+			return nil, fmt.Errorf("no status was published")
+		}
+		return statuses[len(statuses)-1].Status, nil
+	}
+	metadataDir, err := dirOf(actual, "metadata")
+	if err != nil {
+		return nil, err
+	}
+	status, err := readStatus(metadataDir)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil {
+		return nil, fmt.Errorf("no status was written to %s", metadataDir)
+	}
+	return status, nil
+}
+
+// fakeClientOf returns the fake client of the actual value.
+func fakeClientOf(actual any) (*kratixgofakes.FakeResourceInterface, error) {
+	switch a := actual.(type) {
+	case *Environment:
+		if a.client == nil {
+			return nil, fmt.Errorf("the fake client was replaced with WithObjectClient")
+		}
+		return a.client, nil
+	case *kratixgofakes.FakeResourceInterface:
+		return a, nil
+	}
+	return nil, fmt.Errorf("expected an *Environment or a *FakeResourceInterface, got\n%s", format.Object(actual, 1))
+}
+
+func equalJSON(expected, actual any) (bool, error) {
+	e, err := objutil.ToJSON(expected)
+	if err != nil {
+		return false, err
+	}
+	a, err := objutil.ToJSON(actual)
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(e, a), nil
+}
+
+// containsJSON returns true if every field of expected has the same value in
+// actual, recursively for objects.
+func containsJSON(expected, actual any) (bool, error) {
+	e, err := objutil.ToJSON(expected)
+	if err != nil {
+		return false, err
+	}
+	a, err := objutil.ToJSON(actual)
+	if err != nil {
+		return false, err
+	}
+	return contains(e, a), nil
+}
+
+func contains(expected, actual any) bool {
+	expectedObj, ok := expected.(map[string]any)
+	if !ok {
+		return reflect.DeepEqual(expected, actual)
+	}
+	actualObj, ok := actual.(map[string]any)
+	if !ok {
+		return false
+	}
+	for k, v := range expectedObj {
+		av, ok := actualObj[k]
+		if !ok || !contains(v, av) {
+			return false
+		}
+	}
+	return true
+}
+
+// subsetOf returns the fields of actual that are in expected, so diffs only
+// show the fields the assertion is about.
+func subsetOf(expected, actual any) any {
+	e, _ := objutil.ToJSON(expected)
+	expectedObj, ok := e.(map[string]any)
+	if !ok {
+		return actual
+	}
+	actualObj, ok := actual.(map[string]any)
+	if !ok {
+		return actual
+	}
+	subset := map[string]any{}
+	for k := range expectedObj {
+		if v, ok := actualObj[k]; ok {
+			subset[k] = subsetOf(expectedObj[k], v)
+		}
+	}
+	return subset
+}
+
+func indentYAML(v any) string {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return format.Object(v, 1)
+	}
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = "    " + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// yamlDiff renders the YAML of expected and actual as a line diff, with
+// expected lines prefixed by - and actual lines by +.
+func yamlDiff(expected, actual any) string {
+	e, _ := objutil.ToJSON(expected)
+	a, _ := objutil.ToJSON(actual)
+	expectedData, _ := yaml.Marshal(e)
+	actualData, _ := yaml.Marshal(a)
+	return diffLines(
+		strings.Split(strings.TrimRight(string(expectedData), "\n"), "\n"),
+		strings.Split(strings.TrimRight(string(actualData), "\n"), "\n"),
+	)
+}
+
+// diffLines returns the line diff of the longest common subsequence of a and b.
+func diffLines(a, b []string) string {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out strings.Builder
+	out.WriteString("    (-expected +actual)\n")
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			out.WriteString("      " + a[i] + "\n")
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			out.WriteString("    - " + a[i] + "\n")
+			i++
+		default:
+			out.WriteString("    + " + b[j] + "\n")
+			j++
+		}
+	}
+	return strings.TrimRight(out.String(), "\n")
+}
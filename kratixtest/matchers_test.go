@@ -0,0 +1,148 @@
+package kratixtest_test
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kratix "github.com/syntasso/kratix-go"
+	kratixgofakes "github.com/syntasso/kratix-go/kratix-gofakes"
+	"github.com/syntasso/kratix-go/kratixtest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var configMapGVK = schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+
+var _ = Describe("Matchers", func() {
+	var (
+		env *kratixtest.Environment
+		sdk *kratix.KratixSDK
+	)
+
+	BeforeEach(func() {
+		env = kratixtest.New(GinkgoT()).WithInputFile("../assets/input/resource.yaml").Build()
+		sdk = env.SDK()
+
+		Expect(sdk.WriteObjects("app/config.yaml", []*unstructured.Unstructured{configMap("settings")})).To(Succeed())
+		status := kratix.NewStatusFromMap(map[string]any{
+			"replicas": int64(3),
+			"endpoint": map[string]any{"host": "db.local", "port": int64(5432)},
+			"conditions": []any{
+				map[string]any{"type": "Ready", "status": "True"},
+				map[string]any{"type": "Degraded", "status": "False"},
+			},
+		})
+		Expect(sdk.WriteStatus(status)).To(Succeed())
+		Expect(sdk.WriteDestinationSelectors([]kratix.DestinationSelector{
+			{Directory: "app", MatchLabels: map[string]string{"env": "dev"}},
+		})).To(Succeed())
+	})
+
+	Describe("HaveWrittenObject", func() {
+		It("matches objects written to the output directory", func() {
+			Expect(env).To(kratixtest.HaveWrittenObject(configMapGVK, "settings"))
+			Expect(env.OutputDir()).To(kratixtest.HaveWrittenObject(configMapGVK, "settings"))
+			Expect(filepath.Dir(env.OutputDir())).To(kratixtest.HaveWrittenObject(configMapGVK, "settings"))
+			Expect(env).ToNot(kratixtest.HaveWrittenObject(configMapGVK, "other"))
+			Expect(env).ToNot(kratixtest.HaveWrittenObject(schema.GroupVersionKind{Version: "v1", Kind: "Secret"}, "settings"))
+		})
+
+		It("lists the written objects on failure", func() {
+			matcher := kratixtest.HaveWrittenObject(configMapGVK, "other")
+			Expect(matcher.Match(env)).To(BeFalse())
+			Expect(matcher.FailureMessage(env)).To(Equal(
+				"Expected a ConfigMap v1 named other to be written, written objects:\n    app/config.yaml: ConfigMap v1 settings"))
+		})
+
+		It("errors on unsupported actual values", func() {
+			_, err := kratixtest.HaveWrittenObject(configMapGVK, "settings").Match(42)
+			Expect(err).To(MatchError(ContainSubstring("expected an *Environment or a output directory")))
+		})
+	})
+
+	Describe("HaveStatusField", func() {
+		It("matches fields of the written status", func() {
+			Expect(env).To(kratixtest.HaveStatusField("replicas", 3))
+			Expect(env).To(kratixtest.HaveStatusField("endpoint.port", 5432))
+			Expect(env).To(kratixtest.HaveStatusField("endpoint", map[string]any{"host": "db.local", "port": 5432}))
+			Expect(env).To(kratixtest.HaveStatusField("endpoint.host", HavePrefix("db.")))
+			Expect(env.MetadataDir()).To(kratixtest.HaveStatusField("replicas", 3))
+			Expect(env).ToNot(kratixtest.HaveStatusField("replicas", 4))
+			Expect(env).ToNot(kratixtest.HaveStatusField("missing", "value"))
+		})
+
+		It("matches fields of a Status", func() {
+			status := kratix.NewStatusFromMap(map[string]any{"phase": "Ready"})
+			Expect(status).To(kratixtest.HaveStatusField("phase", "Ready"))
+		})
+
+		It("shows a diff on failure", func() {
+			matcher := kratixtest.HaveStatusField("endpoint", map[string]any{"host": "db.remote", "port": 5432})
+			Expect(matcher.Match(env)).To(BeFalse())
+			Expect(matcher.FailureMessage(env)).To(Equal(`Expected status field endpoint to be the expected value:
+    (-expected +actual)
+    - host: db.remote
+    + host: db.local
+      port: 5432`))
+		})
+	})
+
+	Describe("HaveCondition", func() {
+		It("matches conditions of the status", func() {
+			Expect(env).To(kratixtest.HaveCondition("Ready", metav1.ConditionTrue))
+			Expect(env).To(kratixtest.HaveCondition("Degraded", metav1.ConditionFalse))
+			Expect(env).ToNot(kratixtest.HaveCondition("Ready", metav1.ConditionFalse))
+			Expect(env).ToNot(kratixtest.HaveCondition("Missing", metav1.ConditionTrue))
+		})
+	})
+
+	Describe("HaveDestinationSelector", func() {
+		It("matches the written destination selectors", func() {
+			Expect(env).To(kratixtest.HaveDestinationSelector(map[string]string{"env": "dev"}))
+			Expect(env.MetadataDir()).To(kratixtest.HaveDestinationSelector(map[string]string{"env": "dev"}))
+			Expect(env).ToNot(kratixtest.HaveDestinationSelector(map[string]string{"env": "prod"}))
+		})
+	})
+
+	Describe("HavePublishedStatus", func() {
+		BeforeEach(func() {
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sdk.PublishStatus(resource, kratix.NewStatusFromMap(map[string]any{
+				"phase":  "Provisioning",
+				"nested": map[string]any{"a": int64(1), "b": int64(2)},
+			}))).To(Succeed())
+		})
+
+		It("matches the published statuses", func() {
+			Expect(env).To(kratixtest.HavePublishedStatus(map[string]any{"phase": "Provisioning"}))
+			Expect(env).To(kratixtest.HavePublishedStatus(map[string]any{"nested": map[string]any{"a": 1}}))
+			Expect(env).To(kratixtest.HavePublishedStatus(HaveKey("phase")))
+			Expect(env.Client()).To(kratixtest.HavePublishedStatus(map[string]any{"phase": "Provisioning"}))
+			Expect(env).ToNot(kratixtest.HavePublishedStatus(map[string]any{"phase": "Ready"}))
+		})
+
+		It("matches fields and conditions of the last published status of a fake client", func() {
+			Expect(env.Client()).To(kratixtest.HaveStatusField("nested.b", 2))
+		})
+
+		It("shows a diff of the expected fields on failure", func() {
+			matcher := kratixtest.HavePublishedStatus(map[string]any{"phase": "Ready"})
+			Expect(matcher.Match(env)).To(BeFalse())
+			Expect(matcher.FailureMessage(env)).To(Equal(`Expected a published status to contain the expected fields, the last published status for my-resource differs:
+    (-expected +actual)
+    - phase: Ready
+    + phase: Provisioning`))
+		})
+
+		It("fails when no status was published", func() {
+			client := &kratixgofakes.FakeResourceInterface{}
+			matcher := kratixtest.HavePublishedStatus(map[string]any{"phase": "Ready"})
+			Expect(matcher.Match(client)).To(BeFalse())
+			Expect(matcher.FailureMessage(client)).To(HaveSuffix("but no status was published"))
+		})
+	})
+})
@@ -0,0 +1,138 @@
+package kratix
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/syntasso/kratix/api/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/restmapper"
+)
+
+var promiseGVK = v1alpha1.GroupVersion.WithKind("Promise")
+
+// WithRESTMapper overrides how kinds are resolved to API resources. Without it,
+// the SDK uses cached discovery against the cluster it is configured for.
+func WithRESTMapper(mapper meta.RESTMapper) Option {
+	return func(k *KratixSDK) { k.restMapper = mapper }
+}
+
+// NewPromiseRESTMapper returns a RESTMapper for the API declared by the Promise,
+// resolving every served version with the plural and scope from its CRD.
+func NewPromiseRESTMapper(promise Promise) (meta.RESTMapper, error) {
+	crd, err := promise.GetCRD()
+	if err != nil {
+		return nil, err
+	}
+	return crdRESTMapper(crd), nil
+}
+
+// crdRESTMapper returns a RESTMapper resolving every version of the CRD.
+func crdRESTMapper(crd *apiextensionsv1.CustomResourceDefinition) meta.RESTMapper {
+	scope := meta.RESTScopeNamespace
+	if crd.Spec.Scope == apiextensionsv1.ClusterScoped {
+		scope = meta.RESTScopeRoot
+	}
+
+	var versions []schema.GroupVersion
+	for _, v := range crd.Spec.Versions {
+		versions = append(versions, schema.GroupVersion{Group: crd.Spec.Group, Version: v.Name})
+	}
+	mapper := meta.NewDefaultRESTMapper(versions)
+	for _, gv := range versions {
+		singular := crd.Spec.Names.Singular
+		if singular == "" {
+			singular = strings.ToLower(crd.Spec.Names.Kind)
+		}
+		mapper.AddSpecific(
+			gv.WithKind(crd.Spec.Names.Kind),
+			gv.WithResource(crd.Spec.Names.Plural),
+			gv.WithResource(singular),
+			scope,
+		)
+	}
+	return mapper
+}
+
+// getRESTMapper returns the configured RESTMapper, or builds and caches one
+// backed by discovery. It returns nil when the dynamic client was injected, as
+// there is no configuration to run discovery with.
+func (k *KratixSDK) getRESTMapper() (meta.RESTMapper, error) {
+	if k.restMapper != nil {
+		return k.restMapper, nil
+	}
+	if k.restConfig == nil {
+		return nil, nil
+	}
+
+	client, err := discovery.NewDiscoveryClientForConfig(k.restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("create discovery client: %w", err)
+	}
+	k.restMapper = restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(client))
+	return k.restMapper, nil
+}
+
+// resourceMapping resolves the API resource and scope for the kind. Promises
+// are always mapped to the cluster-scoped promises resource. When the
+// RESTMapper cannot resolve it, the plural and scope Kratix provides for the
+// workflow object are used, provided the kind is the workflow object's kind.
+func (k *KratixSDK) resourceMapping(gvk schema.GroupVersionKind) (*meta.RESTMapping, error) {
+	if gvk.GroupKind() == promiseGVK.GroupKind() {
+		return &meta.RESTMapping{
+			Resource:         gvk.GroupVersion().WithResource("promises"),
+			GroupVersionKind: gvk,
+			Scope:            meta.RESTScopeRoot,
+		}, nil
+	}
+
+	mapper, err := k.getRESTMapper()
+	if err != nil {
+		return nil, err
+	}
+
+	var mapErr error
+	if mapper != nil {
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err == nil {
+			return mapping, nil
+		}
+		mapErr = err
+	}
+
+	if mapping, ok := workflowObjectMapping(gvk); ok {
+		return mapping, nil
+	}
+	if mapErr != nil {
+		return nil, fmt.Errorf("resolve resource for %s: %w", gvk, mapErr)
+	}
+	return nil, fmt.Errorf("resolve resource for %s: no RESTMapper available and KRATIX_CRD_PLURAL does not apply", gvk)
+}
+
+// workflowObjectMapping builds a mapping from the KRATIX_CRD_PLURAL and
+// KRATIX_CLUSTER_SCOPED environment variables.
+func workflowObjectMapping(gvk schema.GroupVersionKind) (*meta.RESTMapping, bool) {
+	plural := os.Getenv("KRATIX_CRD_PLURAL")
+	if plural == "" {
+		return nil, false
+	}
+	kind, group := os.Getenv("KRATIX_OBJECT_KIND"), os.Getenv("KRATIX_OBJECT_GROUP")
+	if (kind != "" || group != "") && (!strings.EqualFold(kind, gvk.Kind) || group != gvk.Group) {
+		return nil, false
+	}
+
+	scope := meta.RESTScopeNamespace
+	if os.Getenv("KRATIX_CLUSTER_SCOPED") == "true" {
+		scope = meta.RESTScopeRoot
+	}
+	return &meta.RESTMapping{
+		Resource:         gvk.GroupVersion().WithResource(plural),
+		GroupVersionKind: gvk,
+		Scope:            scope,
+	}, true
+}
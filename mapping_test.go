@@ -0,0 +1,77 @@
+package kratix
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/syntasso/kratix/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var _ = Describe("Resource mapping", func() {
+	var redisGVK schema.GroupVersionKind
+
+	BeforeEach(func() {
+		redisGVK = schema.GroupVersionKind{Group: "marketplace.kratix.io", Version: "v1alpha1", Kind: "redis"}
+		for _, env := range []string{"KRATIX_CRD_PLURAL", "KRATIX_CLUSTER_SCOPED", "KRATIX_OBJECT_KIND", "KRATIX_OBJECT_GROUP"} {
+			DeferCleanup(os.Setenv, env, os.Getenv(env))
+			os.Unsetenv(env)
+		}
+	})
+
+	Describe("NewPromiseRESTMapper", func() {
+		It("maps the promise API using the plural and scope from the CRD", func() {
+			promise, err := New(WithInputDir("assets/input"), WithInputObject("promise.yaml")).ReadPromiseInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			mapper, err := NewPromiseRESTMapper(promise)
+			Expect(err).ToNot(HaveOccurred())
+
+			mapping, err := mapper.RESTMapping(redisGVK.GroupKind(), "v1alpha1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mapping.Resource).To(Equal(redisGVK.GroupVersion().WithResource("redis")))
+			Expect(mapping.Scope.Name()).To(Equal(meta.RESTScopeNameNamespace))
+		})
+
+		It("errors when the promise has no API", func() {
+			_, err := NewPromiseRESTMapper(&PromiseImpl{promise: &v1alpha1.Promise{}})
+			Expect(err).To(MatchError(ContainSubstring("promise does not contain an API")))
+		})
+	})
+
+	Describe("resourceMapping", func() {
+		It("uses the configured RESTMapper", func() {
+			mapper := meta.NewDefaultRESTMapper(nil)
+			mapper.Add(redisGVK, meta.RESTScopeRoot)
+
+			mapping, err := New(WithRESTMapper(mapper)).resourceMapping(redisGVK)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mapping.Resource.Resource).To(Equal("redises"))
+			Expect(mapping.Scope.Name()).To(Equal(meta.RESTScopeNameRoot))
+		})
+
+		It("falls back to the environment for the workflow object", func() {
+			os.Setenv("KRATIX_CRD_PLURAL", "redises")
+			os.Setenv("KRATIX_CLUSTER_SCOPED", "true")
+			os.Setenv("KRATIX_OBJECT_KIND", "redis")
+			os.Setenv("KRATIX_OBJECT_GROUP", "marketplace.kratix.io")
+
+			mapping, err := New(WithRESTMapper(meta.NewDefaultRESTMapper(nil))).resourceMapping(redisGVK)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mapping.Resource).To(Equal(redisGVK.GroupVersion().WithResource("redises")))
+			Expect(mapping.Scope.Name()).To(Equal(meta.RESTScopeNameRoot))
+		})
+
+		It("does not apply the environment to other kinds", func() {
+			os.Setenv("KRATIX_CRD_PLURAL", "redises")
+			os.Setenv("KRATIX_OBJECT_KIND", "postgres")
+			os.Setenv("KRATIX_OBJECT_GROUP", "marketplace.kratix.io")
+
+			_, err := New(WithRESTMapper(meta.NewDefaultRESTMapper(nil))).resourceMapping(redisGVK)
+			Expect(err).To(MatchError(ContainSubstring("resolve resource for marketplace.kratix.io/v1alpha1, Kind=redis")))
+		})
+	})
+})
@@ -0,0 +1,81 @@
+package kratix
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// ObjectTransformer modifies an object before it is written to the output
+// directory, e.g. to set its namespace or add labels.
+type ObjectTransformer func(obj *unstructured.Unstructured) error
+
+// DefaultNamespace sets the namespace of the objects that do not have one.
+func DefaultNamespace(namespace string) ObjectTransformer {
+	return func(obj *unstructured.Unstructured) error {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+		return nil
+	}
+}
+
+// AddLabels adds the labels to the objects, replacing labels with the same key.
+func AddLabels(labels map[string]string) ObjectTransformer {
+	return func(obj *unstructured.Unstructured) error {
+		merged := obj.GetLabels()
+		if merged == nil {
+			merged = map[string]string{}
+		}
+		maps.Copy(merged, labels)
+		obj.SetLabels(merged)
+		return nil
+	}
+}
+
+// AddAnnotations adds the annotations to the objects, replacing annotations
+// with the same key.
+func AddAnnotations(annotations map[string]string) ObjectTransformer {
+	return func(obj *unstructured.Unstructured) error {
+		merged := obj.GetAnnotations()
+		if merged == nil {
+			merged = map[string]string{}
+		}
+		maps.Copy(merged, annotations)
+		obj.SetAnnotations(merged)
+		return nil
+	}
+}
+
+// marshalObjects applies the transformers to copies of the objects and
+// encodes them as a multi-document YAML.
+func marshalObjects(objs []*unstructured.Unstructured, transformers []ObjectTransformer) ([]byte, error) {
+	var buf bytes.Buffer
+	for i, obj := range objs {
+		obj = obj.DeepCopy()
+		for _, transform := range transformers {
+			if err := transform(obj); err != nil {
+				return nil, fmt.Errorf("transform object %s: %w", objectRef(obj), err)
+			}
+		}
+		data, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("marshal object %s: %w", objectRef(obj), err)
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
+
+func objectRef(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return fmt.Sprintf("%s %s", obj.GetKind(), obj.GetName())
+	}
+	return fmt.Sprintf("%s %s/%s", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
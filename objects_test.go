@@ -0,0 +1,88 @@
+package kratix
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("Writing objects", func() {
+	var objs []*unstructured.Unstructured
+
+	BeforeEach(func() {
+		configMap := &unstructured.Unstructured{}
+		configMap.SetAPIVersion("v1")
+		configMap.SetKind("ConfigMap")
+		configMap.SetName("config")
+		configMap.SetLabels(map[string]string{"app": "db", "team": "data"})
+
+		namespace := &unstructured.Unstructured{}
+		namespace.SetAPIVersion("v1")
+		namespace.SetKind("Namespace")
+		namespace.SetName("db")
+		namespace.SetNamespace("ignored")
+
+		objs = []*unstructured.Unstructured{configMap, namespace}
+	})
+
+	It("encodes the objects as a multi-document YAML", func() {
+		data, err := marshalObjects(objs, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  labels:
+    app: db
+    team: data
+  name: config
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: db
+  namespace: ignored
+`))
+	})
+
+	It("applies the transformers in order to copies of the objects", func() {
+		data, err := marshalObjects(objs, []ObjectTransformer{
+			DefaultNamespace("default"),
+			AddLabels(map[string]string{"team": "platform"}),
+			AddAnnotations(map[string]string{"kratix.io/source": "sdk"}),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  annotations:
+    kratix.io/source: sdk
+  labels:
+    app: db
+    team: platform
+  name: config
+  namespace: default
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  annotations:
+    kratix.io/source: sdk
+  labels:
+    team: platform
+  name: db
+  namespace: ignored
+`))
+		Expect(objs[0].GetLabels()).To(HaveKeyWithValue("team", "data"))
+		Expect(objs[0].GetNamespace()).To(BeEmpty())
+	})
+
+	It("returns the errors of the transformers", func() {
+		_, err := marshalObjects(objs, []ObjectTransformer{func(*unstructured.Unstructured) error {
+			return errors.New("boom")
+		}})
+		Expect(err).To(MatchError("transform object ConfigMap config: boom"))
+	})
+})
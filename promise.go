@@ -1,12 +1,30 @@
 package kratix
 
 import (
+	"fmt"
+
+	"github.com/go-logr/logr"
 	"github.com/syntasso/kratix/api/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
+//go:generate go tool counterfeiter . Promise
 type Promise interface {
 	Resource
 	GetPromise() *v1alpha1.Promise
+	// GetCRD returns the CustomResourceDefinition of the Promise API
+	GetCRD() (*apiextensionsv1.CustomResourceDefinition, error)
+	// GetStorageVersion returns the storage version of the Promise API
+	GetStorageVersion() (string, error)
+	// GetAPISchema returns the OpenAPI schema of the provided version of the Promise API
+	GetAPISchema(version string) (*apiextensionsv1.JSONSchemaProps, error)
+	// GetPipelines returns the pipelines of the workflow with the provided type and action, e.g. resource and configure
+	GetPipelines(workflowType, workflowAction string) ([]v1alpha1.Pipeline, error)
+	// GetPipeline returns the named pipeline of the workflow with the provided type and action
+	GetPipeline(workflowType, workflowAction, name string) (*v1alpha1.Pipeline, error)
+	// GetDependencies returns the dependencies of the Promise
+	GetDependencies() []*unstructured.Unstructured
 }
 
 type PromiseImpl struct {
@@ -19,3 +37,96 @@ var _ Promise = (*PromiseImpl)(nil)
 func (p *PromiseImpl) GetPromise() *v1alpha1.Promise {
 	return p.promise
 }
+
+// GetCRD parses the API of the Promise. It returns v1alpha1.ErrNoAPI if the
+// Promise has no API.
+func (p *PromiseImpl) GetCRD() (*apiextensionsv1.CustomResourceDefinition, error) {
+	_, crd, err := p.promise.GetAPI()
+	if err != nil {
+		return nil, fmt.Errorf("read promise api: %w", err)
+	}
+	return crd, nil
+}
+
+// GetStorageVersion returns the version of the Promise API marked as the
+// storage version, or its first version if none is.
+func (p *PromiseImpl) GetStorageVersion() (string, error) {
+	gvk, _, err := p.promise.GetAPI()
+	if err != nil {
+		return "", fmt.Errorf("read promise api: %w", err)
+	}
+	return gvk.Version, nil
+}
+
+// GetAPISchema returns the OpenAPI schema of the provided version of the
+// Promise API.
+func (p *PromiseImpl) GetAPISchema(version string) (*apiextensionsv1.JSONSchemaProps, error) {
+	crd, err := p.GetCRD()
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range crd.Spec.Versions {
+		if v.Name != version {
+			continue
+		}
+		if v.Schema == nil || v.Schema.OpenAPIV3Schema == nil {
+			return nil, fmt.Errorf("promise api version %s has no schema", version)
+		}
+		return v.Schema.OpenAPIV3Schema, nil
+	}
+	return nil, fmt.Errorf("promise api has no version %s", version)
+}
+
+// GetPipelines decodes the pipelines of the workflow with the provided type
+// (promise or resource) and action (configure or delete).
+func (p *PromiseImpl) GetPipelines(workflowType, workflowAction string) ([]v1alpha1.Pipeline, error) {
+	var triggers v1alpha1.WorkflowTriggers
+	switch v1alpha1.Type(workflowType) {
+	case v1alpha1.WorkflowTypePromise:
+		triggers = p.promise.Spec.Workflows.Promise
+	case v1alpha1.WorkflowTypeResource:
+		triggers = p.promise.Spec.Workflows.Resource
+	default:
+		return nil, fmt.Errorf("unknown workflow type %q", workflowType)
+	}
+
+	var pipelines []v1alpha1.Pipeline
+	var err error
+	switch v1alpha1.Action(workflowAction) {
+	case v1alpha1.WorkflowActionConfigure:
+		pipelines, err = v1alpha1.PipelinesFromUnstructured(triggers.Configure, logr.Discard())
+	case v1alpha1.WorkflowActionDelete:
+		pipelines, err = v1alpha1.PipelinesFromUnstructured(triggers.Delete, logr.Discard())
+	default:
+		return nil, fmt.Errorf("unknown workflow action %q", workflowAction)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decode %s.%s pipelines: %w", workflowType, workflowAction, err)
+	}
+	return pipelines, nil
+}
+
+// GetPipeline returns the named pipeline of the workflow with the provided
+// type and action.
+func (p *PromiseImpl) GetPipeline(workflowType, workflowAction, name string) (*v1alpha1.Pipeline, error) {
+	pipelines, err := p.GetPipelines(workflowType, workflowAction)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pipelines {
+		if pipelines[i].GetName() == name {
+			return &pipelines[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%s.%s pipeline %s not found", workflowType, workflowAction, name)
+}
+
+// GetDependencies returns copies of the objects in the dependencies of the
+// Promise.
+func (p *PromiseImpl) GetDependencies() []*unstructured.Unstructured {
+	var objs []*unstructured.Unstructured
+	for _, dependency := range p.promise.Spec.Dependencies {
+		objs = append(objs, dependency.Unstructured.DeepCopy())
+	}
+	return objs
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/syntasso/kratix/api/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -104,4 +106,123 @@ var _ = Describe("The Promise interface", func() {
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring("path spec.nonExistent not found"))
 	})
+
+	It("returns ErrNoAPI when the promise has no API", func() {
+		_, err := promise.GetCRD()
+		Expect(err).To(MatchError(v1alpha1.ErrNoAPI))
+	})
+
+	When("the promise has an API and workflows", func() {
+		BeforeEach(func() {
+			promiseObject.Spec.API = &runtime.RawExtension{Raw: []byte(`{
+				"apiVersion": "apiextensions.k8s.io/v1",
+				"kind": "CustomResourceDefinition",
+				"metadata": {"name": "databases.example.com"},
+				"spec": {
+					"group": "example.com",
+					"names": {"kind": "Database", "plural": "databases", "singular": "database"},
+					"scope": "Namespaced",
+					"versions": [
+						{"name": "v1alpha1", "served": true, "storage": false, "schema": {"openAPIV3Schema": {"type": "object"}}},
+						{"name": "v1", "served": true, "storage": true, "schema": {"openAPIV3Schema": {
+							"type": "object",
+							"properties": {"spec": {"type": "object", "properties": {"size": {"type": "string"}}}}
+						}}},
+						{"name": "v2", "served": false, "storage": false}
+					]
+				}
+			}`)}
+			promiseObject.Spec.Workflows.Resource.Configure = []unstructured.Unstructured{
+				pipeline("instance-configure", "configure:v1"),
+				pipeline("instance-notify", "notify:v1"),
+			}
+			promiseObject.Spec.Workflows.Promise.Delete = []unstructured.Unstructured{
+				pipeline("promise-delete", "delete:v1"),
+			}
+		})
+
+		It("returns the parsed CRD", func() {
+			crd, err := promise.GetCRD()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crd.Spec.Group).To(Equal("example.com"))
+			Expect(crd.Spec.Names.Plural).To(Equal("databases"))
+			Expect(crd.Spec.Versions).To(HaveLen(3))
+		})
+
+		It("returns the storage version", func() {
+			Expect(promise.GetStorageVersion()).To(Equal("v1"))
+		})
+
+		It("returns the schema of each version", func() {
+			schema, err := promise.GetAPISchema("v1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schema.Properties["spec"].Properties).To(HaveKey("size"))
+
+			schema, err = promise.GetAPISchema("v1alpha1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schema.Type).To(Equal("object"))
+
+			_, err = promise.GetAPISchema("v2")
+			Expect(err).To(MatchError("promise api version v2 has no schema"))
+
+			_, err = promise.GetAPISchema("v3")
+			Expect(err).To(MatchError("promise api has no version v3"))
+		})
+
+		It("returns the decoded pipelines of each workflow", func() {
+			pipelines, err := promise.GetPipelines("resource", "configure")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pipelines).To(HaveLen(2))
+			Expect(pipelines[0].GetName()).To(Equal("instance-configure"))
+			Expect(pipelines[0].Spec.Containers[0].Image).To(Equal("configure:v1"))
+
+			pipelines, err = promise.GetPipelines("promise", "delete")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pipelines).To(HaveLen(1))
+
+			pipelines, err = promise.GetPipelines("resource", "delete")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pipelines).To(BeEmpty())
+
+			_, err = promise.GetPipelines("cluster", "configure")
+			Expect(err).To(MatchError(`unknown workflow type "cluster"`))
+			_, err = promise.GetPipelines("resource", "update")
+			Expect(err).To(MatchError(`unknown workflow action "update"`))
+		})
+
+		It("returns copies of the dependencies", func() {
+			promiseObject.Spec.Dependencies = v1alpha1.Dependencies{
+				{Unstructured: pipeline("dependency", "dependency:v1")},
+			}
+
+			dependencies := promise.GetDependencies()
+			Expect(dependencies).To(HaveLen(1))
+			Expect(dependencies[0].GetName()).To(Equal("dependency"))
+
+			dependencies[0].SetName("changed")
+			Expect(promiseObject.Spec.Dependencies[0].GetName()).To(Equal("dependency"))
+		})
+
+		It("returns a pipeline by name", func() {
+			p, err := promise.GetPipeline("resource", "configure", "instance-notify")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(p.Spec.Containers[0].Image).To(Equal("notify:v1"))
+
+			_, err = promise.GetPipeline("resource", "configure", "missing")
+			Expect(err).To(MatchError("resource.configure pipeline missing not found"))
+		})
+	})
 })
+
+func pipeline(name, image string) unstructured.Unstructured {
+	return unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "platform.kratix.io/v1alpha1",
+		"kind":       "Pipeline",
+		"metadata":   map[string]any{"name": name},
+		"spec": map[string]any{
+			"containers": []any{
+				map[string]any{"name": name, "image": image},
+			},
+		},
+	}}
+}
@@ -0,0 +1,123 @@
+package kratix
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/syntasso/kratix/api/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// The states of a required Promise, as reported by Kratix in the Promise status.
+const (
+	RequirementStateInstalled                      = "Requirement installed"
+	RequirementStateNotInstalled                   = "Requirement not installed"
+	RequirementStateNotInstalledAtSpecifiedVersion = "Requirement not installed at the specified version"
+	RequirementStateNotAvailable                   = "Requirement not available"
+
+	// RequirementsFulfilledConditionType is the type of the condition returned
+	// by RequiredPromisesResult.Condition.
+	RequirementsFulfilledConditionType = "RequirementsFulfilled"
+)
+
+// RequiredPromisesResult is the result of checking the required Promises of a
+// Promise against the platform cluster.
+type RequiredPromisesResult struct {
+	// Fulfilled is true when every required Promise is installed at the
+	// required version and available.
+	Fulfilled bool `json:"fulfilled"`
+	// RequiredPromises has the state of each required Promise.
+	RequiredPromises []v1alpha1.RequiredPromiseStatus `json:"requiredPromises,omitempty"`
+}
+
+// Condition returns a condition describing the result, to be published in the
+// status. When the result is not fulfilled, its reason is that of the first
+// required Promise not installed or not available.
+func (r RequiredPromisesResult) Condition() metav1.Condition {
+	condition := metav1.Condition{
+		Type:               RequirementsFulfilledConditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             "RequirementsInstalled",
+		Message:            "Requirements fulfilled",
+		LastTransitionTime: metav1.Now(),
+	}
+	if !r.Fulfilled {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = r.unfulfilledReason()
+		condition.Message = "Requirements not fulfilled"
+	}
+	return condition
+}
+
+// unfulfilledReason returns the reason of the first required Promise not
+// installed or not available.
+func (r RequiredPromisesResult) unfulfilledReason() string {
+	for _, status := range r.RequiredPromises {
+		switch status.State {
+		case RequirementStateNotInstalled, RequirementStateNotInstalledAtSpecifiedVersion:
+			return "RequirementsNotInstalled"
+		case RequirementStateNotAvailable:
+			return "RequirementsNotAvailable"
+		}
+	}
+	return "RequirementsNotFulfilled"
+}
+
+// CheckRequiredPromises fetches the required Promises of the Promise from the
+// platform cluster and reports whether they are installed at the required
+// version and available, with the states Kratix reports in the Promise status.
+// Unlike Kratix, which takes the reason of its condition from the last
+// required Promise not fulfilled, the reason of the condition is that of the
+// first. It returns an error, where Kratix would record an unknown state, if a
+// required Promise cannot be fetched for any reason but not existing.
+func (k *KratixSDK) CheckRequiredPromises(promise Promise) (RequiredPromisesResult, error) {
+	result := RequiredPromisesResult{Fulfilled: true}
+	required := promise.GetPromise().Spec.RequiredPromises
+	if len(required) == 0 {
+		return result, nil
+	}
+
+	objectClient, err := k.clientFor(promiseGVK, "", nil)
+	if err != nil {
+		return RequiredPromisesResult{}, err
+	}
+
+	for _, req := range required {
+		state, err := requirementState(objectClient, req)
+		if err != nil {
+			return RequiredPromisesResult{}, err
+		}
+		if state != RequirementStateInstalled {
+			result.Fulfilled = false
+		}
+		result.RequiredPromises = append(result.RequiredPromises, v1alpha1.RequiredPromiseStatus{
+			Name:    req.Name,
+			Version: req.Version,
+			State:   state,
+		})
+	}
+	return result, nil
+}
+
+// requirementState returns the state of the required Promise.
+func requirementState(objectClient ResourceInterface, req v1alpha1.RequiredPromise) (string, error) {
+	obj, err := objectClient.Get(context.Background(), req.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err), err == nil && obj == nil:
+		return RequirementStateNotInstalled, nil
+	case err != nil:
+		return "", fmt.Errorf("fetch required promise %s: %w", req.Name, err)
+	}
+
+	version, _, _ := unstructured.NestedString(obj.Object, "status", "version")
+	availability, _, _ := unstructured.NestedString(obj.Object, "status", "status")
+	switch {
+	case version != req.Version:
+		return RequirementStateNotInstalledAtSpecifiedVersion, nil
+	case availability != v1alpha1.PromiseStatusAvailable:
+		return RequirementStateNotAvailable, nil
+	}
+	return RequirementStateInstalled, nil
+}
@@ -0,0 +1,146 @@
+package kratix
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/syntasso/kratix/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+var _ = Describe("Checking the required promises", func() {
+	var (
+		sdk           *KratixSDK
+		dynamicClient *fake.FakeDynamicClient
+		promise       *PromiseImpl
+	)
+
+	installed := func(name, version, availability string) {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(promiseGVK)
+		obj.SetName(name)
+		Expect(unstructured.SetNestedField(obj.Object, version, "status", "version")).To(Succeed())
+		Expect(unstructured.SetNestedField(obj.Object, availability, "status", "status")).To(Succeed())
+		_, err := dynamicClient.Resource(v1alpha1.GroupVersion.WithResource("promises")).Create(context.Background(), obj, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		dynamicClient = fake.NewSimpleDynamicClient(runtime.NewScheme())
+		sdk = New(WithDynamicClient(dynamicClient))
+		promise = &PromiseImpl{promise: &v1alpha1.Promise{Spec: v1alpha1.PromiseSpec{
+			RequiredPromises: []v1alpha1.RequiredPromise{
+				{Name: "postgres", Version: "v1.0.0"},
+				{Name: "redis", Version: "v2.0.0"},
+			},
+		}}}
+	})
+
+	It("is fulfilled when there are no required promises", func() {
+		promise.promise.Spec.RequiredPromises = nil
+		result, err := sdk.CheckRequiredPromises(promise)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Fulfilled).To(BeTrue())
+		Expect(result.RequiredPromises).To(BeEmpty())
+	})
+
+	It("is fulfilled when every required promise is available at the required version", func() {
+		installed("postgres", "v1.0.0", "Available")
+		installed("redis", "v2.0.0", "Available")
+
+		result, err := sdk.CheckRequiredPromises(promise)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Fulfilled).To(BeTrue())
+		Expect(result.RequiredPromises).To(Equal([]v1alpha1.RequiredPromiseStatus{
+			{Name: "postgres", Version: "v1.0.0", State: RequirementStateInstalled},
+			{Name: "redis", Version: "v2.0.0", State: RequirementStateInstalled},
+		}))
+
+		condition := result.Condition()
+		Expect(condition.Type).To(Equal(RequirementsFulfilledConditionType))
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("RequirementsInstalled"))
+	})
+
+	It("reports the state of the required promises that are not fulfilled", func() {
+		installed("postgres", "v0.9.0", "Available")
+
+		result, err := sdk.CheckRequiredPromises(promise)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Fulfilled).To(BeFalse())
+		Expect(result.RequiredPromises).To(Equal([]v1alpha1.RequiredPromiseStatus{
+			{Name: "postgres", Version: "v1.0.0", State: RequirementStateNotInstalledAtSpecifiedVersion},
+			{Name: "redis", Version: "v2.0.0", State: RequirementStateNotInstalled},
+		}))
+
+		condition := result.Condition()
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("RequirementsNotInstalled"))
+		Expect(condition.Message).To(Equal("Requirements not fulfilled"))
+	})
+
+	It("reports required promises that are not available", func() {
+		installed("postgres", "v1.0.0", "Unavailable")
+		installed("redis", "v2.0.0", "Available")
+
+		result, err := sdk.CheckRequiredPromises(promise)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequiredPromises[0].State).To(Equal(RequirementStateNotAvailable))
+		Expect(result.Condition().Reason).To(Equal("RequirementsNotAvailable"))
+	})
+
+	It("takes the reason from the first required promise not fulfilled", func() {
+		installed("postgres", "v1.0.0", "Unavailable")
+
+		result, err := sdk.CheckRequiredPromises(promise)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequiredPromises[1].State).To(Equal(RequirementStateNotInstalled))
+		Expect(result.Condition().Reason).To(Equal("RequirementsNotAvailable"))
+	})
+
+	It("reports required promises the client returns no object for as not installed", func() {
+		dynamicClient.PrependReactor("get", "promises", func(clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, nil
+		})
+
+		result, err := sdk.CheckRequiredPromises(promise)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequiredPromises[0].State).To(Equal(RequirementStateNotInstalled))
+		Expect(result.Condition().Reason).To(Equal("RequirementsNotInstalled"))
+	})
+
+	It("keeps the reason of the condition when the result is decoded from JSON", func() {
+		installed("postgres", "v1.0.0", "Unavailable")
+		result, err := sdk.CheckRequiredPromises(promise)
+		Expect(err).ToNot(HaveOccurred())
+
+		data, err := json.Marshal(result)
+		Expect(err).ToNot(HaveOccurred())
+		var decoded RequiredPromisesResult
+		Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+		Expect(decoded.Condition().Reason).To(Equal("RequirementsNotAvailable"))
+	})
+
+	It("gives a reason to the condition of a result without required promise states", func() {
+		condition := RequiredPromisesResult{}.Condition()
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("RequirementsNotFulfilled"))
+	})
+
+	It("errors when a required promise cannot be fetched", func() {
+		dynamicClient.PrependReactor("get", "promises", func(clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("connection refused")
+		})
+
+		_, err := sdk.CheckRequiredPromises(promise)
+		Expect(err).To(MatchError("fetch required promise postgres: connection refused"))
+	})
+})
@@ -1,16 +1,57 @@
 package kratix
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 )
 
+//go:generate go tool counterfeiter . Resource
 type Resource interface {
-	// GetValue queries the resource and returns the value at the specified path e.g. spec.dbConfig.size
+	// GetValue queries the resource and returns the value at the specified path e.g. spec.dbConfig.size or spec.fields[0].name
 	GetValue(string) (any, error)
+	// GetValues queries the resource and returns every value matched by the specified path e.g. spec.fields[].name
+	GetValues(string) ([]any, error)
+	// GetString returns the string at the specified path
+	GetString(string) (string, error)
+	// GetStringOrDefault returns the string at the specified path, or the default if the path does not exist or is null
+	GetStringOrDefault(string, string) (string, error)
+	// GetInt64 returns the integer at the specified path
+	GetInt64(string) (int64, error)
+	// GetInt64OrDefault returns the integer at the specified path, or the default if the path does not exist or is null
+	GetInt64OrDefault(string, int64) (int64, error)
+	// GetFloat64 returns the number at the specified path
+	GetFloat64(string) (float64, error)
+	// GetFloat64OrDefault returns the number at the specified path, or the default if the path does not exist or is null
+	GetFloat64OrDefault(string, float64) (float64, error)
+	// GetBool returns the boolean at the specified path
+	GetBool(string) (bool, error)
+	// GetBoolOrDefault returns the boolean at the specified path, or the default if the path does not exist or is null
+	GetBoolOrDefault(string, bool) (bool, error)
+	// GetStringSlice returns the list of strings at the specified path
+	GetStringSlice(string) ([]string, error)
+	// GetStringSliceOrDefault returns the list of strings at the specified path, or the default if the path does not exist or is null
+	GetStringSliceOrDefault(string, []string) ([]string, error)
+	// GetStringMap returns the map of strings at the specified path
+	GetStringMap(string) (map[string]string, error)
+	// GetStringMapOrDefault returns the map of strings at the specified path, or the default if the path does not exist or is null
+	GetStringMapOrDefault(string, map[string]string) (map[string]string, error)
+	// GetDuration parses the duration at the specified path e.g. 30s
+	GetDuration(string) (time.Duration, error)
+	// GetDurationOrDefault parses the duration at the specified path, or returns the default if the path does not exist
+	GetDurationOrDefault(string, time.Duration) (time.Duration, error)
+	// GetQuantity parses the Kubernetes quantity at the specified path e.g. 10Gi
+	GetQuantity(string) (resource.Quantity, error)
+	// GetQuantityOrDefault parses the Kubernetes quantity at the specified path, or returns the default if the path does not exist
+	GetQuantityOrDefault(string, resource.Quantity) (resource.Quantity, error)
 	// GetStatus queries the resource and returns the resource.status
 	GetStatus() (Status, error)
 	// GetName queries the resource and returns the name
@@ -23,28 +64,81 @@ type Resource interface {
 	GetLabels() map[string]string
 	// GetAnnotations queries the resource and returns the annotations
 	GetAnnotations() map[string]string
+	// GetUID queries the resource and returns the UID
+	GetUID() types.UID
+	// GetGeneration queries the resource and returns the metadata.generation
+	GetGeneration() int64
+	// GetResourceVersion queries the resource and returns the resourceVersion
+	GetResourceVersion() string
+	// GetCreationTimestamp queries the resource and returns the creationTimestamp
+	GetCreationTimestamp() metav1.Time
+	// GetDeletionTimestamp queries the resource and returns the deletionTimestamp, or nil if it is not being deleted
+	GetDeletionTimestamp() *metav1.Time
+	// GetFinalizers queries the resource and returns the finalizers
+	GetFinalizers() []string
+	// GetOwnerReferences queries the resource and returns the owner references
+	GetOwnerReferences() []metav1.OwnerReference
+	// GetMetadata returns a copy of the raw metadata, without the managedFields
+	GetMetadata() map[string]any
+	// ToOwnerReference returns a controller OwnerReference pointing at the resource, for use in generated objects
+	ToOwnerReference() metav1.OwnerReference
+	// SetLabel sets the label on the resource
+	SetLabel(key, value string)
+	// SetAnnotation sets the annotation on the resource
+	SetAnnotation(key, value string)
+	// SetValue sets the value at the specified path e.g. spec.dbConfig.id
+	SetValue(path string, value any) error
+	// Diff returns a JSON merge patch with the changes made to the resource, or nil if nothing changed
+	Diff() ([]byte, error)
 	// GetUnstructured returns the underlying unstructured object
 	ToUnstructured() unstructured.Unstructured
 }
 
+// ErrNotFound is returned when the queried path does not exist on the resource.
+var ErrNotFound = errors.New("not found")
+
 // ResourceImpl implements contract.Resource backed by an unstructured object.
 type ResourceImpl struct {
 	obj unstructured.Unstructured
+	// original is the state of the object before it was first changed
+	original map[string]any
 }
 
 var _ Resource = (*ResourceImpl)(nil)
 
 // GetValue returns the value at the provided path.
+// Besides dotted paths, it accepts list indexes, iterations and jq queries,
+// returning the first result. Keys containing dots can be escaped with a
+// backslash or quoted.
+// Examples:
+//   - "spec.fields[0].name" -> returns the name of the first field
+//   - "spec.fields[].value" -> returns the value of the first field
+//   - "metadata.annotations.kratix\.io/foo" -> returns the kratix.io/foo annotation
+//   - `metadata.annotations["kratix.io/foo"]` -> returns the kratix.io/foo annotation
+//   - ".spec.fields | length" -> returns the number of fields
+//
+// It returns ErrNotFound when the path does not exist, and nil for fields
+// explicitly set to null.
 func (r *ResourceImpl) GetValue(path string) (any, error) {
-	path = strings.TrimPrefix(path, ".")
-	val, found, err := unstructured.NestedFieldNoCopy(r.obj.Object, strings.Split(path, ".")...)
+	values, err := r.GetValues(path)
+	if err != nil {
+		return nil, err
+	}
+	return values[0], nil
+}
+
+// GetValues returns every value matched by the provided path, e.g. all the
+// values of "spec.fields[].value". It accepts the same paths as GetValue and
+// returns ErrNotFound when nothing matches.
+func (r *ResourceImpl) GetValues(path string) ([]any, error) {
+	values, err := r.query(path)
 	if err != nil {
 		return nil, err
 	}
-	if !found {
-		return nil, fmt.Errorf("path %s not found", path)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("path %s %w", strings.TrimPrefix(path, "."), ErrNotFound)
 	}
-	return val, nil
+	return values, nil
 }
 
 // GetStatus returns the Status of the Object
@@ -75,5 +169,52 @@ func (r *ResourceImpl) GetLabels() map[string]string { return r.obj.GetLabels()
 // GetAnnotations returns the annotations of the resource.
 func (r *ResourceImpl) GetAnnotations() map[string]string { return r.obj.GetAnnotations() }
 
+// GetUID returns the UID of the resource.
+func (r *ResourceImpl) GetUID() types.UID { return r.obj.GetUID() }
+
+// GetGeneration returns the generation of the resource.
+func (r *ResourceImpl) GetGeneration() int64 { return r.obj.GetGeneration() }
+
+// GetResourceVersion returns the resourceVersion of the resource.
+func (r *ResourceImpl) GetResourceVersion() string { return r.obj.GetResourceVersion() }
+
+// GetCreationTimestamp returns the creationTimestamp of the resource.
+func (r *ResourceImpl) GetCreationTimestamp() metav1.Time { return r.obj.GetCreationTimestamp() }
+
+// GetDeletionTimestamp returns the deletionTimestamp of the resource.
+func (r *ResourceImpl) GetDeletionTimestamp() *metav1.Time { return r.obj.GetDeletionTimestamp() }
+
+// GetFinalizers returns the finalizers of the resource.
+func (r *ResourceImpl) GetFinalizers() []string { return r.obj.GetFinalizers() }
+
+// GetOwnerReferences returns the owner references of the resource.
+func (r *ResourceImpl) GetOwnerReferences() []metav1.OwnerReference { return r.obj.GetOwnerReferences() }
+
+// GetMetadata returns a copy of the metadata of the resource, without the managedFields.
+func (r *ResourceImpl) GetMetadata() map[string]any {
+	metadata, ok := r.obj.Object["metadata"].(map[string]any)
+	if !ok {
+		return map[string]any{}
+	}
+	metadata = runtime.DeepCopyJSONValue(metadata).(map[string]any)
+	delete(metadata, "managedFields")
+	return metadata
+}
+
+// ToOwnerReference returns a controller OwnerReference pointing at the resource.
+func (r *ResourceImpl) ToOwnerReference() metav1.OwnerReference {
+	return *metav1.NewControllerRef(&r.obj, r.obj.GroupVersionKind())
+}
+
 // GetUnstructured returns the underlying unstructured object for the resource.
 func (r *ResourceImpl) ToUnstructured() unstructured.Unstructured { return r.obj }
+
+// Decode converts the Resource into out, a pointer to a typed struct such as
+// the ones generated from the Promise API with the codegen package.
+func Decode(res Resource, out any) error {
+	obj := res.ToUnstructured()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, out); err != nil {
+		return fmt.Errorf("decode %s: %w", res.GetName(), err)
+	}
+	return nil
+}
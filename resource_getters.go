@@ -0,0 +1,238 @@
+package kratix
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// TypeMismatchError is returned by the typed getters when the value at Path is
+// not of the expected type.
+type TypeMismatchError struct {
+	Path     string
+	Expected string
+	Actual   string
+}
+
+func (e *TypeMismatchError) Error() string {
+	return fmt.Sprintf("path %s: expected %s, found %s", e.Path, e.Expected, e.Actual)
+}
+
+func mismatch(path, expected string, val any) error {
+	return &TypeMismatchError{Path: path, Expected: expected, Actual: fmt.Sprintf("%T", val)}
+}
+
+// getTyped queries the path and converts the value with convert.
+func getTyped[T any](r *ResourceImpl, path string, convert func(string, any) (T, error)) (T, error) {
+	var zero T
+	val, err := r.GetValue(path)
+	if err != nil {
+		return zero, err
+	}
+	return convert(path, val)
+}
+
+// getTypedOrDefault behaves like getTyped, returning def when the path does not
+// exist or is null.
+func getTypedOrDefault[T any](r *ResourceImpl, path string, def T, convert func(string, any) (T, error)) (T, error) {
+	val, err := r.GetValue(path)
+	if errors.Is(err, ErrNotFound) || err == nil && val == nil {
+		return def, nil
+	}
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return convert(path, val)
+}
+
+func toString(path string, val any) (string, error) {
+	s, ok := val.(string)
+	if !ok {
+		return "", mismatch(path, "string", val)
+	}
+	return s, nil
+}
+
+func toInt64(path string, val any) (int64, error) {
+	switch v := val.(type) {
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case float64:
+		if v == math.Trunc(v) && v >= math.MinInt64 && v < math.MaxInt64 {
+			return int64(v), nil
+		}
+	}
+	return 0, mismatch(path, "int64", val)
+}
+
+func toFloat64(path string, val any) (float64, error) {
+	switch v := val.(type) {
+	case float64:
+		return v, nil
+	case int64:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	}
+	return 0, mismatch(path, "float64", val)
+}
+
+func toBool(path string, val any) (bool, error) {
+	b, ok := val.(bool)
+	if !ok {
+		return false, mismatch(path, "bool", val)
+	}
+	return b, nil
+}
+
+func toStringSlice(path string, val any) ([]string, error) {
+	items, ok := val.([]any)
+	if !ok {
+		return nil, mismatch(path, "[]string", val)
+	}
+	out := make([]string, 0, len(items))
+	for i, item := range items {
+		s, err := toString(fmt.Sprintf("%s[%d]", path, i), item)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+func toStringMap(path string, val any) (map[string]string, error) {
+	m, ok := val.(map[string]any)
+	if !ok {
+		return nil, mismatch(path, "map[string]string", val)
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		s, err := toString(path+"."+k, v)
+		if err != nil {
+			return nil, err
+		}
+		out[k] = s
+	}
+	return out, nil
+}
+
+func toDuration(path string, val any) (time.Duration, error) {
+	s, err := toString(path, val)
+	if err != nil {
+		return 0, err
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("path %s: invalid duration: %w", path, err)
+	}
+	return d, nil
+}
+
+func toQuantity(path string, val any) (resource.Quantity, error) {
+	switch v := val.(type) {
+	case string:
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return resource.Quantity{}, fmt.Errorf("path %s: invalid quantity %q: %w", path, v, err)
+		}
+		return q, nil
+	case int64, int, int32:
+		i, _ := toInt64(path, v)
+		return *resource.NewQuantity(i, resource.DecimalSI), nil
+	case float64:
+		return toQuantity(path, fmt.Sprint(v))
+	}
+	return resource.Quantity{}, mismatch(path, "quantity", val)
+}
+
+// GetString returns the string at the provided path.
+func (r *ResourceImpl) GetString(path string) (string, error) {
+	return getTyped(r, path, toString)
+}
+
+// GetStringOrDefault returns the string at the provided path, or def if the path does not exist.
+func (r *ResourceImpl) GetStringOrDefault(path string, def string) (string, error) {
+	return getTypedOrDefault(r, path, def, toString)
+}
+
+// GetInt64 returns the integer at the provided path. Whole floating point
+// numbers are accepted, as YAML decoders may produce them for integers.
+func (r *ResourceImpl) GetInt64(path string) (int64, error) {
+	return getTyped(r, path, toInt64)
+}
+
+// GetInt64OrDefault returns the integer at the provided path, or def if the path does not exist.
+func (r *ResourceImpl) GetInt64OrDefault(path string, def int64) (int64, error) {
+	return getTypedOrDefault(r, path, def, toInt64)
+}
+
+// GetFloat64 returns the number at the provided path.
+func (r *ResourceImpl) GetFloat64(path string) (float64, error) {
+	return getTyped(r, path, toFloat64)
+}
+
+// GetFloat64OrDefault returns the number at the provided path, or def if the path does not exist.
+func (r *ResourceImpl) GetFloat64OrDefault(path string, def float64) (float64, error) {
+	return getTypedOrDefault(r, path, def, toFloat64)
+}
+
+// GetBool returns the boolean at the provided path.
+func (r *ResourceImpl) GetBool(path string) (bool, error) {
+	return getTyped(r, path, toBool)
+}
+
+// GetBoolOrDefault returns the boolean at the provided path, or def if the path does not exist.
+func (r *ResourceImpl) GetBoolOrDefault(path string, def bool) (bool, error) {
+	return getTypedOrDefault(r, path, def, toBool)
+}
+
+// GetStringSlice returns the list of strings at the provided path.
+func (r *ResourceImpl) GetStringSlice(path string) ([]string, error) {
+	return getTyped(r, path, toStringSlice)
+}
+
+// GetStringSliceOrDefault returns the list of strings at the provided path, or def if the path does not exist.
+func (r *ResourceImpl) GetStringSliceOrDefault(path string, def []string) ([]string, error) {
+	return getTypedOrDefault(r, path, def, toStringSlice)
+}
+
+// GetStringMap returns the map of strings at the provided path.
+func (r *ResourceImpl) GetStringMap(path string) (map[string]string, error) {
+	return getTyped(r, path, toStringMap)
+}
+
+// GetStringMapOrDefault returns the map of strings at the provided path, or def if the path does not exist.
+func (r *ResourceImpl) GetStringMapOrDefault(path string, def map[string]string) (map[string]string, error) {
+	return getTypedOrDefault(r, path, def, toStringMap)
+}
+
+// GetDuration parses the duration string at the provided path, e.g. 30s.
+func (r *ResourceImpl) GetDuration(path string) (time.Duration, error) {
+	return getTyped(r, path, toDuration)
+}
+
+// GetDurationOrDefault parses the duration at the provided path, or returns def if the path does not exist.
+func (r *ResourceImpl) GetDurationOrDefault(path string, def time.Duration) (time.Duration, error) {
+	return getTypedOrDefault(r, path, def, toDuration)
+}
+
+// GetQuantity parses the Kubernetes quantity at the provided path, e.g. 10Gi.
+func (r *ResourceImpl) GetQuantity(path string) (resource.Quantity, error) {
+	return getTyped(r, path, toQuantity)
+}
+
+// GetQuantityOrDefault parses the quantity at the provided path, or returns def if the path does not exist.
+func (r *ResourceImpl) GetQuantityOrDefault(path string, def resource.Quantity) (resource.Quantity, error) {
+	return getTypedOrDefault(r, path, def, toQuantity)
+}
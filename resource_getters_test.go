@@ -0,0 +1,102 @@
+package kratix
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("Typed getters", func() {
+	var r *ResourceImpl
+
+	BeforeEach(func() {
+		r = &ResourceImpl{obj: unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{
+				"name":     "db",
+				"replicas": int64(3),
+				"ratio":    0.5,
+				"count":    float64(7),
+				"enabled":  true,
+				"features": []any{"logging", "monitoring"},
+				"mixed":    []any{"logging", int64(1)},
+				"tags":     map[string]any{"team": "data"},
+				"timeout":  "30s",
+				"storage":  "10Gi",
+				"cpu":      int64(2),
+			},
+		}}}
+	})
+
+	It("returns values of the expected type", func() {
+		Expect(r.GetString("spec.name")).To(Equal("db"))
+		Expect(r.GetInt64("spec.replicas")).To(Equal(int64(3)))
+		Expect(r.GetInt64("spec.count")).To(Equal(int64(7)))
+		Expect(r.GetFloat64("spec.ratio")).To(Equal(0.5))
+		Expect(r.GetFloat64("spec.replicas")).To(Equal(float64(3)))
+		Expect(r.GetBool("spec.enabled")).To(BeTrue())
+		Expect(r.GetStringSlice("spec.features")).To(Equal([]string{"logging", "monitoring"}))
+		Expect(r.GetStringMap("spec.tags")).To(Equal(map[string]string{"team": "data"}))
+		Expect(r.GetDuration("spec.timeout")).To(Equal(30 * time.Second))
+		Expect(r.GetQuantity("spec.storage")).To(Equal(resource.MustParse("10Gi")))
+
+		cpu, err := r.GetQuantity("spec.cpu")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cpu.Value()).To(Equal(int64(2)))
+	})
+
+	It("returns the default when the path does not exist", func() {
+		Expect(r.GetStringOrDefault("spec.missing", "fallback")).To(Equal("fallback"))
+		Expect(r.GetInt64OrDefault("spec.missing", 1)).To(Equal(int64(1)))
+		Expect(r.GetFloat64OrDefault("spec.missing", 1.5)).To(Equal(1.5))
+		Expect(r.GetBoolOrDefault("spec.missing", true)).To(BeTrue())
+		Expect(r.GetStringSliceOrDefault("spec.missing", []string{"a"})).To(Equal([]string{"a"}))
+		Expect(r.GetStringMapOrDefault("spec.missing", map[string]string{"a": "b"})).To(Equal(map[string]string{"a": "b"}))
+		Expect(r.GetDurationOrDefault("spec.missing", time.Minute)).To(Equal(time.Minute))
+		Expect(r.GetQuantityOrDefault("spec.missing", resource.MustParse("1Gi"))).To(Equal(resource.MustParse("1Gi")))
+
+		Expect(r.GetStringOrDefault("spec.name", "fallback")).To(Equal("db"))
+	})
+
+	It("returns the default when the value is null", func() {
+		r.obj.Object["spec"].(map[string]any)["cleared"] = nil
+		Expect(r.GetStringOrDefault("spec.cleared", "fallback")).To(Equal("fallback"))
+		Expect(r.GetInt64OrDefault("spec.cleared", 1)).To(Equal(int64(1)))
+
+		_, err := r.GetString("spec.cleared")
+		Expect(err).To(MatchError("path spec.cleared: expected string, found <nil>"))
+	})
+
+	It("returns a not found error without a default", func() {
+		_, err := r.GetString("spec.missing")
+		Expect(err).To(MatchError(ErrNotFound))
+		Expect(err).To(MatchError("path spec.missing not found"))
+	})
+
+	It("returns type mismatch errors with the path and the type found", func() {
+		_, err := r.GetString("spec.replicas")
+		var mismatchErr *TypeMismatchError
+		Expect(err).To(BeAssignableToTypeOf(mismatchErr))
+		Expect(err).To(MatchError("path spec.replicas: expected string, found int64"))
+
+		_, err = r.GetInt64("spec.ratio")
+		Expect(err).To(MatchError("path spec.ratio: expected int64, found float64"))
+
+		_, err = r.GetStringSlice("spec.mixed")
+		Expect(err).To(MatchError("path spec.mixed[1]: expected string, found int64"))
+
+		_, err = r.GetBoolOrDefault("spec.name", false)
+		Expect(err).To(MatchError("path spec.name: expected bool, found string"))
+	})
+
+	It("returns an error for unparseable durations and quantities", func() {
+		_, err := r.GetDuration("spec.name")
+		Expect(err).To(MatchError(ContainSubstring("path spec.name: invalid duration")))
+
+		_, err = r.GetQuantity("spec.name")
+		Expect(err).To(MatchError(ContainSubstring(`path spec.name: invalid quantity "db"`)))
+	})
+})
@@ -0,0 +1,147 @@
+package kratix
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/syntasso/kratix-go/internal/objutil"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SetLabel sets the label on the resource.
+func (r *ResourceImpl) SetLabel(key, value string) {
+	r.track()
+	labels := r.obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[key] = value
+	r.obj.SetLabels(labels)
+}
+
+// SetAnnotation sets the annotation on the resource.
+func (r *ResourceImpl) SetAnnotation(key, value string) {
+	r.track()
+	annotations := r.obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	r.obj.SetAnnotations(annotations)
+}
+
+// SetValue sets the value at the provided path, creating any missing objects
+// along the way. The resource is left unchanged when it returns an error. It
+// accepts dotted paths with list indexes and escaped keys, e.g.
+// spec.fields[0].name, but not iterations or jq queries. The status cannot be
+// set, use PublishStatus instead.
+func (r *ResourceImpl) SetValue(path string, value any) error {
+	elements, ok := parseSimplePath(path)
+	if !ok || len(elements) == 0 {
+		return fmt.Errorf("path %s: only dotted paths with list indexes can be set", path)
+	}
+	if !elements[0].isIndex && !elements[0].iterate && elements[0].key == "status" {
+		return fmt.Errorf("path %s: the status cannot be set, use PublishStatus instead", path)
+	}
+	for _, element := range elements {
+		if element.iterate {
+			return fmt.Errorf("path %s: only dotted paths with list indexes can be set", path)
+		}
+	}
+
+	val, err := objutil.ToJSON(value)
+	if err != nil {
+		return fmt.Errorf("path %s: %w", path, err)
+	}
+
+	// The change is made on a copy, so a path that turns out to be invalid
+	// does not leave the objects created along the way in the resource.
+	obj := runtime.DeepCopyJSON(r.obj.Object)
+	path = strings.TrimPrefix(path, ".")
+	var current any = obj
+	for i, element := range elements {
+		last := i == len(elements)-1
+		if element.isIndex {
+			list, ok := current.([]any)
+			if !ok {
+				return fmt.Errorf("path %s: cannot index %T with %d", path, current, element.index)
+			}
+			if element.index >= len(list) {
+				return fmt.Errorf("path %s: index %d out of range", path, element.index)
+			}
+			if last {
+				list[element.index] = val
+				break
+			}
+			current = list[element.index]
+			continue
+		}
+
+		m, ok := current.(map[string]any)
+		if !ok {
+			return fmt.Errorf("path %s: cannot access key %q on %T", path, element.key, current)
+		}
+		if last {
+			m[element.key] = val
+			break
+		}
+		if _, found := m[element.key]; !found {
+			m[element.key] = map[string]any{}
+		}
+		current = m[element.key]
+	}
+
+	r.track()
+	r.obj.Object = obj
+	return nil
+}
+
+// Diff returns a JSON merge patch with the changes made to the resource since
+// it was read, or since it was last published. It returns nil when nothing
+// changed. Changes to the status are not included.
+func (r *ResourceImpl) Diff() ([]byte, error) {
+	if r.original == nil {
+		return nil, nil
+	}
+
+	original, err := json.Marshal(withoutStatus(r.original))
+	if err != nil {
+		return nil, fmt.Errorf("marshal original resource: %w", err)
+	}
+	modified, err := json.Marshal(withoutStatus(r.obj.Object))
+	if err != nil {
+		return nil, fmt.Errorf("marshal modified resource: %w", err)
+	}
+	patch, err := jsonpatch.CreateMergePatch(original, modified)
+	if err != nil {
+		return nil, fmt.Errorf("create merge patch: %w", err)
+	}
+	if string(patch) == "{}" {
+		return nil, nil
+	}
+	return patch, nil
+}
+
+// track records the state of the resource before its first change.
+func (r *ResourceImpl) track() {
+	if r.original == nil {
+		r.original = runtime.DeepCopyJSON(r.obj.Object)
+	}
+}
+
+// resetChanges makes the current state of the resource the baseline for Diff.
+func (r *ResourceImpl) resetChanges() {
+	r.original = nil
+}
+
+func withoutStatus(obj map[string]any) map[string]any {
+	out := make(map[string]any, len(obj))
+	for k, v := range obj {
+		if k != "status" {
+			out[k] = v
+		}
+	}
+	return out
+}
@@ -0,0 +1,200 @@
+package kratix
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/itchyny/gojq"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// pathElement is a single step of a simple path: a map key, a list index, or
+// an iteration over every item of a list or map.
+type pathElement struct {
+	key     string
+	index   int
+	isIndex bool
+	iterate bool
+}
+
+// parseSimplePath parses paths such as spec.fields[0].name, spec.fields[].value
+// or metadata.annotations.kratix\.io/foo. It returns false when the path uses
+// any other jq syntax, in which case it should be evaluated as a jq query.
+func parseSimplePath(path string) ([]pathElement, bool) {
+	var elements []pathElement
+	var key strings.Builder
+	inKey := false
+
+	flush := func() {
+		if inKey {
+			elements = append(elements, pathElement{key: key.String()})
+			key.Reset()
+			inKey = false
+		}
+	}
+
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		switch {
+		case c == '\\':
+			if i+1 == len(path) {
+				return nil, false
+			}
+			i++
+			key.WriteByte(path[i])
+			inKey = true
+		case c == '.':
+			if !inKey && i > 0 && path[i-1] == '.' {
+				return nil, false
+			}
+			flush()
+		case c == '[':
+			flush()
+			end := strings.IndexByte(path[i:], ']')
+			if end == -1 {
+				return nil, false
+			}
+			element, ok := parseBracket(path[i+1 : i+end])
+			if !ok {
+				return nil, false
+			}
+			elements = append(elements, element)
+			i += end
+		case strings.IndexByte(" |,()$=!<>?+*%{};'\"", c) != -1:
+			return nil, false
+		default:
+			key.WriteByte(c)
+			inKey = true
+		}
+	}
+	flush()
+	return elements, true
+}
+
+func parseBracket(content string) (pathElement, bool) {
+	if content == "" {
+		return pathElement{iterate: true}, true
+	}
+	if strings.HasPrefix(content, `"`) {
+		var key string
+		if err := json.Unmarshal([]byte(content), &key); err != nil {
+			return pathElement{}, false
+		}
+		return pathElement{key: key}, true
+	}
+	index, err := strconv.Atoi(content)
+	if err != nil || index < 0 {
+		return pathElement{}, false
+	}
+	return pathElement{index: index, isIndex: true}, true
+}
+
+// traverse follows the elements from val, returning every value reached, with
+// the values of maps in the order of their keys. Missing keys and out of range
+// indexes produce no value, while explicit nulls produce a nil value.
+func traverse(path string, val any, elements []pathElement) ([]any, error) {
+	if len(elements) == 0 {
+		return []any{val}, nil
+	}
+
+	element, rest := elements[0], elements[1:]
+	switch {
+	case element.iterate:
+		var children []any
+		switch v := val.(type) {
+		case []any:
+			children = v
+		case map[string]any:
+			for _, key := range slices.Sorted(maps.Keys(v)) {
+				children = append(children, v[key])
+			}
+		default:
+			return nil, fmt.Errorf("path %s: cannot iterate over %T", path, val)
+		}
+		var results []any
+		for _, child := range children {
+			childResults, err := traverse(path, child, rest)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, childResults...)
+		}
+		return results, nil
+	case element.isIndex:
+		list, ok := val.([]any)
+		if !ok {
+			return nil, fmt.Errorf("path %s: cannot index %T with %d", path, val, element.index)
+		}
+		if element.index >= len(list) {
+			return nil, nil
+		}
+		return traverse(path, list[element.index], rest)
+	default:
+		m, ok := val.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("path %s: cannot access key %q on %T", path, element.key, val)
+		}
+		child, found := m[element.key]
+		if !found {
+			return nil, nil
+		}
+		return traverse(path, child, rest)
+	}
+}
+
+// queryJQ evaluates the path as a jq query against the object.
+func queryJQ(path string, obj map[string]any) ([]any, error) {
+	path, err := normalisePath(path)
+	if err != nil {
+		return nil, err
+	}
+	query, err := gojq.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("path %s: %w", path, err)
+	}
+
+	// gojq normalises the numbers of its input in place, so query a copy to
+	// keep the integers of the resource as int64.
+	var results []any
+	iter := query.Run(runtime.DeepCopyJSON(obj))
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := v.(error); ok {
+			return nil, fmt.Errorf("path %s: %w", path, err)
+		}
+		results = append(results, normaliseNumbers(v))
+	}
+	return results, nil
+}
+
+// query evaluates the path against the resource. Simple paths return explicit
+// nulls, while the null results of jq queries are dropped, as jq cannot tell
+// them apart from missing keys.
+func (r *ResourceImpl) query(path string) ([]any, error) {
+	if path == "" {
+		return nil, fmt.Errorf("path cannot be empty")
+	}
+
+	if elements, ok := parseSimplePath(path); ok {
+		return traverse(strings.TrimPrefix(path, "."), r.obj.Object, elements)
+	}
+	results, err := queryJQ(path, r.obj.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	values := results[:0]
+	for _, v := range results {
+		if v != nil {
+			values = append(values, v)
+		}
+	}
+	return values, nil
+}
@@ -1,11 +1,15 @@
 package kratix
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 var _ = Describe("ResourceImpl", func() {
@@ -20,6 +24,10 @@ var _ = Describe("ResourceImpl", func() {
 				"dbConfig": map[string]any{
 					"size": "small",
 				},
+				"fields": []any{
+					map[string]any{"name": "first", "value": "one"},
+					map[string]any{"name": "second", "value": "two"},
+				},
 			},
 		}
 		resourceObject.SetUnstructuredContent(spec)
@@ -43,6 +51,16 @@ var _ = Describe("ResourceImpl", func() {
 		}
 		resourceObject.SetLabels(labels)
 
+		resourceObject.SetUID("1234-5678")
+		resourceObject.SetGeneration(3)
+		resourceObject.SetResourceVersion("42")
+		resourceObject.SetCreationTimestamp(metav1.NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)))
+		resourceObject.SetFinalizers([]string{"kratix.io/workflows-cleanup"})
+		resourceObject.SetOwnerReferences([]metav1.OwnerReference{
+			{APIVersion: "platform.kratix.io/v1alpha1", Kind: "Promise", Name: "mykind", UID: "abcd"},
+		})
+		resourceObject.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl"}})
+
 		resource = ResourceImpl{
 			obj: resourceObject,
 		}
@@ -95,6 +113,174 @@ var _ = Describe("ResourceImpl", func() {
 			It("can handle dot-prefixed keys", func() {
 				Expect(resource.GetValue(".spec.dbConfig.size")).To(Equal("small"))
 			})
+
+			It("can index into lists", func() {
+				Expect(resource.GetValue("spec.fields[1].name")).To(Equal("second"))
+			})
+
+			It("returns the first result when iterating over lists", func() {
+				Expect(resource.GetValue("spec.fields[].value")).To(Equal("one"))
+			})
+
+			It("can access keys containing dots", func() {
+				Expect(resource.GetValue(`metadata.annotations.app\.kubernetes\.io/name`)).To(Equal("my-resource"))
+				Expect(resource.GetValue(`metadata.annotations["app.kubernetes.io/managed-by"]`)).To(Equal("kratix"))
+				Expect(resource.GetValue(`metadata.labels.sdk\.io/type`)).To(Equal("resource"))
+			})
+
+			It("can run jq queries", func() {
+				Expect(resource.GetValue(".spec.fields | length")).To(Equal(int64(2)))
+				Expect(resource.GetValue(`.spec.fields[] | select(.name == "second") | .value`)).To(Equal("two"))
+			})
+		})
+
+		When("the value does not exist", func() {
+			It("returns a not found error", func() {
+				_, err := resource.GetValue("spec.fields[5].name")
+				Expect(err).To(MatchError("path spec.fields[5].name not found"))
+
+				_, err = resource.GetValue(".spec.missing | .nested")
+				Expect(err).To(MatchError(ErrNotFound))
+			})
+		})
+
+		When("the value is explicitly null", func() {
+			It("returns nil without an error", func() {
+				resource.obj.Object["spec"].(map[string]any)["cleared"] = nil
+				Expect(resource.GetValue("spec.cleared")).To(BeNil())
+
+				_, err := resource.GetValue(".spec.cleared | .nested")
+				Expect(err).To(MatchError(ErrNotFound))
+			})
+		})
+
+		When("the path does not match the structure of the resource", func() {
+			It("returns an error", func() {
+				_, err := resource.GetValue("spec.dbConfig.size.value")
+				Expect(err).To(MatchError(`path spec.dbConfig.size.value: cannot access key "value" on string`))
+
+				_, err = resource.GetValue("spec.dbConfig[0]")
+				Expect(err).To(MatchError(ContainSubstring("cannot index map[string]interface {} with 0")))
+			})
+		})
+	})
+
+	Describe("GetValues", func() {
+		It("returns every matched value", func() {
+			Expect(resource.GetValues("spec.fields[].value")).To(Equal([]any{"one", "two"}))
+			Expect(resource.GetValues(".spec.fields[].name")).To(Equal([]any{"first", "second"}))
+			Expect(resource.GetValues(`.spec.fields[] | select(.name == "first") | .value`)).To(Equal([]any{"one"}))
+		})
+
+		It("returns the values of maps in the order of their keys", func() {
+			resource.obj.Object["spec"].(map[string]any)["replicas"] = map[string]any{
+				"c": int64(3), "a": int64(1), "d": nil, "b": int64(2), "e": int64(5),
+			}
+			for range 10 {
+				Expect(resource.GetValues("spec.replicas[]")).To(Equal([]any{int64(1), int64(2), int64(3), nil, int64(5)}))
+			}
+		})
+
+		It("returns a not found error when nothing matches", func() {
+			_, err := resource.GetValues("spec.missing[].value")
+			Expect(err).To(MatchError(ErrNotFound))
+		})
+	})
+
+	Describe("metadata accessors", func() {
+		It("return the metadata of the underlying object", func() {
+			Expect(resource.GetUID()).To(Equal(types.UID("1234-5678")))
+			Expect(resource.GetGeneration()).To(Equal(int64(3)))
+			Expect(resource.GetResourceVersion()).To(Equal("42"))
+			Expect(resource.GetCreationTimestamp().Time).To(BeTemporally("==", time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)))
+			Expect(resource.GetDeletionTimestamp()).To(BeNil())
+			Expect(resource.GetFinalizers()).To(ConsistOf("kratix.io/workflows-cleanup"))
+			Expect(resource.GetOwnerReferences()).To(ConsistOf(
+				HaveField("Name", "mykind"),
+			))
+		})
+	})
+
+	Describe("GetMetadata", func() {
+		It("returns a copy of the metadata without the managedFields", func() {
+			metadata := resource.GetMetadata()
+			Expect(metadata).To(HaveKeyWithValue("name", "my-resource"))
+			Expect(metadata).To(HaveKeyWithValue("uid", "1234-5678"))
+			Expect(metadata).ToNot(HaveKey("managedFields"))
+
+			metadata["name"] = "changed"
+			Expect(resource.GetName()).To(Equal("my-resource"))
+		})
+	})
+
+	Describe("ToOwnerReference", func() {
+		It("returns a controller reference to the resource", func() {
+			ref := resource.ToOwnerReference()
+			Expect(ref.APIVersion).To(Equal("mygroup.example/v1"))
+			Expect(ref.Kind).To(Equal("mykind"))
+			Expect(ref.Name).To(Equal("my-resource"))
+			Expect(ref.UID).To(Equal(types.UID("1234-5678")))
+			Expect(*ref.Controller).To(BeTrue())
+			Expect(*ref.BlockOwnerDeletion).To(BeTrue())
+		})
+	})
+
+	Describe("mutations", func() {
+		It("has no changes until the resource is modified", func() {
+			Expect(resource.Diff()).To(BeNil())
+		})
+
+		It("tracks the labels, annotations and values that changed", func() {
+			resource.SetLabel("kratix.io/allocated", "true")
+			resource.SetAnnotation("example.com/id", "1234")
+			Expect(resource.SetValue("spec.dbConfig.id", 42)).To(Succeed())
+			Expect(resource.SetValue("spec.fields[1].value", "updated")).To(Succeed())
+			Expect(resource.SetValue(`spec.extra.nested\.key`, []string{"a"})).To(Succeed())
+
+			Expect(resource.GetLabels()).To(HaveKeyWithValue("kratix.io/allocated", "true"))
+			Expect(resource.GetValue("spec.dbConfig.id")).To(Equal(int64(42)))
+
+			diff, err := resource.Diff()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diff).To(MatchJSON(`{
+				"metadata": {
+					"labels": {"kratix.io/allocated": "true"},
+					"annotations": {"example.com/id": "1234"}
+				},
+				"spec": {
+					"dbConfig": {"id": 42},
+					"fields": [
+						{"name": "first", "value": "one"},
+						{"name": "second", "value": "updated"}
+					],
+					"extra": {"nested.key": ["a"]}
+				}
+			}`))
+		})
+
+		It("has no changes when a value is set to what it already was", func() {
+			Expect(resource.SetValue("spec.dbConfig.size", "small")).To(Succeed())
+			Expect(resource.Diff()).To(BeNil())
+		})
+
+		It("rejects paths that cannot be set", func() {
+			Expect(resource.SetValue("status.phase", "Ready")).To(MatchError(ContainSubstring("use PublishStatus instead")))
+			Expect(resource.SetValue("spec.fields[].value", "x")).To(MatchError(ContainSubstring("only dotted paths with list indexes can be set")))
+			Expect(resource.SetValue(".spec | .x", "x")).To(MatchError(ContainSubstring("only dotted paths with list indexes can be set")))
+			Expect(resource.SetValue("spec.fields[5].value", "x")).To(MatchError("path spec.fields[5].value: index 5 out of range"))
+			Expect(resource.SetValue("spec.dbConfig.size.value", "x")).To(MatchError(ContainSubstring(`cannot access key "value" on string`)))
+		})
+
+		It("leaves the resource unchanged when setting a value fails", func() {
+			before := resource.ToUnstructured()
+			before = *before.DeepCopy()
+
+			Expect(resource.SetValue("spec.new[0]", "x")).To(MatchError(ContainSubstring("cannot index map")))
+			Expect(resource.SetValue("spec.other.list[3]", "x")).To(MatchError(ContainSubstring("cannot index map")))
+			Expect(resource.SetValue("spec.fields[5].value", "x")).To(HaveOccurred())
+
+			Expect(resource.Diff()).To(BeNil())
+			Expect(resource.ToUnstructured().Object).To(Equal(before.Object))
 		})
 	})
 
@@ -103,4 +289,37 @@ var _ = Describe("ResourceImpl", func() {
 			Expect(resource.ToUnstructured()).To(Equal(resource.obj))
 		})
 	})
+
+	Describe("Decode", func() {
+		type config struct {
+			metav1.ObjectMeta `json:"metadata,omitempty"`
+			Spec              struct {
+				DBConfig struct {
+					Size *string `json:"size,omitempty"`
+				} `json:"dbConfig"`
+				Fields []struct {
+					Name  string `json:"name"`
+					Value string `json:"value"`
+				} `json:"fields"`
+			} `json:"spec"`
+		}
+
+		It("converts the resource into a typed struct", func() {
+			out := &config{}
+			Expect(Decode(&resource, out)).To(Succeed())
+			Expect(out.Name).To(Equal("my-resource"))
+			Expect(*out.Spec.DBConfig.Size).To(Equal("small"))
+			Expect(out.Spec.Fields).To(HaveLen(2))
+			Expect(out.Spec.Fields[1].Value).To(Equal("two"))
+		})
+
+		It("errors when the resource does not match the struct", func() {
+			out := &struct {
+				Spec struct {
+					Fields string `json:"fields"`
+				} `json:"spec"`
+			}{}
+			Expect(Decode(&resource, out)).To(MatchError(ContainSubstring("decode my-resource")))
+		})
+	})
 })
@@ -0,0 +1,169 @@
+package kratix
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	openapierrors "k8s.io/kube-openapi/pkg/validation/errors"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+)
+
+// SchemaValidationError is returned when an object does not match the schema
+// of the Promise API.
+type SchemaValidationError struct {
+	Object string
+	Errors field.ErrorList
+}
+
+func (e *SchemaValidationError) Error() string {
+	return fmt.Sprintf("%s is invalid: %s", e.Object, e.Errors.ToAggregate())
+}
+
+// ValidateAgainstPromise validates the object against the OpenAPI schema of the
+// Promise API for the version of the object. It returns a
+// *SchemaValidationError listing the fields that do not match.
+func ValidateAgainstPromise(promise Promise, obj *unstructured.Unstructured) error {
+	crd, err := promise.GetCRD()
+	if err != nil {
+		return err
+	}
+	gvk := obj.GroupVersionKind()
+	if gvk.Group != crd.Spec.Group || gvk.Kind != crd.Spec.Names.Kind {
+		return fmt.Errorf("%s is not a %s.%s", gvk, crd.Spec.Names.Kind, crd.Spec.Group)
+	}
+	schema, err := promise.GetAPISchema(gvk.Version)
+	if err != nil {
+		return err
+	}
+
+	errs, err := validateAgainstSchema(schema, obj.Object)
+	if err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return &SchemaValidationError{Object: objectRef(obj), Errors: errs}
+	}
+	return nil
+}
+
+// validateAgainstSchema validates the object against the schema the way the
+// API server validates custom resources, leaving out the CEL validation rules.
+func validateAgainstSchema(schema *apiextensionsv1.JSONSchemaProps, obj map[string]any) (field.ErrorList, error) {
+	openAPISchema, err := toOpenAPISchema(schema)
+	if err != nil {
+		return nil, fmt.Errorf("convert schema: %w", err)
+	}
+	result := validate.NewSchemaValidator(openAPISchema, nil, "", strfmt.Default).Validate(obj)
+	return toFieldErrors(result), nil
+}
+
+// toOpenAPISchema converts the CRD schema to the schema of the OpenAPI
+// validator, typing int-or-string fields and dropping the formats the API
+// server does not validate.
+func toOpenAPISchema(schema *apiextensionsv1.JSONSchemaProps) (*spec.Schema, error) {
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	openAPISchema := &spec.Schema{}
+	if err := json.Unmarshal(data, openAPISchema); err != nil {
+		return nil, err
+	}
+	walkSchema(openAPISchema, func(s *spec.Schema) {
+		if intOrString, _ := s.Extensions.GetBool("x-kubernetes-int-or-string"); intOrString {
+			s.Type = spec.StringOrArray{"integer", "string"}
+		}
+		if !supportedFormats.Has(strings.ReplaceAll(s.Format, "-", "")) {
+			s.Format = ""
+		}
+	})
+	return openAPISchema, nil
+}
+
+// supportedFormats are the string formats validated by the API server.
+var supportedFormats = sets.New(
+	"bsonobjectid", "uri", "email", "hostname", "ipv4", "ipv6", "cidr", "mac",
+	"uuid", "uuid3", "uuid4", "uuid5", "isbn", "isbn10", "isbn13", "creditcard",
+	"ssn", "hexcolor", "rgbcolor", "byte", "password", "date", "duration", "datetime",
+)
+
+// walkSchema calls fn on the schema and every schema nested in it.
+func walkSchema(s *spec.Schema, fn func(*spec.Schema)) {
+	if s == nil {
+		return
+	}
+	fn(s)
+	for _, schemas := range [][]spec.Schema{s.AllOf, s.AnyOf, s.OneOf} {
+		for i := range schemas {
+			walkSchema(&schemas[i], fn)
+		}
+	}
+	for _, schemas := range []map[string]spec.Schema{s.Properties, s.PatternProperties, s.Definitions} {
+		for key, nested := range schemas {
+			walkSchema(&nested, fn)
+			schemas[key] = nested
+		}
+	}
+	walkSchema(s.Not, fn)
+	if s.AdditionalProperties != nil {
+		walkSchema(s.AdditionalProperties.Schema, fn)
+	}
+	if s.AdditionalItems != nil {
+		walkSchema(s.AdditionalItems.Schema, fn)
+	}
+	if s.Items != nil {
+		walkSchema(s.Items.Schema, fn)
+		for i := range s.Items.Schemas {
+			walkSchema(&s.Items.Schemas[i], fn)
+		}
+	}
+}
+
+// toFieldErrors converts the errors of the OpenAPI validator to field errors,
+// like the API server does.
+func toFieldErrors(result *validate.Result) field.ErrorList {
+	var errs field.ErrorList
+	for _, err := range result.Errors {
+		validationErr, ok := err.(*openapierrors.Validation)
+		if !ok {
+			errs = append(errs, field.Invalid(nil, "", err.Error()))
+			continue
+		}
+		var path *field.Path
+		if name := strings.TrimPrefix(validationErr.Name, "."); name != "" {
+			path = field.NewPath(name)
+		}
+		value := validationErr.Value
+		if value == nil {
+			value = ""
+		}
+
+		switch validationErr.Code() {
+		case openapierrors.RequiredFailCode:
+			errs = append(errs, field.Required(path, ""))
+		case openapierrors.EnumFailCode:
+			var values []string
+			for _, allowed := range validationErr.Values {
+				if s, ok := allowed.(string); ok {
+					values = append(values, s)
+				} else {
+					allowedJSON, _ := json.Marshal(allowed)
+					values = append(values, string(allowedJSON))
+				}
+			}
+			errs = append(errs, field.NotSupported(path, validationErr.Value, values))
+		case openapierrors.InvalidTypeCode:
+			errs = append(errs, field.TypeInvalid(path, value, validationErr.Error()))
+		default:
+			errs = append(errs, field.Invalid(path, value, validationErr.Error()))
+		}
+	}
+	return errs
+}
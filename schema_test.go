@@ -0,0 +1,142 @@
+package kratix
+
+import (
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/syntasso/kratix/api/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// postgresPromise returns a Promise with a namespaced Postgres API whose
+// storage version requires spec.size to be small or large.
+func postgresPromise() *PromiseImpl {
+	promise := &v1alpha1.Promise{}
+	promise.SetGroupVersionKind(promiseGVK)
+	promise.SetName("postgres")
+	promise.Spec.API = &runtime.RawExtension{Raw: []byte(`{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind": "CustomResourceDefinition",
+		"metadata": {"name": "postgresqls.marketplace.kratix.io"},
+		"spec": {
+			"group": "marketplace.kratix.io",
+			"names": {"kind": "postgresql", "plural": "postgresqls", "singular": "postgresql"},
+			"scope": "Namespaced",
+			"versions": [{
+				"name": "v1alpha1", "served": true, "storage": true,
+				"schema": {"openAPIV3Schema": {
+					"type": "object",
+					"properties": {"spec": {
+						"type": "object",
+						"required": ["size"],
+						"properties": {
+							"size": {"type": "string", "enum": ["small", "large"]},
+							"replicas": {"type": "integer", "minimum": 1}
+						}
+					}}
+				}}
+			}]
+		}
+	}`)}
+	obj, err := promise.ToUnstructured()
+	Expect(err).ToNot(HaveOccurred())
+	return &PromiseImpl{ResourceImpl: ResourceImpl{obj: *obj}, promise: promise}
+}
+
+var _ = Describe("ValidateAgainstPromise", func() {
+	var (
+		promise *PromiseImpl
+		request *unstructured.Unstructured
+	)
+
+	BeforeEach(func() {
+		promise = postgresPromise()
+		request = &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "marketplace.kratix.io/v1alpha1",
+			"kind":       "postgresql",
+			"metadata":   map[string]any{"name": "db"},
+			"spec":       map[string]any{"size": "small", "replicas": int64(2)},
+		}}
+	})
+
+	It("accepts objects matching the schema", func() {
+		Expect(ValidateAgainstPromise(promise, request)).To(Succeed())
+	})
+
+	It("returns the fields that do not match the schema", func() {
+		request.Object["spec"] = map[string]any{"size": "medium", "replicas": int64(0)}
+
+		err := ValidateAgainstPromise(promise, request)
+		var validationErr *SchemaValidationError
+		Expect(errors.As(err, &validationErr)).To(BeTrue())
+		Expect(validationErr.Object).To(Equal("postgresql db"))
+		Expect(validationErr.Errors).To(HaveLen(2))
+		Expect(err).To(MatchError(SatisfyAll(
+			ContainSubstring("postgresql db is invalid"),
+			ContainSubstring(`spec.size: Unsupported value: "medium"`),
+			ContainSubstring("spec.replicas: Invalid value: 0"),
+		)))
+	})
+
+	It("rejects objects of another kind or version", func() {
+		request.SetKind("redis")
+		Expect(ValidateAgainstPromise(promise, request)).To(MatchError(ContainSubstring("is not a postgresql.marketplace.kratix.io")))
+
+		request.SetKind("postgresql")
+		request.SetAPIVersion("marketplace.kratix.io/v2")
+		Expect(ValidateAgainstPromise(promise, request)).To(MatchError("promise api has no version v2"))
+	})
+})
+
+var _ = Describe("validateAgainstSchema", func() {
+	var schema *apiextensionsv1.JSONSchemaProps
+
+	BeforeEach(func() {
+		schema = &apiextensionsv1.JSONSchemaProps{}
+		Expect(json.Unmarshal([]byte(`{
+			"type": "object",
+			"required": ["port"],
+			"properties": {
+				"port": {"x-kubernetes-int-or-string": true},
+				"email": {"type": "string", "format": "email"},
+				"custom": {"type": "string", "format": "not-a-format"},
+				"owner": {"type": "string", "nullable": true},
+				"nested": {"type": "array", "items": {"type": "object", "properties": {"port": {"x-kubernetes-int-or-string": true}}}}
+			}
+		}`), schema)).To(Succeed())
+	})
+
+	It("validates int-or-string fields, nullable fields and the formats the API server supports", func() {
+		errs, err := validateAgainstSchema(schema, map[string]any{
+			"port":   "http",
+			"custom": "anything",
+			"owner":  nil,
+			"nested": []any{map[string]any{"port": int64(80)}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(errs).To(BeEmpty())
+
+		errs, err = validateAgainstSchema(schema, map[string]any{
+			"port":   true,
+			"email":  "not an email",
+			"nested": []any{map[string]any{"port": 1.5}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(errs.ToAggregate()).To(MatchError(SatisfyAll(
+			ContainSubstring("port: Invalid value: \"boolean\""),
+			ContainSubstring("email: Invalid value: \"not an email\""),
+			ContainSubstring("nested[0].port: Invalid value"),
+		)))
+	})
+
+	It("reports missing required fields", func() {
+		errs, err := validateAgainstSchema(schema, map[string]any{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(errs.ToAggregate()).To(MatchError("port: Required value"))
+	})
+})
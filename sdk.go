@@ -3,37 +3,116 @@ package kratix
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
+	"maps"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/syntasso/kratix-go/internal/objutil"
 	"github.com/syntasso/kratix/api/v1alpha1"
-	"github.com/syntasso/kratix/work-creator/lib/helpers"
-	"k8s.io/apimachinery/pkg/runtime/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
 	"sigs.k8s.io/yaml"
 )
 
-// The SDK interface implements the Kratix SDK core library function
+// The SDK interface implements the Kratix SDK core library function. It is
+// composed of role interfaces, so code can depend on only the part of the SDK
+// it uses.
+//
+//go:generate go tool counterfeiter . SDKInvoker
 type SDKInvoker interface {
+	InputReader
+	OutputWriter
+	StatusPublisher
+	PlatformClient
+	WorkflowEnvironment
+}
+
+// InputReader reads the inputs of the workflow from /kratix/input and
+// /kratix/metadata.
+//
+//go:generate go tool counterfeiter . InputReader
+type InputReader interface {
 	// ReadResourceInput reads the file in /kratix/input/object.yaml and returns a Resource
 	ReadResourceInput() (Resource, error)
 	// ReadPromiseInput reads the file in /kratix/input/object.yaml and returns a Resource
 	ReadPromiseInput() (Promise, error)
 	// ReadDestinationSelectors
 	ReadDestinationSelectors() ([]DestinationSelector, error)
+	// ReadEffectiveDestinationSelectors combines the Promise destination selectors with the ones in /kratix/metadata/destination-selectors.yaml
+	ReadEffectiveDestinationSelectors(promise Promise) ([]DestinationSelector, error)
 	// ReadStatus reads the /kratix/metadata/status.yaml
 	ReadStatus() (Status, error)
+}
+
+// OutputWriter writes the outputs of the workflow to /kratix/output and
+// /kratix/metadata.
+//
+//go:generate go tool counterfeiter . OutputWriter
+type OutputWriter interface {
 	// WriteOutput writes the content to the specifies file at the path /kratix/output/filepath
 	WriteOutput(filepath string, content []byte) error
+	// WriteObjects writes the objects, modified by the transformers, to the specified file at the path /kratix/output/filepath
+	WriteObjects(filepath string, objects []*unstructured.Unstructured, transformers ...ObjectTransformer) error
+	// WriteDependencies writes the dependencies of the Promise to the specified file at the path /kratix/output/filepath
+	WriteDependencies(promise Promise, filepath string, transformers ...ObjectTransformer) error
+	// WriteSubResourceRequests writes the requests to /kratix/output/platform and schedules them to the platform cluster
+	WriteSubResourceRequests(requests ...*unstructured.Unstructured) error
 	// WriteStatus writes the specified status to the /kratix/metadata/status.yaml
 	WriteStatus(status Status) error
 	// WriteDestinationSelectors writes the specified Destination Selectors to the /kratix/metadata/destination_selectors.yaml
 	WriteDestinationSelectors(selectors []DestinationSelector) error
+}
+
+// StatusPublisher sends the status and changes of resources to the platform
+// cluster.
+//
+//go:generate go tool counterfeiter . StatusPublisher
+type StatusPublisher interface {
+	// PublishStatus updates the status of the provided resource with the provided status
+	PublishStatus(resource Resource, status Status) error
+	// PublishResource patches the provided resource with the changes made to it
+	PublishResource(resource Resource) error
+	// IsStale returns true if the live resource has a newer generation than the provided resource
+	IsStale(resource Resource) (bool, error)
+}
+
+// PlatformClient reads Promises and the resources requested from them from
+// the platform cluster.
+//
+//go:generate go tool counterfeiter . PlatformClient
+type PlatformClient interface {
+	// FetchPromise returns the Promise named by the KRATIX_PROMISE_NAME environment variable from the platform cluster
+	FetchPromise() (Promise, error)
+	// CheckRequiredPromises checks the required Promises of the Promise are installed and available
+	CheckRequiredPromises(promise Promise) (RequiredPromisesResult, error)
+	// BuildSubResourceRequest builds a validated request for a resource of the named Promise, labelled with the parent Resource
+	BuildSubResourceRequest(parent Resource, promiseName, name string, spec map[string]any) (*unstructured.Unstructured, error)
+	// ChildSelector returns the label selector matching the sub-resources requested by the parent Resource
+	ChildSelector(parent Resource) labels.Selector
+	// AggregateChildStatus summarises the readiness of the resources of the named Promises requested by the parent Resource
+	AggregateChildStatus(parent Resource, promiseNames ...string) (ChildrenStatus, error)
+}
+
+// WorkflowEnvironment describes the running workflow from the environment
+// variables Kratix sets.
+//
+//go:generate go tool counterfeiter . WorkflowEnvironment
+type WorkflowEnvironment interface {
 	// WorkflowAction returns the value of KRATIX_WORKFLOW_ACTION environment variable
 	WorkflowAction() string
 	// WorkflowType returns the value of KRATIX_WORKFLOW_TYPE environment variable
@@ -42,9 +121,8 @@ type SDKInvoker interface {
 	PromiseName() string
 	// PipelineName returns the value of the KRATIX_PIPELINE_NAME environment variable
 	PipelineName() string
-	// PublishStatus updates the status of the provided resource with the provided status
-	PublishStatus(resource Resource, status Status) error
-
+	// CurrentPipeline returns the definition of the running pipeline from the provided Promise
+	CurrentPipeline(promise Promise) (*v1alpha1.Pipeline, error)
 	// IsPromiseWorkflow returns true if the workflow is a promise workflow
 	IsPromiseWorkflow() bool
 	// IsResourceWorkflow returns true if the workflow is a resource workflow
@@ -72,10 +150,21 @@ type KratixSDK struct {
 
 	inputObject  string
 	objectClient ResourceInterface
+
+	promiseFile     string
+	promiseFileName string
+	promises        map[string]Promise
+
+	clientConfig  clientConfig
+	restConfig    *rest.Config
+	dynamicClient dynamic.Interface
+	restMapper    meta.RESTMapper
 }
 
 //go:generate go tool counterfeiter . ResourceInterface
 type ResourceInterface interface {
+	Get(ctx context.Context, name string, options metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, options metav1.PatchOptions, subresources ...string) (*unstructured.Unstructured, error)
 }
 
@@ -101,11 +190,21 @@ func WithOutputDir(p string) Option {
 	return func(k *KratixSDK) { k.outputDir = p }
 }
 
-// WithObjectClient overrides the Kubernetes client for testing
+// WithObjectClient overrides the Kubernetes client for testing. The client is
+// used for every kind the SDK reads or writes: the input object, but also the
+// Promises fetched by FetchPromise, CheckRequiredPromises and
+// BuildSubResourceRequest, and the resources listed by AggregateChildStatus.
+// Use WithDynamicClient to serve each kind from its own resource.
 func WithObjectClient(client ResourceInterface) Option {
 	return func(k *KratixSDK) { k.objectClient = client }
 }
 
+// WithPromiseFile makes FetchPromise read the Promise from the file at the
+// provided path instead of the platform cluster, e.g. in tests.
+func WithPromiseFile(p string) Option {
+	return func(k *KratixSDK) { k.promiseFile = p }
+}
+
 // New creates a KratixSDK with optional configuration overrides.
 func New(opts ...Option) *KratixSDK {
 	sdk := &KratixSDK{
@@ -126,11 +225,11 @@ func (k *KratixSDK) ReadResourceInput() (Resource, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read object input: %w", err)
 	}
-	r := &ResourceImpl{}
-	if err := yaml.Unmarshal(data, &r.obj.Object); err != nil {
+	obj, err := objutil.UnmarshalObject(data)
+	if err != nil {
 		return nil, fmt.Errorf("unmarshal object: %w", err)
 	}
-	return r, nil
+	return &ResourceImpl{obj: unstructured.Unstructured{Object: obj}}, nil
 }
 
 // ReadPromiseInput reads the Input Promise YAML and returns it as a Promise.
@@ -139,6 +238,12 @@ func (k *KratixSDK) ReadPromiseInput() (Promise, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read promise input: %w", err)
 	}
+	return UnmarshalPromise(data)
+}
+
+// UnmarshalPromise decodes the YAML or JSON of a Promise, e.g. read from a
+// Promise file.
+func UnmarshalPromise(data []byte) (Promise, error) {
 	p := &v1alpha1.Promise{}
 	if err := yaml.Unmarshal(data, &p); err != nil {
 		return nil, fmt.Errorf("unmarshal promise: %w", err)
@@ -150,6 +255,79 @@ func (k *KratixSDK) ReadPromiseInput() (Promise, error) {
 	return &PromiseImpl{ResourceImpl: ResourceImpl{obj: *obj}, promise: p}, nil
 }
 
+// ReadPromiseFile reads and decodes the YAML or JSON Promise file.
+func ReadPromiseFile(path string) (Promise, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read promise file: %w", err)
+	}
+	return UnmarshalPromise(data)
+}
+
+// FetchPromise returns the Promise named by the KRATIX_PROMISE_NAME environment
+// variable, giving resource workflows access to Promise-level data such as its
+// API, labels and annotations. The Promise is fetched from the platform
+// cluster, or read from the file set with WithPromiseFile, once and then cached
+// with the other Promises the SDK fetches by name.
+func (k *KratixSDK) FetchPromise() (Promise, error) {
+	if k.promiseFile == "" {
+		name := k.PromiseName()
+		if name == "" {
+			return nil, errors.New("fetch promise: KRATIX_PROMISE_NAME is not set")
+		}
+		return k.fetchPromiseNamed(name)
+	}
+
+	if promise, ok := k.promises[k.promiseFileName]; ok {
+		return promise, nil
+	}
+	promise, err := ReadPromiseFile(k.promiseFile)
+	if err != nil {
+		return nil, err
+	}
+	k.promiseFileName = promise.GetName()
+	k.cachePromise(promise)
+	return promise, nil
+}
+
+// fetchPromiseNamed returns the named Promise from the platform cluster,
+// caching it for later calls.
+func (k *KratixSDK) fetchPromiseNamed(name string) (Promise, error) {
+	if promise, ok := k.promises[name]; ok {
+		return promise, nil
+	}
+	promise, err := k.getPromise(name)
+	if err != nil {
+		return nil, err
+	}
+	k.cachePromise(promise)
+	return promise, nil
+}
+
+func (k *KratixSDK) cachePromise(promise Promise) {
+	if k.promises == nil {
+		k.promises = map[string]Promise{}
+	}
+	k.promises[promise.GetName()] = promise
+}
+
+func (k *KratixSDK) getPromise(name string) (Promise, error) {
+	objectClient, err := k.clientFor(promiseGVK, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := objectClient.Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("fetch promise %s: %w", name, err)
+	}
+
+	p := &v1alpha1.Promise{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, p); err != nil {
+		return nil, fmt.Errorf("convert promise %s: %w", name, err)
+	}
+	return &PromiseImpl{ResourceImpl: ResourceImpl{obj: *obj}, promise: p}, nil
+}
+
 // ReadDestinationSelectors reads destination selectors from file.
 func (k *KratixSDK) ReadDestinationSelectors() ([]DestinationSelector, error) {
 	data, err := os.ReadFile(filepath.Join(k.metadataDir, destinationSelectorsFile))
@@ -163,21 +341,53 @@ func (k *KratixSDK) ReadDestinationSelectors() ([]DestinationSelector, error) {
 	return selectors, nil
 }
 
+// ReadEffectiveDestinationSelectors returns the destination selectors Kratix
+// will use to schedule the workflow output, combining the Promise selectors with
+// the workflow selectors. A missing destination selectors file is treated as
+// the workflow declaring no selectors.
+func (k *KratixSDK) ReadEffectiveDestinationSelectors(promise Promise) ([]DestinationSelector, error) {
+	selectors, err := k.ReadDestinationSelectors()
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	var promiseSelectors []v1alpha1.PromiseScheduling
+	if p := promise.GetPromise(); p != nil {
+		promiseSelectors = p.Spec.DestinationSelectors
+	}
+	return ResolveDestinationSelectors(promiseSelectors, selectors)
+}
+
 // ReadStatus reads the status.yaml from the output directory.
 func (k *KratixSDK) ReadStatus() (Status, error) {
 	data, err := os.ReadFile(filepath.Join(k.metadataDir, statusFile))
 	if err != nil {
 		return nil, fmt.Errorf("read status: %w", err)
 	}
-	var m map[string]any
-	if err := yaml.Unmarshal(data, &m); err != nil {
+	m, err := objutil.UnmarshalObject(data)
+	if err != nil {
 		return nil, fmt.Errorf("unmarshal status: %w", err)
 	}
 	return &StatusImpl{data: m}, nil
 }
 
+// PathEscapeError is returned when a file the SDK is asked to write resolves
+// outside the directory it is written to, e.g. WriteOutput("../input/object.yaml").
+type PathEscapeError struct {
+	// Path is the file that was not written.
+	Path string
+	// Dir is the directory the file had to be in.
+	Dir string
+}
+
+func (e *PathEscapeError) Error() string {
+	return fmt.Sprintf("%s resolves outside %s", e.Path, e.Dir)
+}
+
 func (k *KratixSDK) write(dir, relPath string, content []byte) error {
 	full := filepath.Join(dir, relPath)
+	if err := checkWithin(dir, full); err != nil {
+		return err
+	}
 	if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
 		return fmt.Errorf("create output dir: %w", err)
 	}
@@ -187,11 +397,81 @@ func (k *KratixSDK) write(dir, relPath string, content []byte) error {
 	return nil
 }
 
+// checkWithin returns a PathEscapeError when the path, or the target of the
+// symlinks along it, is outside the directory.
+func checkWithin(dir, path string) error {
+	if !isWithin(dir, path) {
+		return &PathEscapeError{Path: path, Dir: dir}
+	}
+	resolvedDir, err := resolveSymlinks(dir)
+	if err != nil {
+		return fmt.Errorf("resolve %s: %w", dir, err)
+	}
+	resolvedPath, err := resolveSymlinks(path)
+	if err != nil {
+		return fmt.Errorf("resolve %s: %w", path, err)
+	}
+	if !isWithin(resolvedDir, resolvedPath) {
+		return &PathEscapeError{Path: path, Dir: dir}
+	}
+	return nil
+}
+
+func isWithin(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// resolveSymlinks evaluates the symlinks of the longest existing prefix of the
+// path, as the rest of it is yet to be created.
+func resolveSymlinks(path string) (string, error) {
+	existing, rest := path, ""
+	for {
+		resolved, err := filepath.EvalSymlinks(existing)
+		if err == nil {
+			return filepath.Join(resolved, rest), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+		if target, err := os.Readlink(existing); err == nil {
+			// a dangling symlink, writing through it creates its target
+			if !filepath.IsAbs(target) {
+				target = filepath.Join(filepath.Dir(existing), target)
+			}
+			return resolveSymlinks(filepath.Join(target, rest))
+		}
+		parent := filepath.Dir(existing)
+		if parent == existing {
+			return path, nil
+		}
+		rest = filepath.Join(filepath.Base(existing), rest)
+		existing = parent
+	}
+}
+
 // WriteOutput writes content to the named file under the output directory.
 func (k *KratixSDK) WriteOutput(relPath string, content []byte) error {
 	return k.write(k.outputDir, relPath, content)
 }
 
+// WriteObjects writes the objects as a multi-document YAML to the named file
+// under the output directory. The transformers are applied, in order, to
+// copies of the objects.
+func (k *KratixSDK) WriteObjects(relPath string, objs []*unstructured.Unstructured, transformers ...ObjectTransformer) error {
+	data, err := marshalObjects(objs, transformers)
+	if err != nil {
+		return err
+	}
+	return k.WriteOutput(relPath, data)
+}
+
+// WriteDependencies writes the dependencies of the Promise to the named file
+// under the output directory, applying the transformers like WriteObjects.
+func (k *KratixSDK) WriteDependencies(promise Promise, relPath string, transformers ...ObjectTransformer) error {
+	return k.WriteObjects(relPath, promise.GetDependencies(), transformers...)
+}
+
 // WriteStatus writes the provided Status to status.yaml.
 func (k *KratixSDK) WriteStatus(s Status) error {
 	sts, ok := s.(*StatusImpl)
@@ -234,47 +514,147 @@ func (k *KratixSDK) PipelineName() string {
 	return os.Getenv("KRATIX_PIPELINE_NAME")
 }
 
+// CurrentPipeline returns the definition of the running pipeline from the
+// Promise, found by the workflow type, action and pipeline name Kratix sets.
+func (k *KratixSDK) CurrentPipeline(promise Promise) (*v1alpha1.Pipeline, error) {
+	return promise.GetPipeline(k.WorkflowType(), k.WorkflowAction(), k.PipelineName())
+}
+
 func (k *KratixSDK) getObjectClient(res Resource) (ResourceInterface, error) {
+	return k.clientFor(res.GetGroupVersionKind(), res.GetNamespace(), nil)
+}
+
+// clientFor returns a client for the objects of the kind in the namespace. The
+// namespace is ignored for cluster-scoped kinds. The kind is resolved with the
+// mapper when one is provided, e.g. for a Promise API, and with
+// resourceMapping otherwise.
+func (k *KratixSDK) clientFor(gvk schema.GroupVersionKind, namespace string, mapper meta.RESTMapper) (ResourceInterface, error) {
 	if k.objectClient != nil {
 		return k.objectClient, nil
 	}
 
-	gvr := schema.GroupVersionResource{
-		Group:    res.GetGroupVersionKind().Group,
-		Version:  res.GetGroupVersionKind().Version,
-		Resource: os.Getenv("KRATIX_CRD_PLURAL"),
-	}
-	client, err := helpers.GetK8sClient()
+	client, err := k.getDynamicClient()
 	if err != nil {
 		return nil, err
 	}
-	return client.Resource(gvr).Namespace(res.GetNamespace()), nil
+	var mapping *meta.RESTMapping
+	if mapper != nil {
+		if mapping, err = mapper.RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+			return nil, fmt.Errorf("resolve resource for %s: %w", gvk, err)
+		}
+	} else if mapping, err = k.resourceMapping(gvk); err != nil {
+		return nil, err
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		return client.Resource(mapping.Resource), nil
+	}
+	return client.Resource(mapping.Resource).Namespace(namespace), nil
 }
 
 // PublishStatus takes a Resource and a Status, and then implements the logic to
 // merge the status into the resource and persist it via the Kubernetes API.
+// Conditions are merged by type with the conditions on the live Resource, so
+// publishing a condition keeps the ones Kratix manages, and the patch is
+// retried if the Resource changes in between. Promises are published to the
+// cluster-scoped promises resource.
 func (k *KratixSDK) PublishStatus(res Resource, incomingStatus Status) error {
 	objectClient, err := k.getObjectClient(res)
 	if err != nil {
 		return err
 	}
 
-	patchData := map[string]any{
-		"status": incomingStatus.ToMap(),
+	statusData := incomingStatus.ToMap()
+	incoming, hasConditions := statusData["conditions"].([]any)
+	if !hasConditions {
+		return patchStatus(objectClient, res.GetName(), map[string]any{"status": statusData})
 	}
 
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		live, err := objectClient.Get(context.Background(), res.GetName(), metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to read current status: %w", err)
+		}
+
+		// without a live Resource there are no conditions to keep
+		var existing []any
+		patchData := map[string]any{}
+		if err == nil && live != nil {
+			existing, _, _ = unstructured.NestedSlice(live.Object, "status", "conditions")
+			// the resourceVersion fails the patch if the conditions changed since
+			if resourceVersion := live.GetResourceVersion(); resourceVersion != "" {
+				patchData["metadata"] = map[string]any{"resourceVersion": resourceVersion}
+			}
+		}
+		merged := maps.Clone(statusData)
+		merged["conditions"] = mergeConditions(existing, incoming)
+		patchData["status"] = merged
+		return patchStatus(objectClient, res.GetName(), patchData)
+	})
+}
+
+func patchStatus(objectClient ResourceInterface, name string, patchData map[string]any) error {
 	patchBytes, err := json.Marshal(patchData)
 	if err != nil {
 		return fmt.Errorf("failed to marshal status patch: %w", err)
 	}
 
-	if _, err = objectClient.Patch(context.Background(), res.GetName(), types.MergePatchType, patchBytes, metav1.PatchOptions{}, "status"); err != nil {
+	if _, err = objectClient.Patch(context.Background(), name, types.MergePatchType, patchBytes, metav1.PatchOptions{}, "status"); err != nil {
 		return fmt.Errorf("failed to patch status: %w", err)
 	}
+	return nil
+}
+
+// PublishResource sends the changes made to the Resource with SetLabel,
+// SetAnnotation and SetValue to the Kubernetes API as a merge patch. It does
+// nothing when the Resource has not changed.
+func (k *KratixSDK) PublishResource(res Resource) error {
+	patchBytes, err := res.Diff()
+	if err != nil {
+		return fmt.Errorf("failed to compute resource changes: %w", err)
+	}
+	if patchBytes == nil {
+		return nil
+	}
+
+	objectClient, err := k.getObjectClient(res)
+	if err != nil {
+		return err
+	}
+	if _, err = objectClient.Patch(context.Background(), res.GetName(), types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to patch resource: %w", err)
+	}
 
+	if tracker, ok := res.(interface{ resetChanges() }); ok {
+		tracker.resetChanges()
+	}
 	return nil
 }
 
+// IsStale fetches the live Resource from the Kubernetes API and returns true if
+// it has moved on to a newer generation than the provided Resource, meaning the
+// spec changed while the workflow was running and Kratix will run it again.
+// Long-running workflows can use it to stop before publishing a stale status.
+// Resources without a generation are never stale, and neither are Resources
+// the client returns no live object for.
+func (k *KratixSDK) IsStale(res Resource) (bool, error) {
+	if res.GetGeneration() == 0 {
+		return false, nil
+	}
+
+	objectClient, err := k.getObjectClient(res)
+	if err != nil {
+		return false, err
+	}
+	live, err := objectClient.Get(context.Background(), res.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to get resource: %w", err)
+	}
+	if live == nil {
+		return false, nil
+	}
+	return live.GetGeneration() > res.GetGeneration(), nil
+}
+
 // IsPromiseWorkflow returns true if the workflow is a promise workflow
 func (k *KratixSDK) IsPromiseWorkflow() bool {
 	return k.WorkflowType() == "promise"
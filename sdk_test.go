@@ -1,7 +1,9 @@
 package kratix_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
 
@@ -9,7 +11,14 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/syntasso/kratix-go"
 	kratixgofakes "github.com/syntasso/kratix-go/kratix-gofakes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
 )
 
 var _ = Describe("E2E Tests", func() {
@@ -93,7 +102,7 @@ var _ = Describe("E2E Tests", func() {
 							expected any
 						}{
 							{path: "metadata.name", expected: "my-resource"},
-							{path: "spec.replicas", expected: float64(3)},
+							{path: "spec.replicas", expected: int64(3)},
 							{path: "spec.dbConfig.size", expected: "large"},
 							{path: "spec.dbConfig.type", expected: "postgres"},
 						}
@@ -190,6 +199,24 @@ var _ = Describe("E2E Tests", func() {
 					HaveKeyWithValue("message", "hello from publish"),
 				))
 			})
+
+			By("publishing the resource", func() {
+				resource, err := sdk.ReadResourceInput()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(sdk.PublishResource(resource)).To(Succeed())
+				Expect(mockObjectClient.PatchCallCount()).To(Equal(1))
+
+				resource.SetLabel("allocated", "true")
+				Expect(sdk.PublishResource(resource)).To(Succeed())
+				Expect(mockObjectClient.PatchCallCount()).To(Equal(2))
+
+				_, resourceName, patchType, patchBytes, _, subresources := mockObjectClient.PatchArgsForCall(1)
+				Expect(resourceName).To(Equal("my-resource"))
+				Expect(patchType).To(Equal(types.MergePatchType))
+				Expect(patchBytes).To(MatchJSON(`{"metadata": {"labels": {"allocated": "true"}}}`))
+				Expect(subresources).To(BeEmpty())
+			})
 		})
 	})
 
@@ -232,7 +259,7 @@ var _ = Describe("E2E Tests", func() {
 			By("accessing promise status", func() {
 				status, err := promise.GetStatus()
 				Expect(err).ToNot(HaveOccurred())
-				Expect(status.Get("workflowsSucceeded")).To(Equal(1))
+				Expect(status.Get("workflowsSucceeded")).To(Equal(int64(1)))
 			})
 
 			By("accessing the promise object", func() {
@@ -241,6 +268,571 @@ var _ = Describe("E2E Tests", func() {
 				Expect(kratixPromise.Spec.Workflows.Resource.Configure).To(HaveLen(1))
 			})
 		})
+
+		It("can write the promise dependencies", func() {
+			promise, err := sdk.ReadPromiseInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sdk.WriteDependencies(promise, "dependencies/deps.yaml", kratix.DefaultNamespace("kratix-platform-system"))).To(Succeed())
+			Expect(readFileContent(outputDir, "dependencies/deps.yaml")).To(MatchYAML(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: redis-defaults
+  namespace: kratix-platform-system
+data:
+  size: small
+`))
+		})
+
+		It("can find the definition of the running pipeline", func() {
+			promise, err := sdk.ReadPromiseInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			os.Setenv("KRATIX_WORKFLOW_TYPE", "promise")
+			os.Setenv("KRATIX_WORKFLOW_ACTION", "configure")
+			os.Setenv("KRATIX_PIPELINE_NAME", "promise-configure")
+			DeferCleanup(func() {
+				os.Unsetenv("KRATIX_WORKFLOW_TYPE")
+				os.Unsetenv("KRATIX_WORKFLOW_ACTION")
+				os.Unsetenv("KRATIX_PIPELINE_NAME")
+			})
+
+			pipeline, err := sdk.CurrentPipeline(promise)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pipeline.GetName()).To(Equal("promise-configure"))
+			Expect(pipeline.Spec.Containers[0].Name).To(Equal("redis-configure-pipeline"))
+		})
+
+		It("can resolve the effective destination selectors", func() {
+			promise, err := sdk.ReadPromiseInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sdk.WriteDestinationSelectors([]kratix.DestinationSelector{
+				{MatchLabels: map[string]string{"environment": "prod", "team": "a"}},
+				{Directory: "db", MatchLabels: map[string]string{"team": "data"}},
+			})).To(Succeed())
+
+			selectors, err := sdk.ReadEffectiveDestinationSelectors(promise)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selectors).To(Equal([]kratix.DestinationSelector{
+				{Directory: ".", MatchLabels: map[string]string{"environment": "dev", "team": "a"}},
+				{Directory: "db", MatchLabels: map[string]string{"team": "data"}},
+			}))
+		})
+
+		It("uses only the promise selectors when the workflow wrote none", func() {
+			promise, err := sdk.ReadPromiseInput()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Remove(filepath.Join(metadataDir, "destination-selectors.yaml"))).To(Succeed())
+
+			selectors, err := sdk.ReadEffectiveDestinationSelectors(promise)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selectors).To(Equal([]kratix.DestinationSelector{
+				{Directory: ".", MatchLabels: map[string]string{"environment": "dev"}},
+			}))
+		})
+	})
+
+	Describe("Fetching the promise in a resource workflow", func() {
+		BeforeEach(func() {
+			os.Unsetenv("KRATIX_PROMISE_NAME")
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("KRATIX_PROMISE_NAME")
+		})
+
+		It("reads the promise from the file override and caches it", func() {
+			promiseFile := filepath.Join(GinkgoT().TempDir(), "promise.yaml")
+			Expect(os.WriteFile(promiseFile, readFileContent("assets/input", "promise.yaml"), 0o644)).To(Succeed())
+			sdk = kratix.New(kratix.WithPromiseFile(promiseFile))
+
+			promise, err := sdk.FetchPromise()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(promise.GetName()).To(Equal("my-promise"))
+			Expect(promise.GetLabels()).To(HaveKeyWithValue("kratix.io/promise-version", "v0.1.0"))
+			Expect(promise.GetPromise().Spec.RequiredPromises).To(HaveLen(1))
+
+			Expect(os.Remove(promiseFile)).To(Succeed())
+			cached, err := sdk.FetchPromise()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cached).To(BeIdenticalTo(promise))
+		})
+
+		It("returns an error when the promise file cannot be read", func() {
+			_, err := kratix.ReadPromiseFile(filepath.Join(GinkgoT().TempDir(), "promise.yaml"))
+			Expect(err).To(MatchError(ContainSubstring("read promise file")))
+			Expect(errors.Is(err, os.ErrNotExist)).To(BeTrue())
+		})
+
+		It("fetches the promise named by KRATIX_PROMISE_NAME from the platform cluster and caches it", func() {
+			promiseInput, err := kratix.New(
+				kratix.WithInputDir("assets/input"),
+				kratix.WithInputObject("promise.yaml"),
+			).ReadPromiseInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			dynamicClient := fake.NewSimpleDynamicClient(runtime.NewScheme())
+			promisesGVR := schema.GroupVersionResource{Group: "platform.kratix.io", Version: "v1alpha1", Resource: "promises"}
+			existing := promiseInput.ToUnstructured()
+			_, err = dynamicClient.Resource(promisesGVR).Create(context.Background(), &existing, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			os.Setenv("KRATIX_PROMISE_NAME", "my-promise")
+			sdk = kratix.New(kratix.WithDynamicClient(dynamicClient))
+
+			promise, err := sdk.FetchPromise()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(promise.GetName()).To(Equal("my-promise"))
+			Expect(promise.GetAnnotations()).To(HaveKeyWithValue("some-annotation", "some-value"))
+			Expect(promise.GetPromise().Spec.Workflows.Resource.Configure).To(HaveLen(1))
+			_, crd, err := promise.GetPromise().GetAPI()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crd.Spec.Names.Kind).To(Equal("redis"))
+
+			Expect(dynamicClient.Resource(promisesGVR).Delete(context.Background(), "my-promise", metav1.DeleteOptions{})).To(Succeed())
+			cached, err := sdk.FetchPromise()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cached).To(BeIdenticalTo(promise))
+
+			By("sharing the cache with the promises fetched by name")
+			parent, err := kratix.New(kratix.WithInputDir("assets/input"), kratix.WithInputObject("resource.yaml")).ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+			_, err = sdk.BuildSubResourceRequest(parent, "my-promise", "my-redis", nil)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an error when the promise name is not set", func() {
+			_, err := sdk.FetchPromise()
+			Expect(err).To(MatchError("fetch promise: KRATIX_PROMISE_NAME is not set"))
+		})
+
+		It("returns an error when the promise cannot be fetched", func() {
+			os.Setenv("KRATIX_PROMISE_NAME", "my-promise")
+			mockObjectClient.GetReturns(nil, errors.New("not found"))
+
+			_, err := sdk.FetchPromise()
+			Expect(err).To(MatchError("fetch promise my-promise: not found"))
+		})
+	})
+
+	Describe("Reading numbers", func() {
+		var inputDir string
+
+		BeforeEach(func() {
+			inputDir = GinkgoT().TempDir()
+			Expect(os.WriteFile(filepath.Join(inputDir, "object.yaml"), []byte(`apiVersion: v1
+kind: MyResource
+metadata:
+  name: my-resource
+  generation: 4
+spec:
+  replicas: 3
+  accountId: 9007199254740993
+  ratio: 0.5
+`), 0o644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(metadataDir, "status.yaml"), []byte("id: 9007199254740993\nretries: 3\n"), 0o644)).To(Succeed())
+
+			sdk = kratix.New(kratix.WithInputDir(inputDir), kratix.WithMetadataDir(metadataDir))
+		})
+
+		It("keeps integers as int64 in the resource input", func() {
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resource.GetValue("spec.replicas")).To(Equal(int64(3)))
+			Expect(resource.GetValue("spec.accountId")).To(Equal(int64(9007199254740993)))
+			Expect(resource.GetValue("spec.ratio")).To(Equal(0.5))
+			Expect(resource.GetGeneration()).To(Equal(int64(4)))
+		})
+
+		It("keeps integers as int64 in the status file, including after writing it", func() {
+			status, err := sdk.ReadStatus()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Get("id")).To(Equal(int64(9007199254740993)))
+
+			Expect(status.Set("retries", 4)).To(Succeed())
+			Expect(status.ToMap()).To(Equal(map[string]any{
+				"id":      int64(9007199254740993),
+				"retries": int64(4),
+			}))
+
+			Expect(sdk.WriteStatus(status)).To(Succeed())
+			Expect(readFileContent(metadataDir, "status.yaml")).To(ContainSubstring("id: 9007199254740993"))
+		})
+	})
+
+	Describe("Writing outside the output and metadata directories", func() {
+		var root, dir string
+
+		BeforeEach(func() {
+			root = GinkgoT().TempDir()
+			dir = filepath.Join(root, "kratix", "output")
+			Expect(os.MkdirAll(dir, 0o755)).To(Succeed())
+			sdk = kratix.New(kratix.WithOutputDir(dir))
+		})
+
+		It("rejects paths escaping the directory", func() {
+			for _, path := range []string{"../input/object.yaml", "../../../escaped.yaml", "nested/../../escaped.yaml"} {
+				err := sdk.WriteOutput(path, []byte("escaped"))
+				var escape *kratix.PathEscapeError
+				Expect(errors.As(err, &escape)).To(BeTrue(), path)
+				Expect(escape.Dir).To(Equal(dir))
+			}
+			Expect(filepath.Join(root, "kratix", "input")).ToNot(BeAnExistingFile())
+			Expect(filepath.Join(root, "escaped.yaml")).ToNot(BeAnExistingFile())
+		})
+
+		It("rejects paths through symlinks leading outside the directory", func() {
+			Expect(os.Symlink(root, filepath.Join(dir, "link"))).To(Succeed())
+			Expect(os.Symlink(filepath.Join(root, "dangling.yaml"), filepath.Join(dir, "dangling.yaml"))).To(Succeed())
+
+			var escape *kratix.PathEscapeError
+			Expect(errors.As(sdk.WriteOutput("link/escaped.yaml", []byte("escaped")), &escape)).To(BeTrue())
+			Expect(errors.As(sdk.WriteOutput("dangling.yaml", []byte("escaped")), &escape)).To(BeTrue())
+			Expect(filepath.Join(root, "escaped.yaml")).ToNot(BeAnExistingFile())
+			Expect(filepath.Join(root, "dangling.yaml")).ToNot(BeAnExistingFile())
+		})
+
+		It("writes paths that stay within the directory", func() {
+			Expect(sdk.WriteOutput("nested/../object.yaml", []byte("kept"))).To(Succeed())
+			Expect(readFileContent(dir, "object.yaml")).To(Equal([]byte("kept")))
+		})
+	})
+
+	Describe("Tracking the observed generation", func() {
+		var inputDir string
+
+		BeforeEach(func() {
+			inputDir = GinkgoT().TempDir()
+			Expect(os.WriteFile(filepath.Join(inputDir, "object.yaml"), []byte(`apiVersion: v1
+kind: MyResource
+metadata:
+  name: my-resource
+  namespace: my-namespace
+  generation: 4
+`), 0o644)).To(Succeed())
+
+			sdk = kratix.New(
+				kratix.WithInputDir(inputDir),
+				kratix.WithMetadataDir(metadataDir),
+				kratix.WithObjectClient(mockObjectClient),
+			)
+		})
+
+		It("does not stamp the generation on the written status", func() {
+			status := kratix.NewStatus()
+			Expect(status.Set("message", "done")).To(Succeed())
+
+			Expect(sdk.WriteStatus(status)).To(Succeed())
+			Expect(readFileContent(metadataDir, "status.yaml")).To(MatchYAML(`{message: done}`))
+		})
+
+		It("writes the observedGeneration set by the workflow", func() {
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+			status := &kratix.StatusImpl{}
+			status.SetObservedGeneration(resource.GetGeneration())
+
+			Expect(sdk.WriteStatus(status)).To(Succeed())
+			Expect(readFileContent(metadataDir, "status.yaml")).To(MatchYAML(`{observedGeneration: 4}`))
+		})
+
+		It("does not stamp the generation on the published status", func() {
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sdk.PublishStatus(resource, kratix.NewStatus())).To(Succeed())
+
+			Expect(mockObjectClient.PatchCallCount()).To(Equal(1))
+			_, _, _, patchBytes, _, _ := mockObjectClient.PatchArgsForCall(0)
+			Expect(patchBytes).To(MatchJSON(`{"status": {}}`))
+		})
+
+		Describe("IsStale", func() {
+			var live *unstructured.Unstructured
+
+			BeforeEach(func() {
+				live = &unstructured.Unstructured{}
+				live.SetGeneration(4)
+				mockObjectClient.GetReturns(live, nil)
+			})
+
+			It("returns false while the live resource is on the same generation", func() {
+				resource, err := sdk.ReadResourceInput()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(sdk.IsStale(resource)).To(BeFalse())
+				Expect(mockObjectClient.GetCallCount()).To(Equal(1))
+				_, name, _, subresources := mockObjectClient.GetArgsForCall(0)
+				Expect(name).To(Equal("my-resource"))
+				Expect(subresources).To(BeEmpty())
+			})
+
+			It("returns true once the live resource has a newer generation", func() {
+				live.SetGeneration(5)
+				resource, err := sdk.ReadResourceInput()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(sdk.IsStale(resource)).To(BeTrue())
+			})
+
+			It("returns false when the client returns no live resource", func() {
+				mockObjectClient.GetReturns(nil, nil)
+				resource, err := sdk.ReadResourceInput()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(sdk.IsStale(resource)).To(BeFalse())
+			})
+
+			It("returns an error when the live resource cannot be fetched", func() {
+				mockObjectClient.GetReturns(nil, errors.New("not reachable"))
+				resource, err := sdk.ReadResourceInput()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = sdk.IsStale(resource)
+				Expect(err).To(MatchError("failed to get resource: not reachable"))
+			})
+		})
+	})
+
+	Describe("Publishing conditions through the object client", func() {
+		var status kratix.Status
+
+		BeforeEach(func() {
+			status = kratix.NewStatus()
+			Expect(status.Set("conditions", []metav1.Condition{{
+				Type:    "Ready",
+				Status:  metav1.ConditionTrue,
+				Reason:  "Provisioned",
+				Message: "provisioned",
+			}})).To(Succeed())
+		})
+
+		expectConditionsPatch := func() {
+			Expect(mockObjectClient.PatchCallCount()).To(Equal(1))
+			_, _, patchType, patchBytes, _, subresources := mockObjectClient.PatchArgsForCall(0)
+			Expect(patchType).To(Equal(types.MergePatchType))
+			Expect(subresources).To(Equal([]string{"status"}))
+
+			var patchData map[string]any
+			Expect(json.Unmarshal(patchBytes, &patchData)).To(Succeed())
+			Expect(patchData).ToNot(HaveKey("metadata"))
+			conditions, _, err := unstructured.NestedSlice(patchData, "status", "conditions")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conditions).To(ConsistOf(SatisfyAll(
+				HaveKeyWithValue("type", "Ready"),
+				HaveKeyWithValue("reason", "Provisioned"),
+				HaveKey("lastTransitionTime"),
+			)))
+		}
+
+		It("publishes the conditions when the client returns no live resource", func() {
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sdk.PublishStatus(resource, status)).To(Succeed())
+			expectConditionsPatch()
+		})
+
+		It("publishes the conditions when the live resource is not found", func() {
+			mockObjectClient.GetReturns(nil, apierrors.NewNotFound(schema.GroupResource{Resource: "myresources"}, "my-resource"))
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sdk.PublishStatus(resource, status)).To(Succeed())
+			expectConditionsPatch()
+		})
+
+		It("returns an error when the live resource cannot be fetched", func() {
+			mockObjectClient.GetReturns(nil, errors.New("not reachable"))
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(sdk.PublishStatus(resource, status)).To(MatchError("failed to read current status: not reachable"))
+			Expect(mockObjectClient.PatchCallCount()).To(BeZero())
+		})
+	})
+
+	When("a dynamic client is provided", func() {
+		var dynamicClient *fake.FakeDynamicClient
+
+		BeforeEach(func() {
+			existing := &unstructured.Unstructured{}
+			existing.SetAPIVersion("v1")
+			existing.SetKind("MyResource")
+			existing.SetName("my-resource")
+			existing.SetNamespace("my-namespace")
+			dynamicClient = fake.NewSimpleDynamicClient(runtime.NewScheme(), existing)
+
+			os.Setenv("KRATIX_CRD_PLURAL", "myresources")
+			sdk = kratix.New(
+				kratix.WithInputDir("assets/input"),
+				kratix.WithInputObject("resource.yaml"),
+				kratix.WithMetadataDir(metadataDir),
+				kratix.WithDynamicClient(dynamicClient),
+			)
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("KRATIX_CRD_PLURAL")
+		})
+
+		It("publishes the status through it", func() {
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			status := kratix.NewStatus()
+			Expect(status.Set("message", "hello from publish")).To(Succeed())
+			Expect(sdk.PublishStatus(resource, status)).To(Succeed())
+
+			gvr := schema.GroupVersionResource{Version: "v1", Resource: "myresources"}
+			updated, err := dynamicClient.Resource(gvr).Namespace("my-namespace").Get(context.Background(), "my-resource", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Object["status"]).To(HaveKeyWithValue("message", "hello from publish"))
+		})
+
+		It("publishes the changes made to the resource", func() {
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			resource.SetAnnotation("example.com/allocated-id", "1234")
+			Expect(resource.SetValue("spec.dbConfig.id", 1234)).To(Succeed())
+			Expect(sdk.PublishResource(resource)).To(Succeed())
+
+			gvr := schema.GroupVersionResource{Version: "v1", Resource: "myresources"}
+			updated, err := dynamicClient.Resource(gvr).Namespace("my-namespace").Get(context.Background(), "my-resource", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.GetAnnotations()).To(HaveKeyWithValue("example.com/allocated-id", "1234"))
+			Expect(updated.Object["spec"]).To(Equal(map[string]any{
+				"dbConfig": map[string]any{"id": int64(1234)},
+			}))
+			Expect(resource.Diff()).To(BeNil())
+		})
+
+		It("detects when the live resource has moved on to a newer generation", func() {
+			gvr := schema.GroupVersionResource{Version: "v1", Resource: "myresources"}
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resource.SetValue("metadata.generation", 1)).To(Succeed())
+
+			live, err := dynamicClient.Resource(gvr).Namespace("my-namespace").Get(context.Background(), "my-resource", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			live.SetGeneration(1)
+			_, err = dynamicClient.Resource(gvr).Namespace("my-namespace").Update(context.Background(), live, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sdk.IsStale(resource)).To(BeFalse())
+
+			live.SetGeneration(2)
+			_, err = dynamicClient.Resource(gvr).Namespace("my-namespace").Update(context.Background(), live, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sdk.IsStale(resource)).To(BeTrue())
+		})
+
+		It("publishes cluster-scoped kinds without a namespace", func() {
+			gvk := schema.GroupVersionKind{Version: "v1", Kind: "MyResource"}
+			mapper := meta.NewDefaultRESTMapper(nil)
+			mapper.AddSpecific(gvk, gvk.GroupVersion().WithResource("myclusterresources"), gvk.GroupVersion().WithResource("myclusterresource"), meta.RESTScopeRoot)
+
+			gvr := gvk.GroupVersion().WithResource("myclusterresources")
+			existing := &unstructured.Unstructured{}
+			existing.SetGroupVersionKind(gvk)
+			existing.SetName("my-resource")
+			dynamicClient = fake.NewSimpleDynamicClient(runtime.NewScheme())
+			_, err := dynamicClient.Resource(gvr).Create(context.Background(), existing, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			sdk = kratix.New(
+				kratix.WithInputDir("assets/input"),
+				kratix.WithInputObject("resource.yaml"),
+				kratix.WithDynamicClient(dynamicClient),
+				kratix.WithRESTMapper(mapper),
+			)
+
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			status := kratix.NewStatus()
+			Expect(status.Set("message", "cluster scoped")).To(Succeed())
+			Expect(sdk.PublishStatus(resource, status)).To(Succeed())
+
+			updated, err := dynamicClient.Resource(gvr).Get(context.Background(), "my-resource", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Object["status"]).To(HaveKeyWithValue("message", "cluster scoped"))
+		})
+
+		It("publishes the status of promises, keeping the existing conditions", func() {
+			sdk = kratix.New(
+				kratix.WithInputDir("assets/input"),
+				kratix.WithInputObject("promise.yaml"),
+				kratix.WithDynamicClient(dynamicClient),
+			)
+			promise, err := sdk.ReadPromiseInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			promisesGVR := schema.GroupVersionResource{Group: "platform.kratix.io", Version: "v1alpha1", Resource: "promises"}
+			existing := promise.ToUnstructured()
+			_, err = dynamicClient.Resource(promisesGVR).Create(context.Background(), &existing, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			status := kratix.NewStatus()
+			Expect(status.Set("conditions", []metav1.Condition{{
+				Type:    "DatabaseReady",
+				Status:  metav1.ConditionTrue,
+				Reason:  "Provisioned",
+				Message: "database provisioned",
+			}})).To(Succeed())
+			Expect(sdk.PublishStatus(promise, status)).To(Succeed())
+
+			updated, err := dynamicClient.Resource(promisesGVR).Get(context.Background(), "my-promise", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			conditions, _, err := unstructured.NestedSlice(updated.Object, "status", "conditions")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conditions).To(ConsistOf(
+				HaveKeyWithValue("type", "ConfigureWorkflowCompleted"),
+				SatisfyAll(
+					HaveKeyWithValue("type", "DatabaseReady"),
+					HaveKeyWithValue("message", "database provisioned"),
+				),
+			))
+		})
+
+		It("merges conditions with the live resource rather than the input", func() {
+			resource, err := sdk.ReadResourceInput()
+			Expect(err).ToNot(HaveOccurred())
+
+			gvr := schema.GroupVersionResource{Version: "v1", Resource: "myresources"}
+			live, err := dynamicClient.Resource(gvr).Namespace("my-namespace").Get(context.Background(), "my-resource", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unstructured.SetNestedSlice(live.Object, []any{
+				map[string]any{"type": "Ready", "status": "True", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+				map[string]any{"type": "Approved", "status": "True", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+			}, "status", "conditions")).To(Succeed())
+			_, err = dynamicClient.Resource(gvr).Namespace("my-namespace").Update(context.Background(), live, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			status := kratix.NewStatus()
+			Expect(status.Set("conditions", []metav1.Condition{{
+				Type:    "Ready",
+				Status:  metav1.ConditionTrue,
+				Reason:  "StillReady",
+				Message: "still ready",
+			}})).To(Succeed())
+			Expect(sdk.PublishStatus(resource, status)).To(Succeed())
+
+			updated, err := dynamicClient.Resource(gvr).Namespace("my-namespace").Get(context.Background(), "my-resource", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			conditions, _, err := unstructured.NestedSlice(updated.Object, "status", "conditions")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conditions).To(ConsistOf(
+				SatisfyAll(
+					HaveKeyWithValue("type", "Ready"),
+					HaveKeyWithValue("reason", "StillReady"),
+					HaveKeyWithValue("lastTransitionTime", "2024-01-01T00:00:00Z"),
+				),
+				HaveKeyWithValue("type", "Approved"),
+			))
+		})
 	})
 
 	Describe("Workflow type and action helpers", func() {
@@ -292,6 +884,63 @@ var _ = Describe("E2E Tests", func() {
 			})
 		})
 	})
+
+	Describe("Role interfaces", func() {
+		// configure depends on only the parts of the SDK it uses.
+		configure := func(input kratix.InputReader, output kratix.OutputWriter, publisher kratix.StatusPublisher) error {
+			resource, err := input.ReadResourceInput()
+			if err != nil {
+				return err
+			}
+			if err := output.WriteOutput("name.txt", []byte(resource.GetName())); err != nil {
+				return err
+			}
+			status := kratix.NewStatus()
+			if err := status.Set("written", true); err != nil {
+				return err
+			}
+			return publisher.PublishStatus(resource, status)
+		}
+
+		It("are implemented by the SDK", func() {
+			Expect(configure(sdk, sdk, sdk)).To(Succeed())
+			Expect(readFileContent(outputDir, "name.txt")).To(Equal([]byte("my-resource")))
+			Expect(mockObjectClient.PatchCallCount()).To(Equal(1))
+		})
+
+		It("can be faked", func() {
+			resource := &kratixgofakes.FakeResource{}
+			resource.GetNameReturns("faked")
+			input := &kratixgofakes.FakeInputReader{}
+			input.ReadResourceInputReturns(resource, nil)
+			output := &kratixgofakes.FakeOutputWriter{}
+			publisher := &kratixgofakes.FakeStatusPublisher{}
+
+			Expect(configure(input, output, publisher)).To(Succeed())
+
+			relPath, content := output.WriteOutputArgsForCall(0)
+			Expect(relPath).To(Equal("name.txt"))
+			Expect(content).To(Equal([]byte("faked")))
+			publishedResource, status := publisher.PublishStatusArgsForCall(0)
+			Expect(publishedResource).To(Equal(resource))
+			Expect(status.Get("written")).To(BeTrue())
+		})
+
+		It("lets the SDK publish the status of a faked Resource", func() {
+			resource := &kratixgofakes.FakeResource{}
+			resource.GetNameReturns("faked")
+			resource.GetGenerationReturns(3)
+			resource.GetStatusReturns(kratix.NewStatus(), nil)
+			status := kratix.NewStatus()
+			Expect(status.Set("message", "hello")).To(Succeed())
+
+			Expect(sdk.PublishStatus(resource, status)).To(Succeed())
+
+			_, name, _, patch, _, _ := mockObjectClient.PatchArgsForCall(0)
+			Expect(name).To(Equal("faked"))
+			Expect(patch).To(MatchJSON(`{"status": {"message": "hello"}}`))
+		})
+	})
 })
 
 func readFileContent(baseDir, relativePath string) []byte {
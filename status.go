@@ -4,11 +4,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"math/big"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/itchyny/gojq"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+//go:generate go tool counterfeiter . Status
 type Status interface {
 	// Get queries the Status and retrieves the value at the specified path e.g. healthStatus.state
 	Get(string) any
@@ -20,6 +26,11 @@ type Status interface {
 	ToMap() map[string]any
 }
 
+const (
+	observedGenerationKey = "observedGeneration"
+	lastTransitionTimeKey = "lastTransitionTime"
+)
+
 type operation string
 
 const (
@@ -40,6 +51,18 @@ func (s *StatusImpl) ToMap() map[string]any {
 	return s.data
 }
 
+// SetObservedGeneration sets observedGeneration to the generation, e.g. of the
+// Resource returned by ReadResourceInput. The SDK never sets it on its own, as
+// Kratix manages observedGeneration: only use it for objects whose status the
+// workflow owns, and never for Promises, whose observedGeneration Kratix uses
+// to decide whether to reconcile their resources again.
+func (s *StatusImpl) SetObservedGeneration(generation int64) {
+	if s.data == nil {
+		s.data = map[string]any{}
+	}
+	s.data[observedGenerationKey] = generation
+}
+
 // NewStatus creates a new Status with an empty map.
 func NewStatus() Status {
 	return &StatusImpl{
@@ -143,12 +166,95 @@ func (s *StatusImpl) query(op operation, path string, val any) ([]any, error) {
 		if err, ok := v.(error); ok {
 			return nil, err
 		}
-		results = append(results, v)
+		results = append(results, normaliseNumbers(v))
 	}
 
 	if persist {
 		s.data = results[0].(map[string]any)
 	}
+	// gojq normalises the numbers of its input in place, restore them to int64
+	normaliseNumbers(s.data)
 
 	return results, nil
 }
+
+// mergeConditions returns the existing conditions with the incoming ones
+// replacing any of the same type, and appends the incoming conditions of new
+// types. Like meta.SetStatusCondition, a condition keeps its lastTransitionTime
+// while its status is unchanged, and gets the current time when its status
+// changes without one.
+func mergeConditions(existing, incoming []any) []any {
+	merged := slices.Clone(existing)
+	now := metav1.Now().UTC().Format(time.RFC3339)
+	for _, condition := range incoming {
+		conditionType := conditionTypeOf(condition)
+		idx := slices.IndexFunc(merged, func(c any) bool {
+			return conditionType != "" && conditionTypeOf(c) == conditionType
+		})
+		if idx == -1 {
+			merged = append(merged, withTransitionTime(condition, nil, now))
+			continue
+		}
+		merged[idx] = withTransitionTime(condition, merged[idx], now)
+	}
+	return merged
+}
+
+// withTransitionTime returns a copy of the condition with the
+// lastTransitionTime of the previous condition if the status is unchanged, or
+// else with now if it has none.
+func withTransitionTime(condition, previous any, now string) any {
+	m, ok := condition.(map[string]any)
+	if !ok {
+		return condition
+	}
+	m = maps.Clone(m)
+	if p, ok := previous.(map[string]any); ok && p["status"] == m["status"] && !isZeroTime(p[lastTransitionTimeKey]) {
+		m[lastTransitionTimeKey] = p[lastTransitionTimeKey]
+		return m
+	}
+	if isZeroTime(m[lastTransitionTimeKey]) {
+		m[lastTransitionTimeKey] = now
+	}
+	return m
+}
+
+// isZeroTime returns true for unset times, which metav1.Time encodes as null.
+func isZeroTime(t any) bool {
+	return t == nil || t == ""
+}
+
+func conditionTypeOf(condition any) string {
+	m, ok := condition.(map[string]any)
+	if !ok {
+		return ""
+	}
+	conditionType, _ := m["type"].(string)
+	return conditionType
+}
+
+// normaliseNumbers converts the integers produced by gojq back to int64, the
+// integer type used by unstructured objects.
+func normaliseNumbers(v any) any {
+	switch v := v.(type) {
+	case int:
+		return int64(v)
+	case *big.Int:
+		if v.IsInt64() {
+			return v.Int64()
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = normaliseNumbers(item)
+		}
+		return v
+	case map[string]any:
+		for k, item := range v {
+			v[k] = normaliseNumbers(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -1,6 +1,8 @@
 package kratix
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -62,8 +64,8 @@ var _ = Describe("Status", func() {
 	Describe("Get", func() {
 		It("retrieves top-level values", func() {
 			Expect(status.Get("phase")).To(Equal("Ready"))
-			Expect(status.Get("observedGeneration")).To(Equal(1))
-			Expect(status.Get("replicas")).To(Equal(3))
+			Expect(status.Get("observedGeneration")).To(Equal(int64(1)))
+			Expect(status.Get("replicas")).To(Equal(int64(3)))
 		})
 
 		It("retrieves nested values", func() {
@@ -116,6 +118,11 @@ var _ = Describe("Status", func() {
 		It("handles empty path", func() {
 			Expect(status.Get("")).To(BeNil())
 		})
+
+		It("keeps the integers of the status as int64", func() {
+			Expect(status.Get("pods | length")).To(Equal(int64(2)))
+			Expect(status.ToMap()["replicas"]).To(Equal(int64(3)))
+		})
 	})
 
 	Describe("Set", func() {
@@ -186,7 +193,7 @@ var _ = Describe("Status", func() {
 
 			err = status.Set("intValue", 42)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(status.Get("intValue")).To(Equal(42))
+			Expect(status.Get("intValue")).To(Equal(int64(42)))
 
 			err = status.Set("boolValue", true)
 			Expect(err).ToNot(HaveOccurred())
@@ -197,6 +204,12 @@ var _ = Describe("Status", func() {
 			Expect(status.Get("sliceValue")).To(Equal([]any{"a", "b", "c"}))
 		})
 
+		It("keeps large integers intact", func() {
+			Expect(status.Set("id", int64(9007199254740993))).To(Succeed())
+			Expect(status.Get("id")).To(Equal(int64(9007199254740993)))
+			Expect(status.ToMap()["observedGeneration"]).To(Equal(int64(1)))
+		})
+
 		It("returns error for empty path", func() {
 			err := status.Set("", "value")
 			Expect(err).To(HaveOccurred())
@@ -247,3 +260,55 @@ var _ = Describe("Status", func() {
 		})
 	})
 })
+
+var _ = Describe("mergeConditions", func() {
+	It("replaces conditions of the same type and appends new ones", func() {
+		existing := []any{
+			map[string]any{"type": "Ready", "status": "False", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+			map[string]any{"type": "ConfigureWorkflowCompleted", "status": "True", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+		}
+		incoming := []any{
+			map[string]any{"type": "Ready", "status": "True", "lastTransitionTime": "2024-02-01T00:00:00Z"},
+			map[string]any{"type": "DatabaseReady", "status": "True", "lastTransitionTime": "2024-02-01T00:00:00Z"},
+		}
+
+		Expect(mergeConditions(existing, incoming)).To(Equal([]any{
+			map[string]any{"type": "Ready", "status": "True", "lastTransitionTime": "2024-02-01T00:00:00Z"},
+			map[string]any{"type": "ConfigureWorkflowCompleted", "status": "True", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+			map[string]any{"type": "DatabaseReady", "status": "True", "lastTransitionTime": "2024-02-01T00:00:00Z"},
+		}))
+		Expect(existing[0]).To(HaveKeyWithValue("status", "False"))
+	})
+
+	It("keeps the lastTransitionTime of conditions whose status is unchanged", func() {
+		existing := []any{
+			map[string]any{"type": "Ready", "status": "True", "reason": "Old", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+		}
+		incoming := []any{
+			map[string]any{"type": "Ready", "status": "True", "reason": "New", "lastTransitionTime": "2024-02-01T00:00:00Z"},
+		}
+
+		Expect(mergeConditions(existing, incoming)).To(Equal([]any{
+			map[string]any{"type": "Ready", "status": "True", "reason": "New", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+		}))
+		Expect(incoming[0]).To(HaveKeyWithValue("lastTransitionTime", "2024-02-01T00:00:00Z"))
+	})
+
+	It("sets the lastTransitionTime of new and changed conditions without one", func() {
+		existing := []any{
+			map[string]any{"type": "Ready", "status": "False", "lastTransitionTime": "2024-01-01T00:00:00Z"},
+		}
+		incoming := []any{
+			map[string]any{"type": "Ready", "status": "True", "lastTransitionTime": nil},
+			map[string]any{"type": "DatabaseReady", "status": "True"},
+		}
+
+		merged := mergeConditions(existing, incoming)
+		Expect(merged).To(HaveLen(2))
+		for _, condition := range merged {
+			transitionTime, err := time.Parse(time.RFC3339, condition.(map[string]any)["lastTransitionTime"].(string))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(transitionTime).To(BeTemporally("~", time.Now(), time.Minute))
+		}
+	})
+})